@@ -15,11 +15,16 @@ import (
 
 	"github.com/commitHub/commitBlockchain/codec"
 	"github.com/commitHub/commitBlockchain/modules/acl"
+	aclclient "github.com/commitHub/commitBlockchain/modules/acl/client"
+	"github.com/commitHub/commitBlockchain/modules/approvals"
 	"github.com/commitHub/commitBlockchain/modules/auth"
 	"github.com/commitHub/commitBlockchain/modules/bank"
 	"github.com/commitHub/commitBlockchain/modules/crisis"
 	distr "github.com/commitHub/commitBlockchain/modules/distribution"
 	distrclient "github.com/commitHub/commitBlockchain/modules/distribution/client"
+	"github.com/commitHub/commitBlockchain/modules/feeGrants"
+	"github.com/commitHub/commitBlockchain/modules/fiatTokens"
+	"github.com/commitHub/commitBlockchain/modules/forwards"
 	"github.com/commitHub/commitBlockchain/modules/genaccounts"
 	"github.com/commitHub/commitBlockchain/modules/genutil"
 	"github.com/commitHub/commitBlockchain/modules/gov"
@@ -28,10 +33,14 @@ import (
 	"github.com/commitHub/commitBlockchain/modules/orders"
 	"github.com/commitHub/commitBlockchain/modules/params"
 	paramsclient "github.com/commitHub/commitBlockchain/modules/params/client"
+	"github.com/commitHub/commitBlockchain/modules/payouts"
+	"github.com/commitHub/commitBlockchain/modules/pools"
 	"github.com/commitHub/commitBlockchain/modules/reputation"
+	"github.com/commitHub/commitBlockchain/modules/reserves"
 	"github.com/commitHub/commitBlockchain/modules/slashing"
 	"github.com/commitHub/commitBlockchain/modules/staking"
 	"github.com/commitHub/commitBlockchain/modules/supply"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
 	"github.com/commitHub/commitBlockchain/types/module"
 	"github.com/commitHub/commitBlockchain/version"
 )
@@ -53,7 +62,9 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler,
+			aclclient.CreateZoneProposalHandler, aclclient.RotateZoneAddressProposalHandler,
+			aclclient.SuspendZoneProposalHandler, aclclient.RemoveZoneProposalHandler, bank.ClawbackProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -62,6 +73,14 @@ var (
 		acl.AppModuleBasic{},
 		negotiation.AppModuleBasic{},
 		orders.AppModuleBasic{},
+		forwards.AppModuleBasic{},
+		pools.AppModuleBasic{},
+		fiatTokens.AppModuleBasic{},
+		payouts.AppModuleBasic{},
+		approvals.AppModuleBasic{},
+		reserves.AppModuleBasic{},
+		tradeFees.AppModuleBasic{},
+		feeGrants.AppModuleBasic{},
 	)
 
 	maccPerms = map[string][]string{
@@ -71,6 +90,11 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		forwards.ModuleName:       nil,
+		pools.ModuleName:          {supply.Minter, supply.Burner},
+		fiatTokens.ModuleName:     {supply.Minter, supply.Burner},
+		orders.ModuleName:         nil,
+		tradeFees.ModuleName:      nil,
 	}
 )
 
@@ -102,6 +126,14 @@ type MainApp struct {
 	keyOrder       *cTypes.KVStoreKey
 	keyNegotiation *cTypes.KVStoreKey
 	keyReputation  *cTypes.KVStoreKey
+	keyForwards    *cTypes.KVStoreKey
+	keyPools       *cTypes.KVStoreKey
+	keyFiatTokens  *cTypes.KVStoreKey
+	keyPayouts     *cTypes.KVStoreKey
+	keyApprovals   *cTypes.KVStoreKey
+	keyReserves    *cTypes.KVStoreKey
+	keyTradeFees   *cTypes.KVStoreKey
+	keyFeeGrants   *cTypes.KVStoreKey
 
 	tkeyStaking      *cTypes.TransientStoreKey
 	tkeyDistribution *cTypes.TransientStoreKey
@@ -122,6 +154,14 @@ type MainApp struct {
 	orderKeeper       orders.Keeper
 	negotiationKeeper negotiation.Keeper
 	reputationKeeper  reputation.Keeper
+	forwardsKeeper    forwards.Keeper
+	poolsKeeper       pools.Keeper
+	fiatTokensKeeper  fiatTokens.Keeper
+	payoutsKeeper     payouts.Keeper
+	approvalsKeeper   approvals.Keeper
+	reservesKeeper    reserves.Keeper
+	tradeFeesKeeper   tradeFees.Keeper
+	feeGrantsKeeper   feeGrants.Keeper
 
 	mm *module.Manager
 }
@@ -158,6 +198,14 @@ func NewMainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		keyNegotiation: cTypes.NewKVStoreKey(negotiation.ModuleName),
 		keyOrder:       cTypes.NewKVStoreKey(orders.ModuleName),
 		keyReputation:  cTypes.NewKVStoreKey(reputation.ModuleName),
+		keyForwards:    cTypes.NewKVStoreKey(forwards.ModuleName),
+		keyPools:       cTypes.NewKVStoreKey(pools.ModuleName),
+		keyFiatTokens:  cTypes.NewKVStoreKey(fiatTokens.ModuleName),
+		keyPayouts:     cTypes.NewKVStoreKey(payouts.ModuleName),
+		keyApprovals:   cTypes.NewKVStoreKey(approvals.ModuleName),
+		keyReserves:    cTypes.NewKVStoreKey(reserves.ModuleName),
+		keyTradeFees:   cTypes.NewKVStoreKey(tradeFees.ModuleName),
+		keyFeeGrants:   cTypes.NewKVStoreKey(feeGrants.ModuleName),
 	}
 
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams, app.tkeyParams, params.DefaultCodespace)
@@ -169,14 +217,27 @@ func NewMainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
+	tradeFeesSubspace := app.paramsKeeper.Subspace(tradeFees.DefaultParamspace)
+	forwardsSubspace := app.paramsKeeper.Subspace(forwards.DefaultParamspace)
 
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, app.keyAccount, authSubspace, auth.ProtoBaseAccount)
-	app.negotiationKeeper = negotiation.NewKeeper(app.keyNegotiation, app.accountKeeper, app.cdc)
 	app.aclKeeper = acl.NewKeeper(app.keyACL, app.accountKeeper, app.cdc)
+	app.negotiationKeeper = negotiation.NewKeeper(app.keyNegotiation, app.accountKeeper, app.aclKeeper, app.cdc)
 	app.orderKeeper = orders.NewKeeper(app.keyOrder, app.cdc, app.negotiationKeeper, app.aclKeeper, app.accountKeeper)
 	app.reputationKeeper = reputation.NewKeeper(cdc, app.keyReputation, app.orderKeeper)
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, app.negotiationKeeper, app.aclKeeper, app.orderKeeper, app.reputationKeeper, bankSubspace, bank.DefaultCodespace)
-	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply, app.accountKeeper, app.bankKeeper, supply.DefaultCodespace, maccPerms)
+	app.reservesKeeper = reserves.NewKeeper(app.keyReserves, app.cdc, app.aclKeeper)
+	app.payoutsKeeper = payouts.NewKeeper(app.keyPayouts, app.cdc, app.accountKeeper, app.aclKeeper, app.reservesKeeper)
+	app.feeGrantsKeeper = feeGrants.NewKeeper(app.keyFeeGrants, app.cdc, app.aclKeeper)
+	app.tradeFeesKeeper = tradeFees.NewKeeper(app.keyTradeFees, app.cdc, tradeFeesSubspace, app.aclKeeper)
+	approvalsKeeper := approvals.NewKeeper(app.keyApprovals, app.cdc, app.aclKeeper)
+	bankKeeper := bank.NewBaseKeeper(app.accountKeeper, app.negotiationKeeper, app.aclKeeper, app.orderKeeper, app.reputationKeeper, app.payoutsKeeper, approvalsKeeper, app.reservesKeeper, app.tradeFeesKeeper, bankSubspace, bank.DefaultCodespace)
+	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply, app.accountKeeper, bankKeeper, supply.DefaultCodespace, maccPerms)
+	app.bankKeeper = *bankKeeper.SetSupplyKeeper(app.supplyKeeper)
+	app.approvalsKeeper = *approvalsKeeper.SetBankKeeper(app.bankKeeper)
+	app.forwardsKeeper = forwards.NewKeeper(app.keyForwards, app.cdc, forwardsSubspace, app.accountKeeper, app.negotiationKeeper,
+		app.orderKeeper, app.aclKeeper, app.supplyKeeper)
+	app.poolsKeeper = pools.NewKeeper(app.keyPools, app.cdc, app.accountKeeper, app.aclKeeper, app.supplyKeeper)
+	app.fiatTokensKeeper = fiatTokens.NewKeeper(app.keyFiatTokens, app.cdc, app.accountKeeper, app.aclKeeper, app.supplyKeeper)
 	stakingKeeper := staking.NewKeeper(app.cdc, app.keyStaking, app.tkeyStaking,
 		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
 	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, mintSubspace, &stakingKeeper, app.supplyKeeper, auth.FeeCollectorName)
@@ -189,7 +250,9 @@ func NewMainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distributionKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distributionKeeper)).
+		AddRoute(acl.RouterKey, acl.NewZoneProposalHandler(app.aclKeeper)).
+		AddRoute(bank.RouterKey, bank.NewClawbackProposalHandler(app.bankKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
@@ -200,7 +263,8 @@ func NewMainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
 		auth.NewAppModule(app.accountKeeper),
-		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		bank.NewAppModule(app.bankKeeper, app.accountKeeper, app.reservesKeeper,
+			app.orderKeeper, app.payoutsKeeper, app.fiatTokensKeeper, app.forwardsKeeper),
 		crisis.NewAppModule(app.crisisKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distributionKeeper, app.supplyKeeper),
@@ -213,26 +277,40 @@ func NewMainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		orders.NewAppModule(app.orderKeeper, app.negotiationKeeper),
 		negotiation.NewAppModule(app.negotiationKeeper),
 		reputation.NewAppModule(app.reputationKeeper),
+		forwards.NewAppModule(app.forwardsKeeper),
+		pools.NewAppModule(app.poolsKeeper),
+		fiatTokens.NewAppModule(app.fiatTokensKeeper),
+		payouts.NewAppModule(app.payoutsKeeper),
+		approvals.NewAppModule(app.approvalsKeeper),
+		reserves.NewAppModule(app.reservesKeeper, app.accountKeeper,
+			app.orderKeeper, app.payoutsKeeper, app.fiatTokensKeeper, app.forwardsKeeper),
+		tradeFees.NewAppModule(app.tradeFeesKeeper, app.accountKeeper, app.distributionKeeper),
+		feeGrants.NewAppModule(app.feeGrantsKeeper),
 	)
 
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName, forwards.ModuleName, approvals.ModuleName, negotiation.ModuleName,
+		tradeFees.ModuleName)
 
 	app.mm.SetOrderInitGenesis(genaccounts.ModuleName, distr.ModuleName,
 		staking.ModuleName, auth.ModuleName, bank.ModuleName, slashing.ModuleName,
 		gov.ModuleName, mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
-		acl.ModuleName, orders.ModuleName, negotiation.ModuleName, reputation.ModuleName)
+		acl.ModuleName, orders.ModuleName, negotiation.ModuleName, reputation.ModuleName, forwards.ModuleName,
+		pools.ModuleName, fiatTokens.ModuleName, payouts.ModuleName, approvals.ModuleName, reserves.ModuleName,
+		tradeFees.ModuleName, feeGrants.ModuleName)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
 	app.MountStores(app.keyMain, app.keyAccount, app.keySupply, app.keyStaking,
 		app.keyMint, app.keyDistribution, app.keySlashing, app.keyGov, app.keyParams,
-		app.tkeyParams, app.tkeyStaking, app.tkeyDistribution, app.keyACL, app.keyOrder, app.keyNegotiation, app.keyReputation)
+		app.tkeyParams, app.tkeyStaking, app.tkeyDistribution, app.keyACL, app.keyOrder, app.keyNegotiation, app.keyReputation,
+		app.keyForwards, app.keyPools, app.keyFiatTokens, app.keyPayouts, app.keyApprovals, app.keyReserves,
+		app.keyTradeFees, app.keyFeeGrants)
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.feeGrantsKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
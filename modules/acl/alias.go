@@ -12,11 +12,37 @@ const (
 	StoreKey     = types.StoreKey
 
 	DefaultCodeSpace = types.DefaultCodeSpace
+
+	ACLStatusActive    = types.ACLStatusActive
+	ACLStatusSuspended = types.ACLStatusSuspended
+	ACLStatusRevoked   = types.ACLStatusRevoked
+
+	ACLAuthorityOrganization = types.ACLAuthorityOrganization
+	ACLAuthorityZone         = types.ACLAuthorityZone
+	ACLAuthorityGenesis      = types.ACLAuthorityGenesis
+
+	PegTypeAsset = types.PegTypeAsset
+	PegTypeFiat  = types.PegTypeFiat
 )
 
 type (
-	GenesisState = types.GenesisState
-	Keeper       = keeper.Keeper
+	GenesisState        = types.GenesisState
+	GenesisZone         = types.GenesisZone
+	GenesisOrganization = types.GenesisOrganization
+	GenesisACLChangeLog = types.GenesisACLChangeLog
+
+	GenesisOrganizationTradingLimit = types.GenesisOrganizationTradingLimit
+	GenesisAccountTradingLimit      = types.GenesisAccountTradingLimit
+
+	AddressRotation     = types.AddressRotation
+	KYC                 = types.KYC
+	TradingLimit        = types.TradingLimit
+	TradingUsage        = types.TradingUsage
+	TradingHeadroom     = types.TradingHeadroom
+	BlockedCounterparty = types.BlockedCounterparty
+	Freeze              = types.Freeze
+	Clawback            = types.Clawback
+	Keeper              = keeper.Keeper
 
 	AccountKeeper = types.AccountKeeper
 
@@ -24,17 +50,35 @@ type (
 	MsgDefineACLs          = types.MsgDefineACLs
 	MsgDefineOrganizations = types.MsgDefineOrganizations
 
+	MsgBlockCounterparties   = types.MsgBlockCounterparties
+	MsgUnblockCounterparties = types.MsgUnblockCounterparties
+	MsgFreezePegs            = types.MsgFreezePegs
+	MsgUnfreezePegs          = types.MsgUnfreezePegs
+
 	DefineZone         = types.DefineZone
 	DefineOrganization = types.DefineOrganization
 	DefineACL          = types.DefineACL
 	Organization       = types.Organization
 
+	BlockCounterparty   = types.BlockCounterparty
+	UnblockCounterparty = types.UnblockCounterparty
+	FreezePeg           = types.FreezePeg
+	UnfreezePeg         = types.UnfreezePeg
+
 	ACLAccount     = types.ACLAccount
 	BaseACLAccount = types.BaseACLAccount
 	ZoneID         = types.ZoneID
 	OrganizationID = types.OrganizationID
 
-	ACL = types.ACL
+	ACL              = types.ACL
+	PermissionExpiry = types.PermissionExpiry
+	Role             = types.Role
+	ACLChange        = types.ACLChange
+
+	CreateZoneProposal        = types.CreateZoneProposal
+	RotateZoneAddressProposal = types.RotateZoneAddressProposal
+	SuspendZoneProposal       = types.SuspendZoneProposal
+	RemoveZoneProposal        = types.RemoveZoneProposal
 )
 
 var (
@@ -44,11 +88,34 @@ var (
 	ModuleCdc     = types.ModuleCdc
 	RegisterCodec = types.RegisterCodec
 
+	RegisterInvariants         = keeper.RegisterInvariants
+	AccountReferencesInvariant = keeper.AccountReferencesInvariant
+
+	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 
+	NewQueryZonesParams         = types.NewQueryZonesParams
+	NewQueryOrganizationsParams = types.NewQueryOrganizationsParams
+	NewQueryACLAccountsParams   = types.NewQueryACLAccountsParams
+
+	NewQuerySubOrganizationsParams = types.NewQuerySubOrganizationsParams
+	NewQueryExpiringKYCsParams     = types.NewQueryExpiringKYCsParams
+
+	NewQueryBlockedCounterpartiesParams = types.NewQueryBlockedCounterpartiesParams
+
 	ErrInvalidAddress = types.ErrInvalidAddress
 	ErrNoInputs       = types.ErrNoInputs
+	ErrInvalidStatus  = types.ErrInvalidStatus
+	ErrACLNotCovered  = types.ErrACLNotCovered
+	ErrKYCExpired     = types.ErrKYCExpired
+
+	ErrTradingLimitExceeded = types.ErrTradingLimitExceeded
+	ErrCounterpartyBlocked  = types.ErrCounterpartyBlocked
+	ErrFrozen               = types.ErrFrozen
+
+	RejectionEvents = types.RejectionEvents
+	ValidatePeg     = types.ValidatePeg
 
 	GetACLAccountKey   = types.GetACLAccountKey
 	GetOrganizationKey = types.GetOrganizationKey
@@ -58,17 +125,73 @@ var (
 	GetOrganizationIDFromString = types.GetOrganizationIDFromString
 
 	EventTypeDefineACL          = types.EventTypeDefineACL
+	EventTypeDefineRole         = types.EventTypeDefineRole
+	EventTypeSuspendACL         = types.EventTypeSuspendACL
+	EventTypeReinstateACL       = types.EventTypeReinstateACL
+	EventTypeRevokeACL          = types.EventTypeRevokeACL
 	EventTypeDefineOrganization = types.EventTypeDefineOrganization
 	EventTypeDefineZone         = types.EventTypeDefineZone
 
+	EventTypeRotateZoneAddress         = types.EventTypeRotateZoneAddress
+	EventTypeRotateOrganizationAddress = types.EventTypeRotateOrganizationAddress
+	EventTypeDefineSubOrganization     = types.EventTypeDefineSubOrganization
+	EventTypeSetOrganizationAdmins     = types.EventTypeSetOrganizationAdmins
+	EventTypeRecordKYC                 = types.EventTypeRecordKYC
+	EventTypeSetTradingLimit           = types.EventTypeSetTradingLimit
+	EventTypeBlockCounterparty         = types.EventTypeBlockCounterparty
+	EventTypeUnblockCounterparty       = types.EventTypeUnblockCounterparty
+	EventTypeRejectCounterparty        = types.EventTypeRejectCounterparty
+	EventTypeFreeze                    = types.EventTypeFreeze
+	EventTypeUnfreeze                  = types.EventTypeUnfreeze
+
 	AttributeKeyZoneID              = types.AttributeKeyZoneID
 	AttributeKeyZoneAddress         = types.AttributeKeyZoneAddress
 	AttributeKeyOrganizationID      = types.AttributeKeyOrganizationID
 	AttributeKeyOrganizationAddress = types.AttributeKeyOrganizationAddress
 	AttributeACLAccountAddress      = types.AttributeACLAccountAddress
-
-	NewOrganization = types.NewOrganization
-	NewKeeper       = keeper.NewKeeper
+	AttributeKeyRoleName            = types.AttributeKeyRoleName
+	AttributeKeyReason              = types.AttributeKeyReason
+	AttributeKeyOldAddress          = types.AttributeKeyOldAddress
+	AttributeKeyParentID            = types.AttributeKeyParentID
+	AttributeKeyAdmin               = types.AttributeKeyAdmin
+	AttributeKeyKYCLevel            = types.AttributeKeyKYCLevel
+	AttributeKeyKYCExpiresAt        = types.AttributeKeyKYCExpiresAt
+	AttributeKeyMaxBid              = types.AttributeKeyMaxBid
+	AttributeKeyMaxDailyFiatOutflow = types.AttributeKeyMaxDailyFiatOutflow
+	AttributeKeyMaxOpenNegotiations = types.AttributeKeyMaxOpenNegotiations
+	AttributeKeyCounterparty        = types.AttributeKeyCounterparty
+	AttributeKeyPegType             = types.AttributeKeyPegType
+	AttributeKeyPegHash             = types.AttributeKeyPegHash
+
+	NewOrganization    = types.NewOrganization
+	NewSubOrganization = types.NewSubOrganization
+	NewRole            = types.NewRole
+
+	NewPermissionExpiry = types.NewPermissionExpiry
+	IsPermission        = types.IsPermission
+
+	NewAddressRotation = types.NewAddressRotation
+	NewKYC             = types.NewKYC
+	NewTradingLimit    = types.NewTradingLimit
+
+	NewBlockCounterparty        = types.NewBlockCounterparty
+	NewUnblockCounterparty      = types.NewUnblockCounterparty
+	BuildMsgBlockCounterparty   = types.BuildMsgBlockCounterparty
+	BuildMsgUnblockCounterparty = types.BuildMsgUnblockCounterparty
+
+	NewFreeze           = types.NewFreeze
+	NewClawback         = types.NewClawback
+	NewFreezePeg        = types.NewFreezePeg
+	NewUnfreezePeg      = types.NewUnfreezePeg
+	BuildMsgFreezePeg   = types.BuildMsgFreezePeg
+	BuildMsgUnfreezePeg = types.BuildMsgUnfreezePeg
+
+	NewCreateZoneProposal        = types.NewCreateZoneProposal
+	NewRotateZoneAddressProposal = types.NewRotateZoneAddressProposal
+	NewSuspendZoneProposal       = types.NewSuspendZoneProposal
+	NewRemoveZoneProposal        = types.NewRemoveZoneProposal
+
+	NewKeeper = keeper.NewKeeper
 
 	NewQuerier = keeper.NewQuerier
 )
@@ -59,3 +59,51 @@ func GetACLAccountCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetACLChangeLogCmd : returns a query of the acl change log of an account
+func GetACLChangeLogCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "changeLog [address]",
+		Short: "Query the acl changes of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := cTypes.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryACLChangeLog", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetAddressRotationsCmd : returns a query of the rotations of a zone or organization address to its current address
+func GetAddressRotationsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotations [address]",
+		Short: "Query the rotations of a zone or organization address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := cTypes.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryAddressRotations", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// getCounterpartyList : the zone or the organization of the flags whose counterparty list is managed
+func getCounterpartyList() (zoneID types.ZoneID, organizationID types.OrganizationID, err error) {
+	if strOrganizationID := viper.GetString(FlagOrganizationID); strOrganizationID != "" {
+		organizationID, err = types.GetOrganizationIDFromString(strOrganizationID)
+		return
+	}
+	zoneID, err = types.GetZoneIDFromString(viper.GetString(FlagZoneID))
+	return
+}
+
+// BlockCounterpartyCmd : adds an address to the denylist of a zone or to the blocked partners of an organization
+func BlockCounterpartyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blockCounterparty",
+		Short: "Block an address from trading, on the denylist of --zoneID or the blocked partners of --organizationID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, organizationID, err := getCounterpartyList()
+			if err != nil {
+				return err
+			}
+
+			address, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			msg := types.BuildMsgBlockCounterparty(cliCtx.GetFromAddress(), zoneID, organizationID, address, viper.GetString(FlagReason))
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsReason)
+	return cmd
+}
+
+// UnblockCounterpartyCmd : removes an address from the denylist of a zone or from the blocked partners of an organization
+func UnblockCounterpartyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblockCounterparty",
+		Short: "Unblock an address, from the denylist of --zoneID or the blocked partners of --organizationID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, organizationID, err := getCounterpartyList()
+			if err != nil {
+				return err
+			}
+
+			address, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			msg := types.BuildMsgUnblockCounterparty(cliCtx.GetFromAddress(), zoneID, organizationID, address)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	return cmd
+}
+
+// GetZoneDenylistCmd : returns a query of a page of the denylist of a zone
+func GetZoneDenylistCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denylist [zoneID]",
+		Short: "Query the addresses blocked by a zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, err := types.GetZoneIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryBlockedCounterpartiesParams(zoneID, nil, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryBlockedCounterparties", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetBlockedCounterpartiesCmd : returns a query of a page of the blocked partners of an organization
+func GetBlockedCounterpartiesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blockedCounterparties [organizationID]",
+		Short: "Query the addresses blocked by an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			organizationID, err := types.GetOrganizationIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryBlockedCounterpartiesParams(nil, organizationID, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryBlockedCounterparties", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
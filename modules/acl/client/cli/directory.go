@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// GetZonesCmd : returns a query of a page of the zones
+func GetZonesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zones",
+		Short: "Query the zones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := types.NewQueryZonesParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryZones", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetOrganizationsCmd : returns a query of a page of the organizations of a zone
+func GetOrganizationsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "organizations [zoneID]",
+		Short: "Query the organizations of a zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, err := types.GetZoneIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryOrganizationsParams(zoneID, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryOrganizations", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetSubOrganizationsCmd : returns a query of a page of the sub organizations of an organization
+func GetSubOrganizationsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subOrganizations [organizationID]",
+		Short: "Query the sub organizations of an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			organizationID, err := types.GetOrganizationIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySubOrganizationsParams(organizationID, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "querySubOrganizations", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetACLAccountsCmd : returns a query of a page of the acl accounts of an organization
+func GetACLAccountsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts [organizationID]",
+		Short: "Query the acl accounts of an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			organizationID, err := types.GetOrganizationIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryACLAccountsParams(organizationID, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryACLAccounts", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+func queryDirectory(cdc *codec.Codec, route string, params interface{}) error {
+	ctx := context.NewCLIContext().WithCodec(cdc)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
@@ -23,6 +23,11 @@ const (
 	FlagRedeemFiat         = "redeemFiat"
 	FlagRedeemAsset        = "redeemAsset"
 	FlagReleaseAsset       = "releaseAsset"
+	FlagPage               = "page"
+	FlagLimit              = "limit"
+	FlagReason             = "reason"
+	FlagPegType            = "pegType"
+	FlagPegHash            = "pegHash"
 )
 
 // onlint
@@ -44,6 +49,9 @@ var (
 	fsRedeemFiat         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedeemAsset        = flag.NewFlagSet("", flag.ContinueOnError)
 	fsReleaseAsset       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPage               = flag.NewFlagSet("", flag.ContinueOnError)
+	fsReason             = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPeg                = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -64,4 +72,9 @@ func init() {
 	fsRedeemFiat.String(FlagRedeemFiat, "", "Redeem fiat")
 	fsRedeemAsset.String(FlagRedeemAsset, "", "Redeem assets")
 	fsReleaseAsset.String(FlagReleaseAsset, "", "Release assets")
+	fsPage.Int(FlagPage, 1, "Page of the results")
+	fsPage.Int(FlagLimit, 100, "Results per page")
+	fsReason.String(FlagReason, "", "Reason the address or peg is blocked")
+	fsPeg.String(FlagPegType, "asset", "Peg type, asset or fiat")
+	fsPeg.String(FlagPegHash, "", "Peg hash")
 }
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// getFrozenPeg : the address, peg type and peg hash of the flags, the whole wallet without --pegHash
+func getFrozenPeg() (address cTypes.AccAddress, pegType string, pegHash cmTypes.PegHash, err error) {
+	address, err = cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+	if err != nil {
+		return
+	}
+	if strPegHash := viper.GetString(FlagPegHash); strPegHash != "" {
+		pegType = viper.GetString(FlagPegType)
+		pegHash, err = cmTypes.GetAssetPegHashHex(strPegHash)
+	}
+	return
+}
+
+// FreezePegCmd : the zone of an account freezes one of its pegs or its whole wallet
+func FreezePegCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Freeze the --pegType peg --pegHash held by an account, or its whole wallet without --pegHash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, pegType, pegHash, err := getFrozenPeg()
+			if err != nil {
+				return err
+			}
+
+			msg := types.BuildMsgFreezePeg(cliCtx.GetFromAddress(), address, pegType, pegHash, viper.GetString(FlagReason))
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsPeg)
+	cmd.Flags().AddFlagSet(fsReason)
+	return cmd
+}
+
+// UnfreezePegCmd : the zone that froze a peg or a wallet lifts its freeze
+func UnfreezePegCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Unfreeze the --pegType peg --pegHash in the wallet of an account, or its whole wallet without --pegHash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, pegType, pegHash, err := getFrozenPeg()
+			if err != nil {
+				return err
+			}
+
+			msg := types.BuildMsgUnfreezePeg(cliCtx.GetFromAddress(), address, pegType, pegHash)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsPeg)
+	return cmd
+}
+
+// GetFreezesCmd : returns a query of the freezes of an account
+func GetFreezesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freezes [address]",
+		Short: "Query the freezes of the wallet of an account and of the pegs frozen in it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := cTypes.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryFreezes", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetClawbacksCmd : returns a query of the clawbacks of a peg
+func GetClawbacksCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clawbacks [pegType] [pegHash]",
+		Short: "Query the clawbacks of an asset or fiat peg with their reasons",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := cmTypes.GetAssetPegHashHex(args[1])
+			if err != nil {
+				return err
+			}
+			if err := types.ValidatePeg(args[0], pegHash); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, "queryClawbacks", args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
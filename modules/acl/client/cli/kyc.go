@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// GetKYCCmd : returns a query of the kyc of an account
+func GetKYCCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "kyc [address]",
+		Short: "Query the kyc of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := cTypes.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryKYC", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetExpiringKYCsCmd : returns a query of a page of the kyc of a zone expiring at or before a height
+func GetExpiringKYCsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiringKYC [zoneID] [height]",
+		Short: "Query the kyc of a zone expiring at or before the height, soonest first",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zoneID, err := types.GetZoneIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryExpiringKYCsParams(zoneID, height, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			return queryDirectory(cdc, "queryExpiringKYCs", params)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/gov"
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// ZoneProposalJSON : zone proposal with a deposit, read from a file
+type ZoneProposalJSON struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	ZoneID      types.ZoneID      `json:"zoneID"`
+	Address     cTypes.AccAddress `json:"address"`
+	Suspended   bool              `json:"suspended"`
+	Deposit     cTypes.Coins      `json:"deposit"`
+}
+
+// GetCmdSubmitCreateZoneProposal : submits a proposal defining a zone
+func GetCmdSubmitCreateZoneProposal(cdc *codec.Codec) *cobra.Command {
+	return zoneProposalCmd(cdc, "create-zone", "Submit a proposal defining a zone with its address", `"address": "commit1..."`,
+		func(proposal ZoneProposalJSON) govTypes.Content {
+			return types.NewCreateZoneProposal(proposal.Title, proposal.Description, proposal.ZoneID, proposal.Address)
+		})
+}
+
+// GetCmdSubmitRotateZoneAddressProposal : submits a proposal moving a zone to a new address
+func GetCmdSubmitRotateZoneAddressProposal(cdc *codec.Codec) *cobra.Command {
+	return zoneProposalCmd(cdc, "rotate-zone-address", "Submit a proposal moving a zone to a new address", `"address": "commit1..."`,
+		func(proposal ZoneProposalJSON) govTypes.Content {
+			return types.NewRotateZoneAddressProposal(proposal.Title, proposal.Description, proposal.ZoneID, proposal.Address)
+		})
+}
+
+// GetCmdSubmitSuspendZoneProposal : submits a proposal suspending or reinstating a zone
+func GetCmdSubmitSuspendZoneProposal(cdc *codec.Codec) *cobra.Command {
+	return zoneProposalCmd(cdc, "suspend-zone", "Submit a proposal suspending a zone, or reinstating it", `"suspended": true`,
+		func(proposal ZoneProposalJSON) govTypes.Content {
+			return types.NewSuspendZoneProposal(proposal.Title, proposal.Description, proposal.ZoneID, proposal.Suspended)
+		})
+}
+
+// GetCmdSubmitRemoveZoneProposal : submits a proposal removing a zone
+func GetCmdSubmitRemoveZoneProposal(cdc *codec.Codec) *cobra.Command {
+	return zoneProposalCmd(cdc, "remove-zone", "Submit a proposal removing a zone without organizations", "",
+		func(proposal ZoneProposalJSON) govTypes.Content {
+			return types.NewRemoveZoneProposal(proposal.Title, proposal.Description, proposal.ZoneID)
+		})
+}
+
+func zoneProposalCmd(cdc *codec.Codec, use string, short string, field string,
+	buildContent func(proposal ZoneProposalJSON) govTypes.Content) *cobra.Command {
+
+	if field != "" {
+		field = "\n  " + field + ","
+	}
+	return &cobra.Command{
+		Use:   use + " [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: short,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`%s along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "...",
+  "description": "...",
+  "zoneID": "ABCD",%s
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`, short, version.ClientName, use, field),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal ZoneProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(buildContent(proposal), proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+}
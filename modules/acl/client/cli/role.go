@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// GetRolesCmd : returns a query of the roles of a zone, or of an organization of the zone
+func GetRolesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "roles [zoneID] [organizationID]",
+		Short: "Query roles defined by a zone or by one of its organizations",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryRoles", args[0])
+			if len(args) == 2 {
+				path = fmt.Sprintf("%s/%s", path, args[1])
+			}
+
+			res, _, err := ctx.QueryWithData(path, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// GetTradingHeadroomCmd : returns a query of the effective trading limit of an account and what remains of it
+func GetTradingHeadroomCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tradingHeadroom [address]",
+		Short: "Query the trading limit of an account and the headroom left under it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := cTypes.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, "queryTradingHeadroom", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
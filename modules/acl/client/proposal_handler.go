@@ -0,0 +1,15 @@
+package client
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/acl/client/cli"
+	"github.com/commitHub/commitBlockchain/modules/acl/client/rest"
+	govclient "github.com/commitHub/commitBlockchain/modules/gov/client"
+)
+
+// zone lifecycle proposal handlers
+var (
+	CreateZoneProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitCreateZoneProposal, rest.CreateZoneProposalRESTHandler)
+	RotateZoneAddressProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRotateZoneAddressProposal, rest.RotateZoneAddressProposalRESTHandler)
+	SuspendZoneProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitSuspendZoneProposal, rest.SuspendZoneProposalRESTHandler)
+	RemoveZoneProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveZoneProposal, rest.RemoveZoneProposalRESTHandler)
+)
@@ -43,3 +43,49 @@ func GetACLRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, account)
 	}
 }
+
+// GetACLChangeLogRequestHandler query acl change log of an account Handler
+func GetACLChangeLogRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32addr := vars["address"]
+		cliCtx := cliCtx
+
+		addr, err := cTypes.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrAccAddressFromBech32(aclTypes.DefaultCodeSpace, bech32addr))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", aclTypes.QuerierRoute, "queryACLChangeLog", addr), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "ACL change log"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetAddressRotationsRequestHandler query rotations of a zone or organization address Handler
+func GetAddressRotationsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32addr := vars["address"]
+		cliCtx := cliCtx
+
+		addr, err := cTypes.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrAccAddressFromBech32(aclTypes.DefaultCodeSpace, bech32addr))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", aclTypes.QuerierRoute, "queryAddressRotations", addr), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "address rotations"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type BlockCounterpartyReq struct {
+	BaseReq        rest.BaseReq `json:"base_req"`
+	ZoneID         string       `json:"zoneID" valid:"matches(^[A-Fa-f0-9]+$)~Invalid ZoneID,length(2|40)~ZoneID length should be 2 to 40"`
+	OrganizationID string       `json:"organizationID" valid:"matches(^[A-Fa-f0-9]+$)~Invalid OrganizationID,length(2|40)~OrganizationID length should be 2 to 40"`
+	Address        string       `json:"address" valid:"required~Enter the address,matches(^commit[a-z0-9]{39}$)~address is Invalid"`
+	Reason         string       `json:"reason"`
+	Password       string       `json:"password" valid:"required~Enter the password"`
+	Mode           string       `json:"mode"`
+}
+
+// blockCounterpartyHandler : builds the block or unblock counterparty message of the request
+func blockCounterpartyHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState, ticketPrefix string,
+	buildMsg func(from cTypes.AccAddress, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID, address cTypes.AccAddress,
+		reason string) cTypes.Msg) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BlockCounterpartyReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(aclTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		var zoneID aclTypes.ZoneID
+		var organizationID aclTypes.OrganizationID
+		if req.OrganizationID != "" {
+			organizationID, err = aclTypes.GetOrganizationIDFromString(req.OrganizationID)
+		} else {
+			zoneID, err = aclTypes.GetZoneIDFromString(req.ZoneID)
+		}
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		address, err := cTypes.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := buildMsg(fromAddr, zoneID, organizationID, address, req.Reason)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator(ticketPrefix)
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
+
+func BlockCounterpartyHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return blockCounterpartyHandler(cliCtx, kafkaBool, kafkaState, "BLCP", aclTypes.BuildMsgBlockCounterparty)
+}
+
+func UnblockCounterpartyHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return blockCounterpartyHandler(cliCtx, kafkaBool, kafkaState, "UBCP",
+		func(from cTypes.AccAddress, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID, address cTypes.AccAddress,
+			_ string) cTypes.Msg {
+			return aclTypes.BuildMsgUnblockCounterparty(from, zoneID, organizationID, address)
+		})
+}
+
+// GetZoneDenylistRequestHandler query page of the denylist of a zone Handler
+func GetZoneDenylistRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strZoneID := mux.Vars(r)["zoneID"]
+
+		zoneID, err := aclTypes.GetZoneIDFromString(strZoneID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrZoneIDFromString(aclTypes.DefaultCodeSpace, strZoneID))
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryBlockedCounterparties", aclTypes.NewQueryBlockedCounterpartiesParams(zoneID, nil, page, limit))
+	}
+}
+
+// GetBlockedCounterpartiesRequestHandler query page of the blocked partners of an organization Handler
+func GetBlockedCounterpartiesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strOrganizationID := mux.Vars(r)["organizationID"]
+
+		organizationID, err := aclTypes.GetOrganizationIDFromString(strOrganizationID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrOrganizationIDFromString(aclTypes.DefaultCodeSpace, strOrganizationID))
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryBlockedCounterparties",
+			aclTypes.NewQueryBlockedCounterpartiesParams(nil, organizationID, page, limit))
+	}
+}
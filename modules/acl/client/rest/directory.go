@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// GetZonesRequestHandler query page of the zones Handler
+func GetZonesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryZones", aclTypes.NewQueryZonesParams(page, limit))
+	}
+}
+
+// GetOrganizationsRequestHandler query page of the organizations of a zone Handler
+func GetOrganizationsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strZoneID := mux.Vars(r)["zoneID"]
+
+		zoneID, err := aclTypes.GetZoneIDFromString(strZoneID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrZoneIDFromString(aclTypes.DefaultCodeSpace, strZoneID))
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryOrganizations", aclTypes.NewQueryOrganizationsParams(zoneID, page, limit))
+	}
+}
+
+// GetSubOrganizationsRequestHandler query page of the sub organizations of an organization Handler
+func GetSubOrganizationsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strOrganizationID := mux.Vars(r)["organizationID"]
+
+		organizationID, err := aclTypes.GetOrganizationIDFromString(strOrganizationID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrOrganizationIDFromString(aclTypes.DefaultCodeSpace, strOrganizationID))
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "querySubOrganizations", aclTypes.NewQuerySubOrganizationsParams(organizationID, page, limit))
+	}
+}
+
+// GetACLAccountsRequestHandler query page of the acl accounts of an organization Handler
+func GetACLAccountsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strOrganizationID := mux.Vars(r)["organizationID"]
+
+		organizationID, err := aclTypes.GetOrganizationIDFromString(strOrganizationID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrOrganizationIDFromString(aclTypes.DefaultCodeSpace, strOrganizationID))
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryACLAccounts", aclTypes.NewQueryACLAccountsParams(organizationID, page, limit))
+	}
+}
+
+func queryDirectory(w http.ResponseWriter, cliCtx context.CLIContext, route string, params interface{}) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", aclTypes.QuerierRoute, route), bz)
+	if err != nil {
+		rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, route))
+		return
+	}
+
+	rest.PostProcessResponse(w, cliCtx, res)
+}
@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type FreezePegReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Address  string       `json:"address" valid:"required~Enter the address,matches(^commit[a-z0-9]{39}$)~address is Invalid"`
+	PegType  string       `json:"pegType"`
+	PegHash  string       `json:"pegHash" valid:"matches(^[A-Fa-f0-9]+$)~Invalid PegHash"`
+	Reason   string       `json:"reason"`
+	Password string       `json:"password" valid:"required~Enter the password"`
+	Mode     string       `json:"mode"`
+}
+
+// freezePegHandler : builds the freeze or unfreeze peg message of the request
+func freezePegHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState, ticketPrefix string,
+	buildMsg func(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash,
+		reason string) cTypes.Msg) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req FreezePegReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(aclTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		address, err := cTypes.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pegHash, err := types.GetAssetPegHashHex(req.PegHash)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrPegHashHex(aclTypes.DefaultCodeSpace, req.PegHash))
+			return
+		}
+
+		msg := buildMsg(fromAddr, address, req.PegType, pegHash, req.Reason)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator(ticketPrefix)
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
+
+func FreezePegHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return freezePegHandler(cliCtx, kafkaBool, kafkaState, "FRPG", aclTypes.BuildMsgFreezePeg)
+}
+
+func UnfreezePegHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return freezePegHandler(cliCtx, kafkaBool, kafkaState, "UFPG",
+		func(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash, _ string) cTypes.Msg {
+			return aclTypes.BuildMsgUnfreezePeg(from, address, pegType, pegHash)
+		})
+}
+
+// GetFreezesRequestHandler query freezes of an account Handler
+func GetFreezesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bech32addr := mux.Vars(r)["address"]
+
+		addr, err := cTypes.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrAccAddressFromBech32(aclTypes.DefaultCodeSpace, bech32addr))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", aclTypes.QuerierRoute, "queryFreezes", addr), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "freezes"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetClawbacksRequestHandler query clawbacks of a peg Handler
+func GetClawbacksRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		pegHash, err := types.GetAssetPegHashHex(vars["pegHash"])
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrPegHashHex(aclTypes.DefaultCodeSpace, vars["pegHash"]))
+			return
+		}
+		if err := aclTypes.ValidatePeg(vars["pegType"], pegHash); err != nil {
+			rest2.WriteErrorResponse(w, err)
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", aclTypes.QuerierRoute, "queryClawbacks",
+			vars["pegType"], vars["pegHash"]), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "clawbacks"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
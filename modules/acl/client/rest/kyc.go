@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// GetKYCRequestHandler query kyc of an account Handler
+func GetKYCRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bech32addr := mux.Vars(r)["address"]
+
+		addr, err := cTypes.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrAccAddressFromBech32(aclTypes.DefaultCodeSpace, bech32addr))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", aclTypes.QuerierRoute, "queryKYC", addr), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "kyc"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetExpiringKYCsRequestHandler query page of the kyc of a zone expiring at or before the height Handler
+func GetExpiringKYCsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strZoneID := mux.Vars(r)["zoneID"]
+
+		zoneID, err := aclTypes.GetZoneIDFromString(strZoneID)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrZoneIDFromString(aclTypes.DefaultCodeSpace, strZoneID))
+			return
+		}
+
+		height, err := strconv.ParseInt(r.FormValue("height"), 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryDirectory(w, cliCtx, "queryExpiringKYCs", aclTypes.NewQueryExpiringKYCsParams(zoneID, height, page, limit))
+	}
+}
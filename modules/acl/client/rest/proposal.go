@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/gov"
+	govrest "github.com/commitHub/commitBlockchain/modules/gov/client/rest"
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+)
+
+// ZoneProposalReq : zone proposal request body
+type ZoneProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	ZoneID      aclTypes.ZoneID   `json:"zoneID"`
+	Address     cTypes.AccAddress `json:"address"`
+	Suspended   bool              `json:"suspended"`
+	Proposer    cTypes.AccAddress `json:"proposer"`
+	Deposit     cTypes.Coins      `json:"deposit"`
+}
+
+// CreateZoneProposalRESTHandler : create zone proposal REST handler
+func CreateZoneProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return zoneProposalRESTHandler(cliCtx, "create_zone", func(req ZoneProposalReq) govTypes.Content {
+		return aclTypes.NewCreateZoneProposal(req.Title, req.Description, req.ZoneID, req.Address)
+	})
+}
+
+// RotateZoneAddressProposalRESTHandler : rotate zone address proposal REST handler
+func RotateZoneAddressProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return zoneProposalRESTHandler(cliCtx, "rotate_zone_address", func(req ZoneProposalReq) govTypes.Content {
+		return aclTypes.NewRotateZoneAddressProposal(req.Title, req.Description, req.ZoneID, req.Address)
+	})
+}
+
+// SuspendZoneProposalRESTHandler : suspend zone proposal REST handler
+func SuspendZoneProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return zoneProposalRESTHandler(cliCtx, "suspend_zone", func(req ZoneProposalReq) govTypes.Content {
+		return aclTypes.NewSuspendZoneProposal(req.Title, req.Description, req.ZoneID, req.Suspended)
+	})
+}
+
+// RemoveZoneProposalRESTHandler : remove zone proposal REST handler
+func RemoveZoneProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return zoneProposalRESTHandler(cliCtx, "remove_zone", func(req ZoneProposalReq) govTypes.Content {
+		return aclTypes.NewRemoveZoneProposal(req.Title, req.Description, req.ZoneID)
+	})
+}
+
+func zoneProposalRESTHandler(cliCtx context.CLIContext, subRoute string,
+	buildContent func(req ZoneProposalReq) govTypes.Content) govrest.ProposalRESTHandler {
+
+	return govrest.ProposalRESTHandler{
+		SubRoute: subRoute,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ZoneProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			msg := gov.NewMsgSubmitProposal(buildContent(req), req.Deposit, req.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []cTypes.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// GetRolesRequestHandler query roles of a zone or of an organization Handler
+func GetRolesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		strZoneID := vars["zoneID"]
+		cliCtx := cliCtx
+
+		if strZoneID == "" {
+			rest2.WriteErrorResponse(w, types.ErrEmptyRequestFields(aclTypes.DefaultCodeSpace, strZoneID))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", aclTypes.QuerierRoute, "queryRoles",
+			strZoneID, vars["organizationID"]), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "roles"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -3,10 +3,32 @@ package rest
 import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/gorilla/mux"
+
+	"github.com/commitHub/commitBlockchain/kafka"
 )
 
-func RegisterRoutes(ctx context.CLIContext, r *mux.Router) {
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/zones", GetZonesRequestHandler(ctx)).Methods("GET")
 	r.HandleFunc("/zone/{zoneID}", GetZoneRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/zone/{zoneID}/organizations", GetOrganizationsRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/zone/{zoneID}/expiringKYC", GetExpiringKYCsRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/zone/{zoneID}/denylist", GetZoneDenylistRequestHandler(ctx)).Methods("GET")
 	r.HandleFunc("/organization/{organizationID}", GetOrganizationRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/organization/{organizationID}/accounts", GetACLAccountsRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/organization/{organizationID}/subOrganizations", GetSubOrganizationsRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/organization/{organizationID}/blockedCounterparties", GetBlockedCounterpartiesRequestHandler(ctx)).Methods("GET")
 	r.HandleFunc("/acl/{address}", GetACLRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/acl/{address}/changeLog", GetACLChangeLogRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/kyc/{address}", GetKYCRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/tradingHeadroom/{address}", GetTradingHeadroomRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/freezes/{address}", GetFreezesRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/clawbacks/{pegType}/{pegHash}", GetClawbacksRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/rotations/{address}", GetAddressRotationsRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/roles/{zoneID}", GetRolesRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/roles/{zoneID}/{organizationID}", GetRolesRequestHandler(ctx)).Methods("GET")
+
+	r.HandleFunc("/blockCounterparty", BlockCounterpartyHandler(ctx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/unblockCounterparty", UnblockCounterpartyHandler(ctx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/freezePeg", FreezePegHandler(ctx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/unfreezePeg", UnfreezePegHandler(ctx, kafkaBool, kafkaState)).Methods("POST")
 }
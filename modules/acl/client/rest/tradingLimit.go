@@ -0,0 +1,36 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// GetTradingHeadroomRequestHandler query trading limit and headroom of an account Handler
+func GetTradingHeadroomRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bech32addr := mux.Vars(r)["address"]
+
+		addr, err := cTypes.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrAccAddressFromBech32(aclTypes.DefaultCodeSpace, bech32addr))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", aclTypes.QuerierRoute, "queryTradingHeadroom", addr), nil)
+		if err != nil {
+			rest2.WriteErrorResponse(w, types.ErrQuery(aclTypes.DefaultCodeSpace, "trading headroom"))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
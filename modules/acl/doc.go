@@ -5,3 +5,17 @@ package acl
 // added validation in keeper of SetZoneAddress: check whether already exist of ZoneID
 // added GetZones, GetOrganizations and GetOrganizationsByZoneID Query in keeper
 // added organization struct : to string method and new organization create method
+// added roles : named acl templates of a zone or organization, acl:{0x04}:{ZoneID}:{OrganizationID}:{Name} => Role
+// acl accounts are assigned roles, GetAccountACLDetails returns the account acl merged with the acl of its roles
+// acl accounts can be suspended, reinstated and revoked, and granted permissions carry optional expiry heights, inactive accounts have no permissions
+// every acl change is recorded in the change log of the account, acl:{0x05}:{len(Address)}:{Address}:{Height}:{Seq} => ACLChange
+// genesis lists zones, organizations, acl accounts, roles and acl change logs, and round-trips through export
+// zone organization and organization acl account index keys back the paginated zones, organizations and accounts queries
+// zones can be created, rotated, suspended and removed by gov proposals, a suspended zone has no authority and its accounts no permissions
+// zone and organization addresses can be rotated by their current address, or by the zone for organizations, acl:{0x09}:{len(OldAddress)}:{OldAddress}:{len(ZoneID)}:{ZoneID}:{OrganizationID} => AddressRotation
+// organizations can have sub organizations bounded by an acl and several admins, acl:{0x0A}:{ParentID}:{OrganizationID} indexes sub organizations, the acl of sub organization members is always resolved within that bound
+// zones record the kyc of their accounts, acl:{0x0B}:{Address} => KYC, indexed by expiry at acl:{0x0C}:{ZoneID}:{ExpiresAt}:{Address}, trades of accounts with expired kyc are rejected
+// organizations and accounts have trading limits tightened along the parent chain, acl:{0x0D}:{OrganizationID} and acl:{0x0E}:{Address} => TradingLimit, daily fiat outflow and open negotiations are tracked at acl:{0x0F}:{Address} => TradingUsage
+// zones keep a denylist and organizations blocked partners, acl:{0x10}:{ZoneID}:{Address} and acl:{0x11}:{OrganizationID}:{Address} => BlockedCounterparty, trades involving a blocked address are rejected with a rejectCounterparty event
+// zones freeze whole wallets and asset or fiat pegs of their accounts, acl:{0x12}:{Address} and acl:{0x13}:{Address}:{PegType}:{PegHash} => Freeze, frozen pegs do not leave the wallet of their holder
+// zones claw back fraudulent pegs to their address through bank, governed by gov proposals when clawbackgoverned is set, acl:{0x14}:{PegType}:{PegHash}:{Height}:{Address} => Clawback
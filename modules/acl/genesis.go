@@ -8,12 +8,64 @@ import (
 
 func InitGenesis(ctx cTypes.Context, keeper Keeper, data GenesisState) (err error) {
 
-	_ = keeper.SetZoneAddress(ctx, GetZoneKey(DefaultZoneID), data.ZoneID)
+	for _, zone := range data.Zones {
+		if err := keeper.SetZoneAddress(ctx, zone.ZoneID, zone.Address); err != nil {
+			return err
+		}
+		if zone.Suspended {
+			_ = keeper.SetZoneSuspended(ctx, zone.ZoneID, true)
+		}
+	}
 
-	_ = keeper.SetOrganization(ctx, GetOrganizationKey(DefaultOrganizationID), data.Organization)
+	for _, organization := range data.Organizations {
+		if err := keeper.SetOrganization(ctx, organization.OrganizationID, organization.GetOrganization()); err != nil {
+			return err
+		}
+	}
 
-	for _, acl := range data.Accounts {
-		_ = keeper.SetACLAccount(ctx, acl)
+	for _, account := range data.Accounts {
+		account := account
+		_ = keeper.SetACLAccount(ctx, &account)
+	}
+
+	for _, role := range data.Roles {
+		keeper.SetRole(ctx, role)
+	}
+
+	for _, aclChangeLog := range data.ACLChangeLogs {
+		keeper.SetACLChangeLog(ctx, aclChangeLog.Address, aclChangeLog.ChangeLog)
+	}
+
+	for _, addressRotation := range data.AddressRotations {
+		keeper.SetAddressRotation(ctx, addressRotation)
+	}
+
+	for _, kyc := range data.KYCs {
+		keeper.SetKYC(ctx, kyc)
+	}
+
+	for _, organizationTradingLimit := range data.OrganizationTradingLimits {
+		keeper.SetOrganizationTradingLimit(ctx, organizationTradingLimit.OrganizationID, organizationTradingLimit.TradingLimit)
+	}
+
+	for _, accountTradingLimit := range data.AccountTradingLimits {
+		keeper.SetAccountTradingLimit(ctx, accountTradingLimit.Address, accountTradingLimit.TradingLimit)
+	}
+
+	for _, tradingUsage := range data.TradingUsages {
+		keeper.SetTradingUsage(ctx, tradingUsage)
+	}
+
+	for _, blockedCounterparty := range data.BlockedCounterparties {
+		keeper.SetBlockedCounterparty(ctx, blockedCounterparty)
+	}
+
+	for _, freeze := range data.Freezes {
+		keeper.SetFreeze(ctx, freeze)
+	}
+
+	for _, clawback := range data.Clawbacks {
+		keeper.SetClawback(ctx, clawback)
 	}
 
 	return nil
@@ -21,14 +73,88 @@ func InitGenesis(ctx cTypes.Context, keeper Keeper, data GenesisState) (err erro
 }
 
 func ExportGenesisState(ctx cTypes.Context, keeper Keeper) GenesisState {
-	zoneID, _ := keeper.GetZoneAddress(ctx, GetZoneKey(DefaultZoneID))
-	organizationID, _ := keeper.GetOrganization(ctx, DefaultOrganizationID)
+	var zones []types.GenesisZone
+	keeper.IterateZones(ctx, func(zoneID types.ZoneID, address cTypes.AccAddress) bool {
+		zones = append(zones, types.GenesisZone{ZoneID: zoneID, Address: address, Suspended: keeper.IsZoneSuspended(ctx, zoneID)})
+		return false
+	})
+
+	var organizations []types.GenesisOrganization
+	keeper.IterateOrganizations(ctx, func(organizationID types.OrganizationID, organization types.Organization) bool {
+		organizations = append(organizations, types.NewGenesisOrganization(organizationID, organization))
+		return false
+	})
 
-	return GenesisState{
-		Accounts:     keeper.GetACLAccounts(ctx),
-		ZoneID:       zoneID,
-		Organization: organizationID,
+	var accounts []types.BaseACLAccount
+	for _, aclAccount := range keeper.GetACLAccounts(ctx) {
+		accounts = append(accounts, types.BaseACLAccount{
+			Address:            aclAccount.GetAddress(),
+			ZoneID:             aclAccount.GetZoneID(),
+			OrganizationID:     aclAccount.GetOrganizationID(),
+			ACL:                aclAccount.GetACL(),
+			Roles:              aclAccount.GetRoles(),
+			Status:             aclAccount.GetStatus(),
+			PermissionExpiries: aclAccount.GetPermissionExpiries(),
+		})
 	}
+
+	var aclChangeLogs []types.GenesisACLChangeLog
+	keeper.IterateACLChangeLogs(ctx, func(address cTypes.AccAddress, changeLog []types.ACLChange) bool {
+		aclChangeLogs = append(aclChangeLogs, types.GenesisACLChangeLog{Address: address, ChangeLog: changeLog})
+		return false
+	})
+
+	var addressRotations []types.AddressRotation
+	keeper.IterateAddressRotations(ctx, func(addressRotation types.AddressRotation) bool {
+		addressRotations = append(addressRotations, addressRotation)
+		return false
+	})
+
+	var kycs []types.KYC
+	keeper.IterateKYCs(ctx, func(kyc types.KYC) bool {
+		kycs = append(kycs, kyc)
+		return false
+	})
+
+	var organizationTradingLimits []types.GenesisOrganizationTradingLimit
+	keeper.IterateOrganizationTradingLimits(ctx, func(organizationID types.OrganizationID, tradingLimit types.TradingLimit) bool {
+		organizationTradingLimits = append(organizationTradingLimits,
+			types.GenesisOrganizationTradingLimit{OrganizationID: organizationID, TradingLimit: tradingLimit})
+		return false
+	})
+
+	var accountTradingLimits []types.GenesisAccountTradingLimit
+	keeper.IterateAccountTradingLimits(ctx, func(address cTypes.AccAddress, tradingLimit types.TradingLimit) bool {
+		accountTradingLimits = append(accountTradingLimits, types.GenesisAccountTradingLimit{Address: address, TradingLimit: tradingLimit})
+		return false
+	})
+
+	var tradingUsages []types.TradingUsage
+	keeper.IterateTradingUsages(ctx, func(tradingUsage types.TradingUsage) bool {
+		tradingUsages = append(tradingUsages, tradingUsage)
+		return false
+	})
+
+	var blockedCounterparties []types.BlockedCounterparty
+	keeper.IterateBlockedCounterparties(ctx, func(blockedCounterparty types.BlockedCounterparty) bool {
+		blockedCounterparties = append(blockedCounterparties, blockedCounterparty)
+		return false
+	})
+
+	var freezes []types.Freeze
+	keeper.IterateFreezes(ctx, func(freeze types.Freeze) bool {
+		freezes = append(freezes, freeze)
+		return false
+	})
+
+	var clawbacks []types.Clawback
+	keeper.IterateClawbacks(ctx, func(clawback types.Clawback) bool {
+		clawbacks = append(clawbacks, clawback)
+		return false
+	})
+
+	return NewGenesisState(zones, organizations, accounts, keeper.GetRoles(ctx, nil, nil), aclChangeLogs, addressRotations, kycs,
+		organizationTradingLimits, accountTradingLimits, tradingUsages, blockedCounterparties, freezes, clawbacks)
 }
 
 func DefaultACLAccount(zoneID types.ZoneID, organizationID types.OrganizationID, address cTypes.AccAddress) types.ACLAccount {
@@ -0,0 +1,99 @@
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func setupTestInput(t *testing.T) (cTypes.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*types.ACLAccount)(nil), nil)
+	cdc.RegisterConcrete(&types.BaseACLAccount{}, "commit-blockchain/AclAccount", nil)
+
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	return ctx, NewKeeper(key, nil, cdc)
+}
+
+// genesisState : one entry of every kind of acl state
+func genesisState() GenesisState {
+	zoneID, organizationID := types.ZoneID([]byte("zone")), types.OrganizationID([]byte("organization"))
+	subOrganizationID := types.OrganizationID([]byte("sub"))
+	zone, organization := cTypes.AccAddress([]byte("zone")), cTypes.AccAddress([]byte("organization"))
+	trader, counterparty := cTypes.AccAddress([]byte("trader")), cTypes.AccAddress([]byte("counterparty"))
+	acl := types.ACL{SendFiat: true, Negotiation: true}
+
+	return NewGenesisState(
+		[]types.GenesisZone{{ZoneID: zoneID, Address: zone, Suspended: true}},
+		[]types.GenesisOrganization{
+			types.NewGenesisOrganization(organizationID, types.Organization{Address: organization, ZoneID: zoneID,
+				Admins: []cTypes.AccAddress{trader}}),
+			types.NewGenesisOrganization(subOrganizationID, types.NewSubOrganization(counterparty, zoneID, organizationID, acl)),
+		},
+		[]types.BaseACLAccount{{Address: trader, ZoneID: zoneID, OrganizationID: organizationID, ACL: acl,
+			Roles: []string{"trader"}, Status: types.ACLStatusSuspended,
+			PermissionExpiries: []types.PermissionExpiry{types.NewPermissionExpiry("sendFiat", 100)}}},
+		[]types.Role{types.NewRole("trader", zoneID, nil, types.ACL{RedeemFiat: true})},
+		[]types.GenesisACLChangeLog{{Address: trader, ChangeLog: []types.ACLChange{
+			{Action: types.ACLActionDefine, By: zone, Height: 3, ACL: acl},
+			{Action: types.ACLActionSuspend, By: zone, Reason: "audit", Height: 3, ACL: acl},
+		}}},
+		[]types.AddressRotation{types.NewAddressRotation(cTypes.AccAddress([]byte("old")), organization, zoneID, organizationID, 4)},
+		[]types.KYC{types.NewKYC(trader, 2, zoneID, "document", 5, 50)},
+		[]types.GenesisOrganizationTradingLimit{{OrganizationID: organizationID, TradingLimit: types.NewTradingLimit(1000, 500, 3)}},
+		[]types.GenesisAccountTradingLimit{{Address: trader, TradingLimit: types.NewTradingLimit(100, 50, 1)}},
+		[]types.TradingUsage{{Address: trader, FiatOutflows: []types.FiatOutflow{{Time: 6, Amount: 20}}, OpenNegotiations: 1}},
+		[]types.BlockedCounterparty{types.NewBlockedCounterparty(counterparty, zoneID, organizationID, "fraud", 7)},
+		[]types.Freeze{types.NewFreeze(zoneID, trader, PegTypeFiat, cmTypes.PegHash([]byte("fiat")), "dispute", 8)},
+		[]types.Clawback{types.NewClawback(zoneID, trader, zone, PegTypeAsset, cmTypes.PegHash([]byte("asset")), 1, "fraud", 9)},
+	)
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	data := genesisState()
+	require.NoError(t, ValidateGenesis(data))
+
+	ctx, keeper := setupTestInput(t)
+	require.NoError(t, InitGenesis(ctx, keeper, data))
+	exported := ExportGenesisState(ctx, keeper)
+	require.Equal(t, data, exported)
+
+	// the exported state imports into an empty chain and exports unchanged
+	ctx, keeper = setupTestInput(t)
+	require.NoError(t, InitGenesis(ctx, keeper, exported))
+	require.Equal(t, exported, ExportGenesisState(ctx, keeper))
+}
+
+func TestInitGenesisBuildsTheDirectoryIndexes(t *testing.T) {
+	ctx, keeper := setupTestInput(t)
+	require.NoError(t, InitGenesis(ctx, keeper, genesisState()))
+
+	organizations := keeper.GetOrganizationsPage(ctx, types.ZoneID([]byte("zone")), 1, 0)
+	require.Len(t, organizations, 2)
+	require.Equal(t, types.OrganizationID([]byte("organization")), organizations[0].OrganizationID)
+	require.Equal(t, types.OrganizationID([]byte("sub")), organizations[1].OrganizationID)
+
+	accounts := keeper.GetACLAccountsPage(ctx, types.OrganizationID([]byte("organization")), 1, 0)
+	require.Len(t, accounts, 1)
+	require.Equal(t, cTypes.AccAddress([]byte("trader")), accounts[0].GetAddress())
+	require.Empty(t, keeper.GetACLAccountsPage(ctx, types.OrganizationID([]byte("sub")), 1, 0))
+}
@@ -16,6 +16,14 @@ func NewHandler(k Keeper) cTypes.Handler {
 			return handleMsgDefineOrganizations(ctx, k, msg)
 		case MsgDefineACLs:
 			return handleMsgDefineACLAccounts(ctx, k, msg)
+		case MsgBlockCounterparties:
+			return handleMsgBlockCounterparties(ctx, k, msg)
+		case MsgUnblockCounterparties:
+			return handleMsgUnblockCounterparties(ctx, k, msg)
+		case MsgFreezePegs:
+			return handleMsgFreezePegs(ctx, k, msg)
+		case MsgUnfreezePegs:
+			return handleMsgUnfreezePegs(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return cTypes.ErrUnknownRequest(errMsg).Result()
@@ -130,20 +138,110 @@ func defineACLAccount(ctx cTypes.Context, k Keeper, acl DefineACL) (cTypes.Event
 			if !(k.CheckValidOrganizationAddress(ctx, acl.ACLAccount.GetZoneID(), acl.ACLAccount.GetOrganizationID(), acl.From)) {
 				return nil, ErrInvalidAddress(DefaultCodeSpace, fmt.Sprintf("Account %v does not have access to define acl for account %v.", acl.From.String(), acl.To.String()))
 			}
+			if err := k.CheckOrganizationACL(ctx, acl.ACLAccount.GetOrganizationID(), k.GetEffectiveACL(ctx, acl.ACLAccount)); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	err := k.SetACLAccount(ctx, acl.ACLAccount)
+	err := k.DefineACLAccount(ctx, acl.From, acl.To, acl.ACLAccount)
 	if err != nil {
 		return nil, err
 	}
 
-	event := cTypes.NewEvent(
-		EventTypeDefineACL,
-		cTypes.NewAttribute(AttributeACLAccountAddress, acl.To.String()),
-	)
+	return events, nil
+}
 
-	events.AppendEvent(event)
+func handleMsgBlockCounterparties(ctx cTypes.Context, k Keeper, msg MsgBlockCounterparties) cTypes.Result {
+	for _, blockCounterparty := range msg.BlockCounterparties {
+		if err := checkCounterpartyListAuthority(ctx, k, blockCounterparty.From, blockCounterparty.ZoneID,
+			blockCounterparty.OrganizationID); err != nil {
+			return err.Result()
+		}
+		if err := k.BlockCounterparty(ctx, blockCounterparty.ZoneID, blockCounterparty.OrganizationID,
+			blockCounterparty.Address, blockCounterparty.Reason); err != nil {
+			return err.Result()
+		}
+	}
 
-	return events, nil
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgUnblockCounterparties(ctx cTypes.Context, k Keeper, msg MsgUnblockCounterparties) cTypes.Result {
+	for _, unblockCounterparty := range msg.UnblockCounterparties {
+		if err := checkCounterpartyListAuthority(ctx, k, unblockCounterparty.From, unblockCounterparty.ZoneID,
+			unblockCounterparty.OrganizationID); err != nil {
+			return err.Result()
+		}
+		if err := k.UnblockCounterparty(ctx, unblockCounterparty.ZoneID, unblockCounterparty.OrganizationID,
+			unblockCounterparty.Address); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// checkCounterpartyListAuthority : the zone manages its denylist, and the zone, or the address or an admin of the
+// organization or its parents, the blocked partners of the organization
+func checkCounterpartyListAuthority(ctx cTypes.Context, k Keeper, from cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID) cTypes.Error {
+	if len(organizationID) == 0 {
+		if !k.CheckValidZoneAddress(ctx, zoneID, from) {
+			return ErrInvalidAddress(DefaultCodeSpace, fmt.Sprintf("Account %v is not the zone account. The denylist of "+
+				"zone %v can only be managed by the zone account.", from.String(), zoneID.String()))
+		}
+		return nil
+	}
+
+	organization, err := k.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if !k.CheckValidZoneAddress(ctx, organization.ZoneID, from) &&
+		!k.CheckValidOrganizationAddress(ctx, organization.ZoneID, organizationID, from) {
+		return ErrInvalidAddress(DefaultCodeSpace, fmt.Sprintf("Account %v does not have access to manage the blocked "+
+			"counterparties of organization %v.", from.String(), organizationID.String()))
+	}
+	return nil
+}
+
+// handleMsgFreezePegs : the zone of the holder freezes its pegs or its whole wallet
+func handleMsgFreezePegs(ctx cTypes.Context, k Keeper, msg MsgFreezePegs) cTypes.Result {
+	for _, freezePeg := range msg.FreezePegs {
+		if _, err := k.CheckZoneAndGetACL(ctx, freezePeg.From, freezePeg.Address); err != nil {
+			return err.Result()
+		}
+		if err := k.FreezePeg(ctx, freezePeg.Address, freezePeg.PegType, freezePeg.PegHash, freezePeg.Reason); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgUnfreezePegs : the zone that froze a peg or a wallet lifts its freeze
+func handleMsgUnfreezePegs(ctx cTypes.Context, k Keeper, msg MsgUnfreezePegs) cTypes.Result {
+	for _, unfreezePeg := range msg.UnfreezePegs {
+		freeze, found := k.GetFreeze(ctx, unfreezePeg.Address, unfreezePeg.PegType, unfreezePeg.PegHash)
+		if !found {
+			return ErrFrozen(DefaultCodeSpace, "not frozen").Result()
+		}
+		if !k.CheckValidZoneAddress(ctx, freeze.ZoneID, unfreezePeg.From) {
+			return ErrInvalidAddress(DefaultCodeSpace, fmt.Sprintf("Account %v is not the zone account. Freezes of "+
+				"zone %v can only be lifted by the zone account.", unfreezePeg.From.String(), freeze.ZoneID.String())).Result()
+		}
+		if err := k.UnfreezePeg(ctx, unfreezePeg.Address, unfreezePeg.PegType, unfreezePeg.PegHash); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
 }
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// ACL change log
+
+func (keeper Keeper) GetACLChangeLog(ctx cTypes.Context, address cTypes.AccAddress) []aclTypes.ACLChange {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.GetACLChangeLogKey(address))
+	defer iterator.Close()
+
+	var changeLog []aclTypes.ACLChange
+	for ; iterator.Valid(); iterator.Next() {
+		var aclChange aclTypes.ACLChange
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &aclChange)
+		changeLog = append(changeLog, aclChange)
+	}
+
+	return changeLog
+}
+
+func (keeper Keeper) SetACLChangeLog(ctx cTypes.Context, address cTypes.AccAddress, changeLog []aclTypes.ACLChange) {
+	for _, aclChange := range changeLog {
+		keeper.setACLChange(ctx, address, aclChange)
+	}
+}
+
+// setACLChange : stores the change after the changes of the account already recorded at its height
+func (keeper Keeper) setACLChange(ctx cTypes.Context, address cTypes.AccAddress, aclChange aclTypes.ACLChange) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var seq uint64
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.GetACLChangeHeightKey(address, aclChange.Height))
+	for ; iterator.Valid(); iterator.Next() {
+		seq++
+	}
+	iterator.Close()
+
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(aclChange)
+	store.Set(aclTypes.GetACLChangeKey(address, aclChange.Height, seq), bz)
+}
+
+func (keeper Keeper) appendACLChange(ctx cTypes.Context, action string, by cTypes.AccAddress, reason string, aclAccount aclTypes.ACLAccount) {
+	keeper.setACLChange(ctx, aclAccount.GetAddress(), aclTypes.NewACLChange(action, by, reason, ctx.BlockHeight(), aclAccount))
+}
+
+// IsACLAccountActive : neither suspended, revoked nor in a suspended zone
+func (keeper Keeper) IsACLAccountActive(ctx cTypes.Context, aclAccount aclTypes.ACLAccount) bool {
+	if keeper.IsZoneSuspended(ctx, aclAccount.GetZoneID()) {
+		return false
+	}
+	switch aclAccount.GetStatus() {
+	case aclTypes.ACLStatusSuspended, aclTypes.ACLStatusRevoked:
+		return false
+	}
+	return true
+}
+
+// SuspendACLAccount : withdraws all permissions of the account until it is reinstated
+func (keeper Keeper) SuspendACLAccount(ctx cTypes.Context, from cTypes.AccAddress, authority string, address cTypes.AccAddress,
+	reason string) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if status := aclAccount.GetStatus(); status == aclTypes.ACLStatusSuspended || status == aclTypes.ACLStatusRevoked {
+		return aclTypes.ErrInvalidStatus(aclTypes.DefaultCodeSpace, "acl account is already "+status)
+	}
+
+	_ = aclAccount.SetStatusAuthority(authority)
+	return keeper.setACLAccountStatus(ctx, from, aclAccount, aclTypes.ACLStatusSuspended, aclTypes.ACLActionSuspend,
+		aclTypes.EventTypeSuspendACL, reason)
+}
+
+// ReinstateACLAccount : gives a suspended account its permissions back, and lets a revoked account be granted
+// permissions again. Only an authority at least as high as the one that suspended or revoked the account reinstates it.
+func (keeper Keeper) ReinstateACLAccount(ctx cTypes.Context, from cTypes.AccAddress, authority string, address cTypes.AccAddress,
+	reason string) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if status := aclAccount.GetStatus(); status != aclTypes.ACLStatusSuspended && status != aclTypes.ACLStatusRevoked {
+		return aclTypes.ErrInvalidStatus(aclTypes.DefaultCodeSpace, "acl account is not suspended or revoked")
+	}
+	if aclTypes.ACLAuthorityLevel(authority) < aclTypes.ACLAuthorityLevel(aclAccount.GetStatusAuthority()) {
+		return aclTypes.ErrInvalidStatus(aclTypes.DefaultCodeSpace, fmt.Sprintf("acl account was %s by the %s, "+
+			"the %s cannot reinstate it", aclAccount.GetStatus(), aclAccount.GetStatusAuthority(), authority))
+	}
+
+	_ = aclAccount.SetStatusAuthority("")
+	return keeper.setACLAccountStatus(ctx, from, aclAccount, aclTypes.ACLStatusActive, aclTypes.ACLActionReinstate,
+		aclTypes.EventTypeReinstateACL, reason)
+}
+
+// RevokeACLAccount : clears the acl and roles of the account, it can only be granted permissions again once it is
+// reinstated
+func (keeper Keeper) RevokeACLAccount(ctx cTypes.Context, from cTypes.AccAddress, authority string, address cTypes.AccAddress,
+	reason string) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if aclAccount.GetStatus() == aclTypes.ACLStatusRevoked {
+		return aclTypes.ErrInvalidStatus(aclTypes.DefaultCodeSpace, "acl account is already revoked")
+	}
+
+	if aclTypes.ACLAuthorityLevel(authority) > aclTypes.ACLAuthorityLevel(aclAccount.GetStatusAuthority()) {
+		_ = aclAccount.SetStatusAuthority(authority)
+	}
+	_ = aclAccount.SetACL(aclTypes.ACL{})
+	_ = aclAccount.SetRoles(nil)
+	return keeper.setACLAccountStatus(ctx, from, aclAccount, aclTypes.ACLStatusRevoked, aclTypes.ACLActionRevoke,
+		aclTypes.EventTypeRevokeACL, reason)
+}
+
+func (keeper Keeper) setACLAccountStatus(ctx cTypes.Context, from cTypes.AccAddress, aclAccount aclTypes.ACLAccount,
+	status string, action string, eventType string, reason string) cTypes.Error {
+
+	_ = aclAccount.SetStatus(status)
+	if err := keeper.SetACLAccount(ctx, aclAccount); err != nil {
+		return err
+	}
+	keeper.appendACLChange(ctx, action, from, reason, aclAccount)
+
+	ctx.EventManager().EmitEvent(cTypes.NewEvent(
+		eventType,
+		cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, aclAccount.GetAddress().String()),
+		cTypes.NewAttribute(aclTypes.AttributeKeyReason, reason),
+	))
+
+	return nil
+}
+
+// IterateACLChangeLogs : calls handler with the change log of every account until it returns true
+func (keeper Keeper) IterateACLChangeLogs(ctx cTypes.Context,
+	handler func(address cTypes.AccAddress, changeLog []aclTypes.ACLChange) (stop bool)) {
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.ACLChangeLogKey)
+	defer iterator.Close()
+
+	var address cTypes.AccAddress
+	var changeLog []aclTypes.ACLChange
+	for ; iterator.Valid(); iterator.Next() {
+		if entryAddress := aclTypes.GetACLChangeLogAddress(iterator.Key()); !entryAddress.Equals(address) {
+			if len(changeLog) != 0 && handler(address, changeLog) {
+				return
+			}
+			address, changeLog = entryAddress, nil
+		}
+
+		var aclChange aclTypes.ACLChange
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &aclChange)
+		changeLog = append(changeLog, aclChange)
+	}
+	if len(changeLog) != 0 {
+		handler(address, changeLog)
+	}
+}
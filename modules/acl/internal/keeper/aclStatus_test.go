@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func TestPermissionsExpireOneByOne(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	address := cTypes.AccAddress([]byte("address"))
+	require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: address, ZoneID: aclTypes.ZoneID([]byte("zone")),
+		ACL: aclTypes.ACL{SendFiat: true, Negotiation: true, RedeemFiat: true},
+		PermissionExpiries: []aclTypes.PermissionExpiry{
+			aclTypes.NewPermissionExpiry("sendFiat", 10),
+			aclTypes.NewPermissionExpiry("negotiation", 20),
+		},
+		Status: aclTypes.ACLStatusActive}))
+
+	aclAccount, err := k.GetAccountACLDetails(ctx.WithBlockHeight(9), address)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACL{SendFiat: true, Negotiation: true, RedeemFiat: true}, aclAccount.GetACL())
+
+	aclAccount, err = k.GetAccountACLDetails(ctx.WithBlockHeight(10), address)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACL{Negotiation: true, RedeemFiat: true}, aclAccount.GetACL())
+
+	aclAccount, err = k.GetAccountACLDetails(ctx.WithBlockHeight(20), address)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACL{RedeemFiat: true}, aclAccount.GetACL())
+}
+
+func TestACLChangeLogEntries(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	by := cTypes.AccAddress([]byte("zone"))
+	first, second := cTypes.AccAddress([]byte("first")), cTypes.AccAddress([]byte("second"))
+	for _, address := range []cTypes.AccAddress{first, second} {
+		require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: address, ZoneID: aclTypes.ZoneID([]byte("zone")),
+			Status: aclTypes.ACLStatusActive}))
+	}
+
+	// two changes of the same height keep their order
+	require.Nil(t, k.SuspendACLAccount(ctx.WithBlockHeight(5), by, aclTypes.ACLAuthorityZone, first, "audit"))
+	require.Nil(t, k.ReinstateACLAccount(ctx.WithBlockHeight(5), by, aclTypes.ACLAuthorityZone, first, "cleared"))
+	require.Nil(t, k.RevokeACLAccount(ctx.WithBlockHeight(7), by, aclTypes.ACLAuthorityZone, first, "closed"))
+	require.Nil(t, k.SuspendACLAccount(ctx.WithBlockHeight(6), by, aclTypes.ACLAuthorityZone, second, "audit"))
+
+	changeLog := k.GetACLChangeLog(ctx, first)
+	require.Len(t, changeLog, 3)
+	require.Equal(t, aclTypes.ACLActionSuspend, changeLog[0].Action)
+	require.Equal(t, aclTypes.ACLActionReinstate, changeLog[1].Action)
+	require.Equal(t, aclTypes.ACLActionRevoke, changeLog[2].Action)
+	require.Equal(t, "closed", changeLog[2].Reason)
+
+	changeLogs := make(map[string]int)
+	k.IterateACLChangeLogs(ctx, func(address cTypes.AccAddress, changeLog []aclTypes.ACLChange) bool {
+		changeLogs[address.String()] = len(changeLog)
+		return false
+	})
+	require.Equal(t, map[string]int{first.String(): 3, second.String(): 1}, changeLogs)
+}
+
+func TestReinstateNeedsTheSuspendingAuthority(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	by, address := cTypes.AccAddress([]byte("zone")), cTypes.AccAddress([]byte("address"))
+	require.Nil(t, k.DefineACLAccount(ctx, by, address, &aclTypes.BaseACLAccount{Address: address,
+		ZoneID: aclTypes.ZoneID([]byte("zone")), ACL: aclTypes.ACL{SendFiat: true}}))
+
+	require.Nil(t, k.SuspendACLAccount(ctx, by, aclTypes.ACLAuthorityZone, address, "audit"))
+	require.NotNil(t, k.ReinstateACLAccount(ctx, by, aclTypes.ACLAuthorityOrganization, address, "cleared"))
+
+	// defining the acl again keeps the account suspended
+	require.Nil(t, k.DefineACLAccount(ctx, by, address, &aclTypes.BaseACLAccount{Address: address,
+		ZoneID: aclTypes.ZoneID([]byte("zone")), ACL: aclTypes.ACL{SendFiat: true}, Status: aclTypes.ACLStatusActive}))
+	aclAccount, err := k.GetACLAccount(ctx, address)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACLStatusSuspended, aclAccount.GetStatus())
+	require.Equal(t, aclTypes.ACLAuthorityZone, aclAccount.GetStatusAuthority())
+
+	// a revocation by a lower authority keeps the higher one
+	require.Nil(t, k.RevokeACLAccount(ctx, by, aclTypes.ACLAuthorityOrganization, address, "closed"))
+	require.NotNil(t, k.ReinstateACLAccount(ctx, by, aclTypes.ACLAuthorityOrganization, address, "cleared"))
+	require.Nil(t, k.ReinstateACLAccount(ctx, by, aclTypes.ACLAuthorityGenesis, address, "cleared"))
+
+	aclAccount, err = k.GetACLAccount(ctx, address)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACLStatusActive, aclAccount.GetStatus())
+	require.Empty(t, aclAccount.GetStatusAuthority())
+	require.Equal(t, aclTypes.ACL{}, aclAccount.GetACL())
+}
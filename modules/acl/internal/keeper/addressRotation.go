@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// Address rotation
+
+func (keeper Keeper) SetAddressRotation(ctx cTypes.Context, addressRotation aclTypes.AddressRotation) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(addressRotation)
+	store.Set(aclTypes.GetAddressRotationKey(addressRotation.OldAddress, addressRotation.ZoneID, addressRotation.OrganizationID), bz)
+}
+
+// GetAddressRotation : rotation of the zone, or of the organization of the zone, away from the old address
+func (keeper Keeper) GetAddressRotation(ctx cTypes.Context, oldAddress cTypes.AccAddress, zoneID aclTypes.ZoneID,
+	organizationID aclTypes.OrganizationID) (aclTypes.AddressRotation, cTypes.Error) {
+
+	store := ctx.KVStore(keeper.storeKey)
+
+	data := store.Get(aclTypes.GetAddressRotationKey(oldAddress, zoneID, organizationID))
+	if data == nil {
+		return aclTypes.AddressRotation{}, aclTypes.ErrInvalidAddress(aclTypes.DefaultCodeSpace, "address was not rotated")
+	}
+
+	var addressRotation aclTypes.AddressRotation
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &addressRotation)
+
+	return addressRotation, nil
+}
+
+// GetAddressRotations : rotations following the address to the current address of each zone and organization that held it
+func (keeper Keeper) GetAddressRotations(ctx cTypes.Context, oldAddress cTypes.AccAddress) []aclTypes.AddressRotation {
+	var addressRotations []aclTypes.AddressRotation
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.GetAddressRotationsKey(oldAddress))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var addressRotation aclTypes.AddressRotation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &addressRotation)
+
+		for {
+			addressRotations = append(addressRotations, addressRotation)
+			nextAddressRotation, err := keeper.GetAddressRotation(ctx, addressRotation.NewAddress, addressRotation.ZoneID,
+				addressRotation.OrganizationID)
+			if err != nil {
+				break
+			}
+			addressRotation = nextAddressRotation
+		}
+	}
+	return addressRotations
+}
+
+// IterateAddressRotations : calls handler with every address rotation until it returns true
+func (keeper Keeper) IterateAddressRotations(ctx cTypes.Context, handler func(addressRotation aclTypes.AddressRotation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.AddressRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var addressRotation aclTypes.AddressRotation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &addressRotation)
+
+		if handler(addressRotation) {
+			break
+		}
+	}
+}
+
+// checkRotationAddresses : the new address must differ from the old one and the zone or organization must never have been
+// rotated away from it, so that following the rotations of an address always ends
+func (keeper Keeper) checkRotationAddresses(ctx cTypes.Context, oldAddress cTypes.AccAddress, newAddress cTypes.AccAddress,
+	zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID) cTypes.Error {
+
+	if newAddress.Empty() || newAddress.Equals(oldAddress) {
+		return aclTypes.ErrInvalidAddress(aclTypes.DefaultCodeSpace, "new address should differ from the current address")
+	}
+	if _, err := keeper.GetAddressRotation(ctx, newAddress, zoneID, organizationID); err == nil {
+		return aclTypes.ErrInvalidAddress(aclTypes.DefaultCodeSpace, "new address was already rotated away from")
+	}
+	return nil
+}
+
+// RotateOrganizationAddress : moves the organization to a new address, recording the rotation from the old address
+func (keeper Keeper) RotateOrganizationAddress(ctx cTypes.Context, organizationID aclTypes.OrganizationID, newAddress cTypes.AccAddress) cTypes.Error {
+	organization, err := keeper.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	oldAddress := organization.Address
+	if err := keeper.checkRotationAddresses(ctx, oldAddress, newAddress, organization.ZoneID, organizationID); err != nil {
+		return err
+	}
+
+	organization.Address = newAddress
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(aclTypes.GetOrganizationKey(organizationID), keeper.cdc.MustMarshalBinaryLengthPrefixed(organization))
+	keeper.SetAddressRotation(ctx, aclTypes.NewAddressRotation(oldAddress, newAddress, organization.ZoneID, organizationID,
+		ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeRotateOrganizationAddress,
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOldAddress, oldAddress.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationAddress, newAddress.String()),
+		))
+	return nil
+}
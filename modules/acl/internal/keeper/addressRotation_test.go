@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func TestRotationsOfASharedAddress(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID := aclTypes.ZoneID([]byte("zone"))
+	shared, rotated := cTypes.AccAddress([]byte("shared")), cTypes.AccAddress([]byte("rotated"))
+	first, second := aclTypes.OrganizationID([]byte("first")), aclTypes.OrganizationID([]byte("second"))
+	require.Nil(t, k.SetZoneAddress(ctx, zoneID, shared))
+	for _, organizationID := range []aclTypes.OrganizationID{first, second} {
+		require.Nil(t, k.SetOrganization(ctx, organizationID, aclTypes.NewOrganization(shared, zoneID)))
+	}
+
+	// each organization and the zone keep their own rotation of the shared address
+	require.Nil(t, k.RotateOrganizationAddress(ctx, first, rotated))
+	require.Nil(t, k.RotateOrganizationAddress(ctx, second, rotated))
+	require.Nil(t, k.RotateZoneAddress(ctx, zoneID, rotated))
+	require.Len(t, k.GetAddressRotations(ctx, shared), 3)
+
+	addressRotation, err := k.GetAddressRotation(ctx, shared, zoneID, first)
+	require.Nil(t, err)
+	require.Equal(t, rotated, addressRotation.NewAddress)
+
+	// an organization can't rotate back to an address it rotated away from
+	require.NotNil(t, k.RotateOrganizationAddress(ctx, first, shared))
+	require.Nil(t, k.RotateOrganizationAddress(ctx, second, cTypes.AccAddress([]byte("next"))))
+	require.Len(t, k.GetAddressRotations(ctx, shared), 4)
+}
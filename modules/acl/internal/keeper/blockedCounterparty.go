@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// Blocked counterparty
+
+func (keeper Keeper) SetBlockedCounterparty(ctx cTypes.Context, blockedCounterparty aclTypes.BlockedCounterparty) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(blockedCounterparty.GetKey(), keeper.cdc.MustMarshalBinaryLengthPrefixed(blockedCounterparty))
+}
+
+// GetBlockedCounterparty : the address on the blocked partners of the organization, or on the denylist of the zone
+// without an organization id
+func (keeper Keeper) GetBlockedCounterparty(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID,
+	address cTypes.AccAddress) (aclTypes.BlockedCounterparty, bool) {
+
+	store := ctx.KVStore(keeper.storeKey)
+
+	var blockedCounterparty aclTypes.BlockedCounterparty
+	data := store.Get(aclTypes.NewBlockedCounterparty(address, zoneID, organizationID, "", 0).GetKey())
+	if data == nil {
+		return blockedCounterparty, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &blockedCounterparty)
+	return blockedCounterparty, true
+}
+
+// IterateBlockedCounterparties : calls handler with every address on the denylist of a zone and then on the blocked
+// partners of an organization until it returns true
+func (keeper Keeper) IterateBlockedCounterparties(ctx cTypes.Context, handler func(blockedCounterparty aclTypes.BlockedCounterparty) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	for _, prefix := range [][]byte{aclTypes.ZoneDenylistKey, aclTypes.OrganizationBlockedCounterpartyKey} {
+		iterator := cTypes.KVStorePrefixIterator(store, prefix)
+
+		stop := false
+		for ; !stop && iterator.Valid(); iterator.Next() {
+			var blockedCounterparty aclTypes.BlockedCounterparty
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &blockedCounterparty)
+
+			stop = handler(blockedCounterparty)
+		}
+		iterator.Close()
+
+		if stop {
+			return
+		}
+	}
+}
+
+// BlockCounterparty : adds the address to the blocked partners of the organization, or to the denylist of the zone
+// without an organization id
+func (keeper Keeper) BlockCounterparty(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID,
+	address cTypes.AccAddress, reason string) cTypes.Error {
+
+	if len(organizationID) != 0 {
+		organization, err := keeper.GetOrganization(ctx, organizationID)
+		if err != nil {
+			return err
+		}
+		zoneID = organization.ZoneID
+	} else if _, err := keeper.GetZoneAddress(ctx, zoneID); err != nil {
+		return err
+	}
+
+	keeper.SetBlockedCounterparty(ctx, aclTypes.NewBlockedCounterparty(address, zoneID, organizationID, reason, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeBlockCounterparty,
+			cTypes.NewAttribute(aclTypes.AttributeKeyCounterparty, address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, zoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyReason, reason),
+		))
+	return nil
+}
+
+// UnblockCounterparty : removes the address from the blocked partners of the organization, or from the denylist of
+// the zone without an organization id
+func (keeper Keeper) UnblockCounterparty(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID,
+	address cTypes.AccAddress) cTypes.Error {
+
+	blockedCounterparty, found := keeper.GetBlockedCounterparty(ctx, zoneID, organizationID, address)
+	if !found {
+		return aclTypes.ErrInvalidAddress(aclTypes.DefaultCodeSpace, fmt.Sprintf("account %v is not blocked", address.String()))
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(blockedCounterparty.GetKey())
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeUnblockCounterparty,
+			cTypes.NewAttribute(aclTypes.AttributeKeyCounterparty, address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, blockedCounterparty.ZoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()),
+		))
+	return nil
+}
+
+// getBlock : the entry blocking the account from trading with the counterparty, the denylist of the zone of the account
+// holding either of them, or the blocked partners of its organization or of the parents of its organization holding
+// the counterparty
+func (keeper Keeper) getBlock(ctx cTypes.Context, address cTypes.AccAddress, counterparty cTypes.AccAddress) (aclTypes.BlockedCounterparty, bool) {
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return aclTypes.BlockedCounterparty{}, false
+	}
+
+	for _, blocked := range []cTypes.AccAddress{counterparty, address} {
+		if blockedCounterparty, found := keeper.GetBlockedCounterparty(ctx, aclAccount.GetZoneID(), nil, blocked); found {
+			return blockedCounterparty, true
+		}
+	}
+
+	var blockedCounterparty aclTypes.BlockedCounterparty
+	found := false
+	keeper.iterateOrganizationAncestors(ctx, aclAccount.GetOrganizationID(), func(id aclTypes.OrganizationID, _ aclTypes.Organization) bool {
+		blockedCounterparty, found = keeper.GetBlockedCounterparty(ctx, aclAccount.GetZoneID(), id, counterparty)
+		return found
+	})
+	return blockedCounterparty, found
+}
+
+// CheckCounterparty : fails and emits a rejection when either account is blocked from trading with the other
+func (keeper Keeper) CheckCounterparty(ctx cTypes.Context, address cTypes.AccAddress, counterparty cTypes.AccAddress) cTypes.Error {
+	blockedCounterparty, found := keeper.getBlock(ctx, address, counterparty)
+	if !found {
+		blockedCounterparty, found = keeper.getBlock(ctx, counterparty, address)
+	}
+	if !found {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeRejectCounterparty,
+			cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyCounterparty, counterparty.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, blockedCounterparty.ZoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, blockedCounterparty.OrganizationID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyReason, blockedCounterparty.Reason),
+		))
+	return aclTypes.ErrCounterpartyBlocked(aclTypes.DefaultCodeSpace, fmt.Sprintf("accounts %v and %v are blocked from "+
+		"trading, account %v is blocked: %s", address.String(), counterparty.String(), blockedCounterparty.Address.String(),
+		blockedCounterparty.Reason))
+}
+
+// GetBlockedCounterpartiesPage : page of the blocked partners of the organization, or of the denylist of the zone
+// without an organization id
+func (keeper Keeper) GetBlockedCounterpartiesPage(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID,
+	page int, limit int) []aclTypes.BlockedCounterparty {
+
+	prefix := aclTypes.GetZoneDenylistPrefix(zoneID)
+	if len(organizationID) != 0 {
+		prefix = aclTypes.GetOrganizationBlockedCounterpartiesKey(organizationID)
+	}
+
+	blockedCounterparties := []aclTypes.BlockedCounterparty{}
+	keeper.iteratePage(ctx, prefix, page, limit, func(_ []byte, value []byte) {
+		var blockedCounterparty aclTypes.BlockedCounterparty
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &blockedCounterparty)
+		blockedCounterparties = append(blockedCounterparties, blockedCounterparty)
+	})
+	return blockedCounterparties
+}
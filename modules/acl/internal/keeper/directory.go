@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+const defaultPageLimit = 100
+
+// iteratePage : calls handler with the entries of the page of the prefix, pages start at 1
+func (keeper Keeper) iteratePage(ctx cTypes.Context, prefix []byte, page int, limit int, handler func(key []byte, value []byte)) {
+	keeper.iterateRangePage(ctx, prefix, cTypes.PrefixEndBytes(prefix), page, limit, handler)
+}
+
+// iterateRangePage : calls handler with the entries of the page of the keys from start up to end
+func (keeper Keeper) iterateRangePage(ctx cTypes.Context, start []byte, end []byte, page int, limit int,
+	handler func(key []byte, value []byte)) {
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for skip := (page - 1) * limit; skip > 0 && iterator.Valid(); skip-- {
+		iterator.Next()
+	}
+	for ; limit > 0 && iterator.Valid(); limit-- {
+		handler(iterator.Key(), iterator.Value())
+		iterator.Next()
+	}
+}
+
+// GetZonesPage : page of the zones
+func (keeper Keeper) GetZonesPage(ctx cTypes.Context, page int, limit int) []aclTypes.GenesisZone {
+	zones := []aclTypes.GenesisZone{}
+	keeper.iteratePage(ctx, aclTypes.ZoneKey, page, limit, func(key []byte, value []byte) {
+		var address cTypes.AccAddress
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &address)
+
+		zoneID := aclTypes.ZoneID(key[len(aclTypes.ZoneKey):])
+		zones = append(zones, aclTypes.GenesisZone{ZoneID: zoneID, Address: address, Suspended: keeper.IsZoneSuspended(ctx, zoneID)})
+	})
+	return zones
+}
+
+// GetOrganizationsPage : page of the organizations of the zone, read through the zone organization index
+func (keeper Keeper) GetOrganizationsPage(ctx cTypes.Context, zoneID aclTypes.ZoneID, page int, limit int) []aclTypes.GenesisOrganization {
+	prefix := aclTypes.GetZoneOrganizationsKey(zoneID)
+
+	organizations := []aclTypes.GenesisOrganization{}
+	keeper.iteratePage(ctx, prefix, page, limit, func(key []byte, _ []byte) {
+		organizationID := aclTypes.OrganizationID(key[len(prefix):])
+
+		organization, err := keeper.GetOrganization(ctx, organizationID)
+		if err != nil {
+			return
+		}
+		organizations = append(organizations, aclTypes.NewGenesisOrganization(organizationID, organization))
+	})
+	return organizations
+}
+
+// GetACLAccountsPage : page of the acl accounts of the organization, read through the organization acl account index
+func (keeper Keeper) GetACLAccountsPage(ctx cTypes.Context, organizationID aclTypes.OrganizationID, page int, limit int) []aclTypes.ACLAccount {
+	prefix := aclTypes.GetOrganizationACLAccountsKey(organizationID)
+
+	accounts := []aclTypes.ACLAccount{}
+	keeper.iteratePage(ctx, prefix, page, limit, func(key []byte, _ []byte) {
+		aclAccount, err := keeper.GetACLAccount(ctx, cTypes.AccAddress(key[len(prefix):]))
+		if err != nil {
+			return
+		}
+		accounts = append(accounts, aclAccount)
+	})
+	return accounts
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func TestIterateRangePage(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	for _, zone := range []string{"a", "b", "c", "d", "e"} {
+		require.Nil(t, k.SetZoneAddress(ctx, aclTypes.ZoneID([]byte(zone)), cTypes.AccAddress([]byte(zone))))
+	}
+	page := func(start string, end string, page int, limit int) []string {
+		var zones []string
+		k.iterateRangePage(ctx, aclTypes.GetZoneKey(aclTypes.ZoneID([]byte(start))), aclTypes.GetZoneKey(aclTypes.ZoneID([]byte(end))),
+			page, limit, func(key []byte, _ []byte) {
+				zones = append(zones, string(key[len(aclTypes.ZoneKey):]))
+			})
+		return zones
+	}
+
+	// the end of the range is excluded
+	require.Equal(t, []string{"b", "c"}, page("b", "e", 1, 2))
+	require.Equal(t, []string{"d"}, page("b", "e", 2, 2))
+	require.Empty(t, page("b", "e", 3, 2))
+
+	// pages start at 1 and a missing limit takes the default
+	require.Equal(t, []string{"b", "c"}, page("b", "e", 0, 2))
+	require.Equal(t, []string{"b", "c", "d"}, page("b", "e", 1, 0))
+}
+
+func TestDirectoryPages(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID, otherZoneID := aclTypes.ZoneID([]byte("zone")), aclTypes.ZoneID([]byte("other"))
+	for _, zone := range []aclTypes.ZoneID{zoneID, otherZoneID} {
+		require.Nil(t, k.SetZoneAddress(ctx, zone, cTypes.AccAddress(zone)))
+	}
+	for _, organization := range []string{"org1", "org2", "org3"} {
+		require.Nil(t, k.SetOrganization(ctx, aclTypes.OrganizationID([]byte(organization)),
+			aclTypes.NewOrganization(cTypes.AccAddress([]byte(organization)), zoneID)))
+	}
+	require.Nil(t, k.SetOrganization(ctx, aclTypes.OrganizationID([]byte("org4")),
+		aclTypes.NewOrganization(cTypes.AccAddress([]byte("org4")), otherZoneID)))
+	for _, address := range []string{"member1", "member2", "member3"} {
+		require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: cTypes.AccAddress([]byte(address)), ZoneID: zoneID,
+			OrganizationID: aclTypes.OrganizationID([]byte("org1")), Status: aclTypes.ACLStatusActive}))
+	}
+
+	require.Len(t, k.GetZonesPage(ctx, 1, 1), 1)
+	require.Len(t, k.GetZonesPage(ctx, 2, 1), 1)
+	require.Empty(t, k.GetZonesPage(ctx, 3, 1))
+
+	// organizations of a zone page through the zone index only
+	organizations := k.GetOrganizationsPage(ctx, zoneID, 1, 2)
+	require.Len(t, organizations, 2)
+	require.Equal(t, aclTypes.OrganizationID([]byte("org1")), organizations[0].OrganizationID)
+	require.Equal(t, aclTypes.OrganizationID([]byte("org2")), organizations[1].OrganizationID)
+	organizations = k.GetOrganizationsPage(ctx, zoneID, 2, 2)
+	require.Len(t, organizations, 1)
+	require.Equal(t, aclTypes.OrganizationID([]byte("org3")), organizations[0].OrganizationID)
+	require.Empty(t, k.GetOrganizationsPage(ctx, zoneID, 3, 2))
+	require.Len(t, k.GetOrganizationsPage(ctx, otherZoneID, 1, 0), 1)
+
+	accounts := k.GetACLAccountsPage(ctx, aclTypes.OrganizationID([]byte("org1")), 2, 2)
+	require.Len(t, accounts, 1)
+	require.Equal(t, cTypes.AccAddress([]byte("member3")), accounts[0].GetAddress())
+	require.Len(t, k.GetACLAccountsPage(ctx, aclTypes.OrganizationID([]byte("org1")), 1, 0), 3)
+	require.Empty(t, k.GetACLAccountsPage(ctx, aclTypes.OrganizationID([]byte("org2")), 1, 0))
+}
+
+func TestACLAccountMovesBetweenOrganizations(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID := aclTypes.ZoneID([]byte("zone"))
+	from, to := aclTypes.OrganizationID([]byte("from")), aclTypes.OrganizationID([]byte("to"))
+	member := cTypes.AccAddress([]byte("member"))
+	require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: member, ZoneID: zoneID, OrganizationID: from,
+		Status: aclTypes.ACLStatusActive}))
+	require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: member, ZoneID: zoneID, OrganizationID: to,
+		Status: aclTypes.ACLStatusActive}))
+
+	// the index entry of the old organization is deleted
+	require.False(t, ctx.KVStore(k.storeKey).Has(aclTypes.GetOrganizationACLAccountKey(from, member)))
+	require.Empty(t, k.GetACLAccountsPage(ctx, from, 1, 0))
+
+	accounts := k.GetACLAccountsPage(ctx, to, 1, 0)
+	require.Len(t, accounts, 1)
+	require.Equal(t, member, accounts[0].GetAddress())
+}
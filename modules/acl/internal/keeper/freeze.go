@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// Freeze
+
+func (keeper Keeper) SetFreeze(ctx cTypes.Context, freeze aclTypes.Freeze) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(freeze.GetKey(), keeper.cdc.MustMarshalBinaryLengthPrefixed(freeze))
+}
+
+// GetFreeze : the freeze of the asset or fiat peg, or of the whole wallet of the address without a peg hash
+func (keeper Keeper) GetFreeze(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHash types.PegHash) (aclTypes.Freeze, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var freeze aclTypes.Freeze
+	data := store.Get(aclTypes.NewFreeze(nil, address, pegType, pegHash, "", 0).GetKey())
+	if data == nil {
+		return freeze, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &freeze)
+	return freeze, true
+}
+
+// IterateFreezes : calls handler with every frozen wallet and then every frozen peg until it returns true
+func (keeper Keeper) IterateFreezes(ctx cTypes.Context, handler func(freeze aclTypes.Freeze) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	for _, prefix := range [][]byte{aclTypes.FrozenWalletKey, aclTypes.FrozenPegKey} {
+		iterator := cTypes.KVStorePrefixIterator(store, prefix)
+
+		stop := false
+		for ; !stop && iterator.Valid(); iterator.Next() {
+			var freeze aclTypes.Freeze
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &freeze)
+
+			stop = handler(freeze)
+		}
+		iterator.Close()
+
+		if stop {
+			return
+		}
+	}
+}
+
+// GetFreezes : the freezes of the whole wallet of the address and of the pegs in it
+func (keeper Keeper) GetFreezes(ctx cTypes.Context, address cTypes.AccAddress) []aclTypes.Freeze {
+	freezes := []aclTypes.Freeze{}
+	if freeze, found := keeper.GetFreeze(ctx, address, "", nil); found {
+		freezes = append(freezes, freeze)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.GetFrozenPegsKey(address))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var freeze aclTypes.Freeze
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &freeze)
+		freezes = append(freezes, freeze)
+	}
+	return freezes
+}
+
+// FreezePeg : freezes the asset or fiat peg in the wallet of the address, or its whole wallet without a peg hash, for
+// the zone of the address, the peg stays movable by its other holders
+func (keeper Keeper) FreezePeg(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHash types.PegHash,
+	reason string) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if _, found := keeper.GetFreeze(ctx, address, pegType, pegHash); found {
+		return aclTypes.ErrFrozen(aclTypes.DefaultCodeSpace, "already frozen")
+	}
+
+	freeze := aclTypes.NewFreeze(aclAccount.GetZoneID(), address, pegType, pegHash, reason, ctx.BlockHeight())
+	keeper.SetFreeze(ctx, freeze)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeFreeze,
+			cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, freeze.ZoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyPegType, pegType),
+			cTypes.NewAttribute(aclTypes.AttributeKeyPegHash, pegHash.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyReason, reason),
+		))
+	return nil
+}
+
+// UnfreezePeg : lifts the freeze of the asset or fiat peg in the wallet of the address, or of its whole wallet without a
+// peg hash
+func (keeper Keeper) UnfreezePeg(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHash types.PegHash) cTypes.Error {
+	freeze, found := keeper.GetFreeze(ctx, address, pegType, pegHash)
+	if !found {
+		return aclTypes.ErrFrozen(aclTypes.DefaultCodeSpace, "not frozen")
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(freeze.GetKey())
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeUnfreeze,
+			cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, freeze.Address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, freeze.ZoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyPegType, pegType),
+			cTypes.NewAttribute(aclTypes.AttributeKeyPegHash, pegHash.String()),
+		))
+	return nil
+}
+
+// CheckFrozen : fails when the wallet of the address or any of its pegs of the peg type is frozen
+func (keeper Keeper) CheckFrozen(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHashes ...types.PegHash) cTypes.Error {
+	if freeze, found := keeper.GetFreeze(ctx, address, "", nil); found {
+		return aclTypes.ErrFrozen(aclTypes.DefaultCodeSpace, fmt.Sprintf("wallet of %v is frozen: %s", address.String(),
+			freeze.Reason))
+	}
+	for _, pegHash := range pegHashes {
+		if freeze, found := keeper.GetFreeze(ctx, address, pegType, pegHash); found {
+			return aclTypes.ErrFrozen(aclTypes.DefaultCodeSpace, fmt.Sprintf("%s peg %v is frozen: %s", pegType,
+				pegHash.String(), freeze.Reason))
+		}
+	}
+	return nil
+}
+
+// Clawback
+
+func (keeper Keeper) SetClawback(ctx cTypes.Context, clawback aclTypes.Clawback) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(clawback.GetKey(), keeper.cdc.MustMarshalBinaryLengthPrefixed(clawback))
+}
+
+// RecordClawback : records the return of the peg from the address to the issuer with its reason, for the zone of the
+// address, and lifts the freeze of the peg in the wallet of the address, freezes of its other holders stay
+func (keeper Keeper) RecordClawback(ctx cTypes.Context, fromAddress cTypes.AccAddress, issuerAddress cTypes.AccAddress,
+	pegType string, pegHash types.PegHash, amount int64, reason string) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, fromAddress)
+	if err != nil {
+		return err
+	}
+	keeper.SetClawback(ctx, aclTypes.NewClawback(aclAccount.GetZoneID(), fromAddress, issuerAddress, pegType, pegHash,
+		amount, reason, ctx.BlockHeight()))
+
+	if freeze, found := keeper.GetFreeze(ctx, fromAddress, pegType, pegHash); found {
+		store := ctx.KVStore(keeper.storeKey)
+		store.Delete(freeze.GetKey())
+	}
+	return nil
+}
+
+// IterateClawbacks : calls handler with every clawback until it returns true
+func (keeper Keeper) IterateClawbacks(ctx cTypes.Context, handler func(clawback aclTypes.Clawback) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.ClawbackKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var clawback aclTypes.Clawback
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &clawback)
+
+		if handler(clawback) {
+			return
+		}
+	}
+}
+
+// GetClawbacks : the clawbacks of the peg by height
+func (keeper Keeper) GetClawbacks(ctx cTypes.Context, pegType string, pegHash types.PegHash) []aclTypes.Clawback {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.GetPegClawbacksKey(pegType, pegHash))
+	defer iterator.Close()
+
+	clawbacks := []aclTypes.Clawback{}
+	for ; iterator.Valid(); iterator.Next() {
+		var clawback aclTypes.Clawback
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &clawback)
+		clawbacks = append(clawbacks, clawback)
+	}
+	return clawbacks
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+func TestFreezesOfAHolder(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID, pegHash := aclTypes.ZoneID([]byte("zone")), types.PegHash([]byte("fiat"))
+	holder, other := cTypes.AccAddress([]byte("holder")), cTypes.AccAddress([]byte("other"))
+	for _, address := range []cTypes.AccAddress{holder, other} {
+		require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: address, ZoneID: zoneID,
+			Status: aclTypes.ACLStatusActive}))
+	}
+
+	// the peg is frozen in the wallet of the holder only
+	require.Nil(t, k.FreezePeg(ctx, holder, aclTypes.PegTypeFiat, pegHash, "dispute"))
+	require.NotNil(t, k.FreezePeg(ctx, holder, aclTypes.PegTypeFiat, pegHash, "dispute"))
+	require.NotNil(t, k.CheckFrozen(ctx, holder, aclTypes.PegTypeFiat, pegHash))
+	require.Nil(t, k.CheckFrozen(ctx, other, aclTypes.PegTypeFiat, pegHash))
+	require.Nil(t, k.CheckFrozen(ctx, holder, aclTypes.PegTypeAsset, pegHash))
+
+	require.Nil(t, k.FreezePeg(ctx, other, "", nil, "audit"))
+	require.NotNil(t, k.CheckFrozen(ctx, other, aclTypes.PegTypeFiat))
+	require.Len(t, k.GetFreezes(ctx, holder), 1)
+	require.Len(t, k.GetFreezes(ctx, other), 1)
+
+	require.Nil(t, k.UnfreezePeg(ctx, other, "", nil))
+	require.Nil(t, k.CheckFrozen(ctx, other, aclTypes.PegTypeFiat, pegHash))
+}
+
+func TestRecordClawbackLiftsTheFreezeOfTheHolder(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID, pegHash := aclTypes.ZoneID([]byte("zone")), types.PegHash([]byte("fiat"))
+	holder, other := cTypes.AccAddress([]byte("holder")), cTypes.AccAddress([]byte("other"))
+	for _, address := range []cTypes.AccAddress{holder, other} {
+		require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: address, ZoneID: zoneID,
+			Status: aclTypes.ACLStatusActive}))
+		require.Nil(t, k.FreezePeg(ctx, address, aclTypes.PegTypeFiat, pegHash, "dispute"))
+	}
+
+	issuer := cTypes.AccAddress([]byte("issuer"))
+	require.Nil(t, k.RecordClawback(ctx, holder, issuer, aclTypes.PegTypeFiat, pegHash, 100, "fraud"))
+	require.Nil(t, k.CheckFrozen(ctx, holder, aclTypes.PegTypeFiat, pegHash))
+	require.NotNil(t, k.CheckFrozen(ctx, other, aclTypes.PegTypeFiat, pegHash))
+
+	clawbacks := k.GetClawbacks(ctx, aclTypes.PegTypeFiat, pegHash)
+	require.Len(t, clawbacks, 1)
+	require.Equal(t, issuer, clawbacks[0].IssuerAddress)
+	require.Equal(t, int64(100), clawbacks[0].Amount)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// register acl invariants
+func RegisterInvariants(ir cTypes.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(aclTypes.ModuleName, "account-references",
+		AccountReferencesInvariant(keeper))
+}
+
+// AccountReferencesInvariant checks that every acl account references a zone and an organization of that zone that exist
+func AccountReferencesInvariant(keeper Keeper) cTypes.Invariant {
+	return func(ctx cTypes.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, aclAccount := range keeper.GetACLAccounts(ctx) {
+			if _, err := keeper.GetZoneAddress(ctx, aclAccount.GetZoneID()); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s references zone %s which doesn't exist\n",
+					aclAccount.GetAddress().String(), aclAccount.GetZoneID().String())
+				continue
+			}
+
+			organization, err := keeper.GetOrganization(ctx, aclAccount.GetOrganizationID())
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s references organization %s which doesn't exist\n",
+					aclAccount.GetAddress().String(), aclAccount.GetOrganizationID().String())
+			} else if organization.ZoneID.String() != aclAccount.GetZoneID().String() {
+				count++
+				msg += fmt.Sprintf("\t%s references organization %s of zone %s instead of zone %s\n",
+					aclAccount.GetAddress().String(), aclAccount.GetOrganizationID().String(),
+					organization.ZoneID.String(), aclAccount.GetZoneID().String())
+			}
+		}
+		broken := count != 0
+
+		return cTypes.FormatInvariant(aclTypes.ModuleName, "account-references",
+			fmt.Sprintf("amount of acl accounts with broken references found %d\n%s", count, msg), broken)
+	}
+}
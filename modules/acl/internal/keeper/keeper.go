@@ -70,6 +70,10 @@ func (keeper Keeper) SetOrganization(ctx cTypes.Context, id aclTypes.Organizatio
 
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(organization)
 	store.Set(aclTypes.GetOrganizationKey(id), bz)
+	store.Set(aclTypes.GetZoneOrganizationKey(organization.ZoneID, id), []byte{})
+	if len(organization.ParentID) != 0 {
+		store.Set(aclTypes.GetSubOrganizationKey(organization.ParentID, id), []byte{})
+	}
 
 	return nil
 }
@@ -107,10 +111,14 @@ func (keeper Keeper) GetOrganizations(ctx cTypes.Context) []aclTypes.Organizatio
 func (keeper Keeper) GetOrganizationsByZoneID(ctx cTypes.Context, id aclTypes.ZoneID) []aclTypes.Organization {
 	var organizationList []aclTypes.Organization
 
-	organizations := keeper.GetOrganizations(ctx)
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := aclTypes.GetZoneOrganizationsKey(id)
+	iterator := cTypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
 
-	for _, organization := range organizations {
-		if strings.EqualFold(organization.ZoneID.String(), id.String()) {
+	for ; iterator.Valid(); iterator.Next() {
+		organization, err := keeper.GetOrganization(ctx, aclTypes.OrganizationID(iterator.Key()[len(prefix):]))
+		if err == nil {
 			organizationList = append(organizationList, organization)
 		}
 	}
@@ -123,8 +131,13 @@ func (keeper Keeper) GetOrganizationsByZoneID(ctx cTypes.Context, id aclTypes.Zo
 func (keeper Keeper) SetACLAccount(ctx cTypes.Context, acl aclTypes.ACLAccount) cTypes.Error {
 	store := ctx.KVStore(keeper.storeKey)
 
+	if oldACL, err := keeper.GetACLAccount(ctx, acl.GetAddress()); err == nil {
+		store.Delete(aclTypes.GetOrganizationACLAccountKey(oldACL.GetOrganizationID(), oldACL.GetAddress()))
+	}
+
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(acl)
 	store.Set(aclTypes.GetACLAccountKey(acl.GetAddress()), bz)
+	store.Set(aclTypes.GetOrganizationACLAccountKey(acl.GetOrganizationID(), acl.GetAddress()), []byte{})
 
 	return nil
 }
@@ -159,6 +172,10 @@ func (keeper Keeper) GetACLAccounts(ctx cTypes.Context) []aclTypes.ACLAccount {
 }
 
 func (keeper Keeper) CheckValidZoneAddress(ctx cTypes.Context, id aclTypes.ZoneID, address cTypes.AccAddress) bool {
+	if keeper.IsZoneSuspended(ctx, id) {
+		return false
+	}
+
 	zoneAddress, err := keeper.GetZoneAddress(ctx, id)
 	if err != nil {
 		return false
@@ -171,6 +188,7 @@ func (keeper Keeper) CheckValidZoneAddress(ctx cTypes.Context, id aclTypes.ZoneI
 	return true
 }
 
+// CheckValidOrganizationAddress : address is the address or an admin of the organization or of one of its parent organizations
 func (keeper Keeper) CheckValidOrganizationAddress(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID, address cTypes.AccAddress) bool {
 
 	organization, err := keeper.GetOrganization(ctx, organizationID)
@@ -178,11 +196,16 @@ func (keeper Keeper) CheckValidOrganizationAddress(ctx cTypes.Context, zoneID ac
 		return false
 	}
 
-	if !(strings.EqualFold(organization.ZoneID.String(), zoneID.String()) && strings.EqualFold(organization.Address.String(), address.String())) {
+	if !strings.EqualFold(organization.ZoneID.String(), zoneID.String()) {
 		return false
 	}
 
-	return true
+	found := false
+	keeper.iterateOrganizationAncestors(ctx, organizationID, func(_ aclTypes.OrganizationID, ancestor aclTypes.Organization) bool {
+		found = ancestor.IsAdmin(address)
+		return found
+	})
+	return found
 }
 
 func (keeper Keeper) CheckValidGenesisAddress(ctx cTypes.Context, address cTypes.AccAddress) bool {
@@ -196,11 +219,19 @@ func (keeper Keeper) CheckValidGenesisAddress(ctx cTypes.Context, address cTypes
 
 	return false
 }
+
+// GetAccountACLDetails : acl account with its effective permissions, its own unexpired permissions and the acl of its roles
+// bounded by the acl of its organization and parents, or no permissions at all while it is suspended or revoked
 func (keeper Keeper) GetAccountACLDetails(ctx cTypes.Context, address cTypes.AccAddress) (aclTypes.ACLAccount, cTypes.Error) {
 	aclAccount, err := keeper.GetACLAccount(ctx, address)
 	if err != nil {
 		return nil, err
 	}
+	if !keeper.IsACLAccountActive(ctx, aclAccount) {
+		_ = aclAccount.SetACL(aclTypes.ACL{})
+		return aclAccount, nil
+	}
+	_ = aclAccount.SetACL(keeper.BoundOrganizationACL(ctx, aclAccount.GetOrganizationID(), keeper.GetEffectiveACL(ctx, aclAccount)))
 	return aclAccount, nil
 }
 
@@ -259,12 +290,22 @@ func (keeper Keeper) DefineOrganizationAddress(ctx cTypes.Context, to cTypes.Acc
 	return nil
 }
 
-func (keeper Keeper) DefineACLAccount(ctx cTypes.Context, toAddress cTypes.AccAddress, aclAccount aclTypes.ACLAccount) cTypes.Error {
+// DefineACLAccount : defines the acl of the account. An account already defined keeps its status, suspended and revoked
+// accounts are only reactivated by reinstating them.
+func (keeper Keeper) DefineACLAccount(ctx cTypes.Context, from cTypes.AccAddress, toAddress cTypes.AccAddress, aclAccount aclTypes.ACLAccount) cTypes.Error {
 
+	if oldACLAccount, err := keeper.GetACLAccount(ctx, aclAccount.GetAddress()); err == nil {
+		_ = aclAccount.SetStatus(oldACLAccount.GetStatus())
+		_ = aclAccount.SetStatusAuthority(oldACLAccount.GetStatusAuthority())
+	} else {
+		_ = aclAccount.SetStatus(aclTypes.ACLStatusActive)
+		_ = aclAccount.SetStatusAuthority("")
+	}
 	err := keeper.SetACLAccount(ctx, aclAccount)
 	if err != nil {
 		return err
 	}
+	keeper.appendACLChange(ctx, aclTypes.ACLActionDefine, from, "", aclAccount)
 
 	ctx.EventManager().EmitEvent(cTypes.NewEvent(
 		aclTypes.EventTypeDefineACL,
@@ -273,3 +314,37 @@ func (keeper Keeper) DefineACLAccount(ctx cTypes.Context, toAddress cTypes.AccAd
 
 	return nil
 }
+
+// IterateZones : calls handler with every zone until it returns true
+func (keeper Keeper) IterateZones(ctx cTypes.Context, handler func(zoneID aclTypes.ZoneID, address cTypes.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.ZoneKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var address cTypes.AccAddress
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &address)
+
+		if handler(aclTypes.ZoneID(iterator.Key()[len(aclTypes.ZoneKey):]), address) {
+			break
+		}
+	}
+}
+
+// IterateOrganizations : calls handler with every organization until it returns true
+func (keeper Keeper) IterateOrganizations(ctx cTypes.Context,
+	handler func(organizationID aclTypes.OrganizationID, organization aclTypes.Organization) (stop bool)) {
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.OrganizationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var organization aclTypes.Organization
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &organization)
+
+		if handler(aclTypes.OrganizationID(iterator.Key()[len(aclTypes.OrganizationKey):]), organization) {
+			break
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// KYC
+
+// SetKYC : stores the kyc and indexes it by zone and expiry height
+func (keeper Keeper) SetKYC(ctx cTypes.Context, kyc aclTypes.KYC) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if oldKYC, err := keeper.GetKYC(ctx, kyc.Address); err == nil {
+		store.Delete(aclTypes.GetKYCExpiryKey(oldKYC.ZoneID, oldKYC.ExpiresAt, oldKYC.Address))
+	}
+
+	store.Set(aclTypes.GetKYCKey(kyc.Address), keeper.cdc.MustMarshalBinaryLengthPrefixed(kyc))
+	if kyc.ExpiresAt != 0 {
+		store.Set(aclTypes.GetKYCExpiryKey(kyc.ZoneID, kyc.ExpiresAt, kyc.Address), []byte{})
+	}
+}
+
+func (keeper Keeper) GetKYC(ctx cTypes.Context, address cTypes.AccAddress) (aclTypes.KYC, cTypes.Error) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	data := store.Get(aclTypes.GetKYCKey(address))
+	if data == nil {
+		return aclTypes.KYC{}, aclTypes.ErrInvalidAddress(aclTypes.DefaultCodeSpace, "kyc for this account not recorded")
+	}
+
+	var kyc aclTypes.KYC
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &kyc)
+
+	return kyc, nil
+}
+
+// IterateKYCs : calls handler with every kyc until it returns true
+func (keeper Keeper) IterateKYCs(ctx cTypes.Context, handler func(kyc aclTypes.KYC) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.KYCKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var kyc aclTypes.KYC
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &kyc)
+
+		if handler(kyc) {
+			break
+		}
+	}
+}
+
+// RecordKYC : records the kyc of an acl account of the zone, verified at the current height
+func (keeper Keeper) RecordKYC(ctx cTypes.Context, zoneID aclTypes.ZoneID, address cTypes.AccAddress, level int64,
+	documentHash string, expiresAt int64) cTypes.Error {
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if aclAccount.GetZoneID().String() != zoneID.String() {
+		return aclTypes.ErrInvalidID(aclTypes.DefaultCodeSpace, fmt.Sprintf("account %v is not an account of zone %v",
+			address.String(), zoneID.String()))
+	}
+	if expiresAt != 0 && expiresAt <= ctx.BlockHeight() {
+		return aclTypes.ErrKYCExpired(aclTypes.DefaultCodeSpace, "kyc should expire after the current height")
+	}
+
+	keeper.SetKYC(ctx, aclTypes.NewKYC(address, level, zoneID, documentHash, ctx.BlockHeight(), expiresAt))
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeRecordKYC,
+			cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, address.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, zoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyKYCLevel, strconv.FormatInt(level, 10)),
+			cTypes.NewAttribute(aclTypes.AttributeKeyKYCExpiresAt, strconv.FormatInt(expiresAt, 10)),
+		))
+	return nil
+}
+
+// CheckKYC : fails for accounts whose kyc expired, accounts without kyc are not checked
+func (keeper Keeper) CheckKYC(ctx cTypes.Context, address cTypes.AccAddress) cTypes.Error {
+	kyc, err := keeper.GetKYC(ctx, address)
+	if err != nil {
+		return nil
+	}
+	if kyc.IsExpired(ctx.BlockHeight()) {
+		return aclTypes.ErrKYCExpired(aclTypes.DefaultCodeSpace, fmt.Sprintf("kyc of account %v expired at height %d",
+			address.String(), kyc.ExpiresAt))
+	}
+	return nil
+}
+
+// GetExpiringKYCsPage : page of the kyc of the zone expiring at or before the height, soonest first
+func (keeper Keeper) GetExpiringKYCsPage(ctx cTypes.Context, zoneID aclTypes.ZoneID, height int64, page int, limit int) []aclTypes.KYC {
+	prefix := aclTypes.GetKYCExpiriesKey(zoneID)
+
+	kycs := []aclTypes.KYC{}
+	keeper.iterateRangePage(ctx, prefix, aclTypes.GetKYCExpiryHeightKey(zoneID, height+1), page, limit, func(key []byte, _ []byte) {
+		kyc, err := keeper.GetKYC(ctx, cTypes.AccAddress(key[len(prefix)+8:]))
+		if err != nil {
+			return
+		}
+		kycs = append(kycs, kyc)
+	})
+	return kycs
+}
@@ -9,12 +9,27 @@ import (
 	"github.com/commitHub/commitBlockchain/codec"
 
 	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	"github.com/commitHub/commitBlockchain/types"
 )
 
 const (
-	QueryZone         = "queryZone"
-	QueryOrganization = "queryOrganization"
-	QueryACLAccount   = "queryACLAccount"
+	QueryZone             = "queryZone"
+	QueryOrganization     = "queryOrganization"
+	QueryACLAccount       = "queryACLAccount"
+	QueryRoles            = "queryRoles"
+	QueryACLChangeLog     = "queryACLChangeLog"
+	QueryZones            = "queryZones"
+	QueryOrganizations    = "queryOrganizations"
+	QueryACLAccounts      = "queryACLAccounts"
+	QueryAddressRotations = "queryAddressRotations"
+	QuerySubOrganizations = "querySubOrganizations"
+	QueryKYC              = "queryKYC"
+	QueryExpiringKYCs     = "queryExpiringKYCs"
+	QueryTradingHeadroom  = "queryTradingHeadroom"
+
+	QueryBlockedCounterparties = "queryBlockedCounterparties"
+	QueryFreezes               = "queryFreezes"
+	QueryClawbacks             = "queryClawbacks"
 )
 
 func NewQuerier(k Keeper) cTypes.Querier {
@@ -26,6 +41,32 @@ func NewQuerier(k Keeper) cTypes.Querier {
 			return queryOrganization(ctx, path[1:], k)
 		case QueryACLAccount:
 			return queryACLAccount(ctx, path[1:], k)
+		case QueryRoles:
+			return queryRoles(ctx, path[1:], k)
+		case QueryACLChangeLog:
+			return queryACLChangeLog(ctx, path[1:], k)
+		case QueryZones:
+			return queryZones(ctx, req, k)
+		case QueryOrganizations:
+			return queryOrganizations(ctx, req, k)
+		case QueryACLAccounts:
+			return queryACLAccounts(ctx, req, k)
+		case QuerySubOrganizations:
+			return querySubOrganizations(ctx, req, k)
+		case QueryKYC:
+			return queryKYC(ctx, path[1:], k)
+		case QueryExpiringKYCs:
+			return queryExpiringKYCs(ctx, req, k)
+		case QueryTradingHeadroom:
+			return queryTradingHeadroom(ctx, path[1:], k)
+		case QueryBlockedCounterparties:
+			return queryBlockedCounterparties(ctx, req, k)
+		case QueryFreezes:
+			return queryFreezes(ctx, path[1:], k)
+		case QueryClawbacks:
+			return queryClawbacks(ctx, path[1:], k)
+		case QueryAddressRotations:
+			return queryAddressRotations(ctx, path[1:], k)
 		default:
 			return nil, cTypes.ErrUnknownRequest("unknown negotiation query endpoint")
 
@@ -93,3 +134,200 @@ func queryACLAccount(ctx cTypes.Context, path []string, k Keeper) ([]byte, cType
 	return res, nil
 
 }
+
+func queryRoles(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	zoneID, err := aclTypes.GetZoneIDFromString(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the zoneID %s", err))
+	}
+
+	var organizationID aclTypes.OrganizationID
+	if len(path) > 1 && path[1] != "" {
+		organizationID, err = aclTypes.GetOrganizationIDFromString(path[1])
+		if err != nil {
+			return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the organizationID %s", err))
+		}
+	}
+
+	roles := k.GetRoles(ctx, zoneID, organizationID)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, roles)
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryACLChangeLog(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	address, err := cTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the acl address %s", err))
+	}
+
+	changeLog := k.GetACLChangeLog(ctx, address)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, changeLog)
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryAddressRotations(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	address, err := cTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the address %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAddressRotations(ctx, address))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryKYC(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	address, err := cTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the acl address %s", err))
+	}
+
+	kyc, errRes := k.GetKYC(ctx, address)
+	if errRes != nil {
+		return nil, errRes
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, kyc)
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryExpiringKYCs(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QueryExpiringKYCsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetExpiringKYCsPage(ctx, params.ZoneID, params.Height, params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryTradingHeadroom(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	address, err := cTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the acl address %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetTradingHeadroom(ctx, address))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryBlockedCounterparties(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QueryBlockedCounterpartiesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetBlockedCounterpartiesPage(ctx, params.ZoneID, params.OrganizationID,
+		params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryFreezes(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	address, err := cTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the acl address %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetFreezes(ctx, address))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryClawbacks(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	if len(path) < 2 {
+		return nil, cTypes.ErrUnknownRequest("pegType and pegHash should be given")
+	}
+
+	pegHash, err := types.GetAssetPegHashHex(path[1])
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse the pegHash %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetClawbacks(ctx, path[0], pegHash))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryZones(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QueryZonesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetZonesPage(ctx, params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryOrganizations(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QueryOrganizationsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetOrganizationsPage(ctx, params.ZoneID, params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func querySubOrganizations(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QuerySubOrganizationsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetSubOrganizationsPage(ctx, params.ParentID, params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryACLAccounts(ctx cTypes.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, cTypes.Error) {
+	var params aclTypes.QueryACLAccountsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetACLAccountsPage(ctx, params.OrganizationID, params.Page, params.Limit))
+	if err != nil {
+		return nil, cTypes.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// Role
+
+func (keeper Keeper) SetRole(ctx cTypes.Context, role aclTypes.Role) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(role)
+	store.Set(aclTypes.GetRoleKey(role.ZoneID, role.OrganizationID, role.Name), bz)
+}
+
+func (keeper Keeper) GetRole(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID, name string) (aclTypes.Role, cTypes.Error) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	data := store.Get(aclTypes.GetRoleKey(zoneID, organizationID, name))
+	if data == nil {
+		return aclTypes.Role{}, aclTypes.ErrInvalidID(aclTypes.DefaultCodeSpace, "role with given name doesn't exist")
+	}
+
+	var role aclTypes.Role
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &role)
+
+	return role, nil
+}
+
+// GetRoles : roles of the zone when organizationID is empty, of the organization otherwise, or of every zone when zoneID is empty
+func (keeper Keeper) GetRoles(ctx cTypes.Context, zoneID aclTypes.ZoneID, organizationID aclTypes.OrganizationID) []aclTypes.Role {
+	var roles []aclTypes.Role
+
+	prefix := aclTypes.RoleKey
+	if len(zoneID) != 0 {
+		prefix = aclTypes.GetRolesKey(zoneID, organizationID)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var role aclTypes.Role
+
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &role)
+		roles = append(roles, role)
+	}
+
+	return roles
+}
+
+// DefineRole : defines the role, or edits it for all of its members when it is already defined
+func (keeper Keeper) DefineRole(ctx cTypes.Context, role aclTypes.Role) cTypes.Error {
+	if err := role.ValidateBasic(); err != nil {
+		return err
+	}
+
+	keeper.SetRole(ctx, role)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeDefineRole,
+			cTypes.NewAttribute(aclTypes.AttributeKeyRoleName, role.Name),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, role.ZoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, role.OrganizationID.String()),
+		))
+	return nil
+}
+
+// GetEffectiveACL : acl of the account without its expired permissions and with the permissions of its roles added, a role
+// of its organization comes before the zone role of the same name, roles that are not defined grant nothing
+func (keeper Keeper) GetEffectiveACL(ctx cTypes.Context, aclAccount aclTypes.ACLAccount) aclTypes.ACL {
+	acl := aclTypes.ExpireACL(aclAccount.GetACL(), aclAccount.GetPermissionExpiries(), ctx.BlockHeight())
+	for _, name := range aclAccount.GetRoles() {
+		role, err := keeper.GetRole(ctx, aclAccount.GetZoneID(), aclAccount.GetOrganizationID(), name)
+		if err != nil {
+			role, err = keeper.GetRole(ctx, aclAccount.GetZoneID(), nil, name)
+			if err != nil {
+				continue
+			}
+		}
+		acl = acl.Merge(role.ACL)
+	}
+	return acl
+}
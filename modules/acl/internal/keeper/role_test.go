@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func setupTestInput(t *testing.T) (cTypes.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(aclTypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	aclTypes.RegisterCodec(cdc)
+	cdc.RegisterInterface((*aclTypes.ACLAccount)(nil), nil)
+	cdc.RegisterConcrete(&aclTypes.BaseACLAccount{}, "commit-blockchain/AclAccount", nil)
+
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	return ctx, NewKeeper(key, nil, cdc)
+}
+
+func TestZoneRoleIsBoundedBySubOrganization(t *testing.T) {
+	ctx, k := setupTestInput(t)
+
+	zoneID := aclTypes.ZoneID([]byte("zone"))
+	parentID, organizationID := aclTypes.OrganizationID([]byte("parent")), aclTypes.OrganizationID([]byte("organization"))
+	require.Nil(t, k.SetOrganization(ctx, parentID, aclTypes.NewSubOrganization(cTypes.AccAddress([]byte("parent")), zoneID,
+		nil, aclTypes.ACL{SendFiat: true, Negotiation: true})))
+	require.Nil(t, k.SetOrganization(ctx, organizationID, aclTypes.NewSubOrganization(cTypes.AccAddress([]byte("organization")),
+		zoneID, parentID, aclTypes.ACL{SendFiat: true, Negotiation: true, IssueFiat: true})))
+
+	require.Nil(t, k.DefineRole(ctx, aclTypes.NewRole("trader", zoneID, nil, aclTypes.ACL{Negotiation: true})))
+	member := cTypes.AccAddress([]byte("member"))
+	require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: member, ZoneID: zoneID,
+		OrganizationID: organizationID, ACL: aclTypes.ACL{SendFiat: true}, Roles: []string{"trader"},
+		Status: aclTypes.ACLStatusActive}))
+
+	// the zone widens the role, members only get what the organization and its parent allow
+	require.Nil(t, k.DefineRole(ctx, aclTypes.NewRole("trader", zoneID, nil, aclTypes.ACL{Negotiation: true, IssueFiat: true,
+		IssueAsset: true})))
+	aclAccount, err := k.GetAccountACLDetails(ctx, member)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACL{SendFiat: true, Negotiation: true}, aclAccount.GetACL())
+
+	// the stored acl of the member is left as defined
+	aclAccount, err = k.GetACLAccount(ctx, member)
+	require.Nil(t, err)
+	require.Equal(t, aclTypes.ACL{SendFiat: true}, aclAccount.GetACL())
+}
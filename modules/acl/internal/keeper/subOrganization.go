@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// Sub organization
+
+// iterateOrganizationAncestors : calls handler with the organization and then each of its parents until it returns true
+func (keeper Keeper) iterateOrganizationAncestors(ctx cTypes.Context, organizationID aclTypes.OrganizationID,
+	handler func(organizationID aclTypes.OrganizationID, organization aclTypes.Organization) (stop bool)) {
+
+	visited := make(map[string]bool)
+	for id := organizationID; len(id) != 0 && !visited[id.String()]; {
+		visited[id.String()] = true
+
+		organization, err := keeper.GetOrganization(ctx, id)
+		if err != nil || handler(id, organization) {
+			return
+		}
+		id = organization.ParentID
+	}
+}
+
+// CheckOrganizationACL : the acl is within the acl of the organization and of each of its parents, organizations
+// without an acl do not bound it
+func (keeper Keeper) CheckOrganizationACL(ctx cTypes.Context, organizationID aclTypes.OrganizationID, acl aclTypes.ACL) cTypes.Error {
+	var err cTypes.Error
+	keeper.iterateOrganizationAncestors(ctx, organizationID, func(id aclTypes.OrganizationID, organization aclTypes.Organization) bool {
+		if organization.ACL != nil && !organization.ACL.Covers(acl) {
+			err = aclTypes.ErrACLNotCovered(aclTypes.DefaultCodeSpace, "acl exceeds the permissions of organization "+id.String())
+		}
+		return err != nil
+	})
+	return err
+}
+
+// BoundOrganizationACL : the acl restricted to the acl of the organization and of each of its parents, so that later
+// edits of zone roles don't grant members of a sub organization more than its bound
+func (keeper Keeper) BoundOrganizationACL(ctx cTypes.Context, organizationID aclTypes.OrganizationID, acl aclTypes.ACL) aclTypes.ACL {
+	keeper.iterateOrganizationAncestors(ctx, organizationID, func(_ aclTypes.OrganizationID, organization aclTypes.Organization) bool {
+		if organization.ACL != nil {
+			acl = acl.Intersect(*organization.ACL)
+		}
+		return false
+	})
+	return acl
+}
+
+// DefineSubOrganization : defines an organization under the parent, in the zone of the parent and bounded by its acl
+func (keeper Keeper) DefineSubOrganization(ctx cTypes.Context, to cTypes.AccAddress, organizationID aclTypes.OrganizationID,
+	parentID aclTypes.OrganizationID, acl aclTypes.ACL) cTypes.Error {
+
+	parent, err := keeper.GetOrganization(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if err := keeper.CheckOrganizationACL(ctx, parentID, acl); err != nil {
+		return err
+	}
+
+	err = keeper.SetOrganization(ctx, organizationID, aclTypes.NewSubOrganization(to, parent.ZoneID, parentID, acl))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeDefineSubOrganization,
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationAddress, to.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyParentID, parentID.String()),
+		))
+	return nil
+}
+
+// SetOrganizationAdmins : replaces the admins of the organization
+func (keeper Keeper) SetOrganizationAdmins(ctx cTypes.Context, organizationID aclTypes.OrganizationID, admins []cTypes.AccAddress) cTypes.Error {
+	organization, err := keeper.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	organization.Admins = admins
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(aclTypes.GetOrganizationKey(organizationID), keeper.cdc.MustMarshalBinaryLengthPrefixed(organization))
+
+	event := cTypes.NewEvent(
+		aclTypes.EventTypeSetOrganizationAdmins,
+		cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()),
+	)
+	for _, admin := range admins {
+		event = event.AppendAttributes(cTypes.NewAttribute(aclTypes.AttributeKeyAdmin, admin.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// GetSubOrganizationsPage : page of the sub organizations of the organization, read through the sub organization index
+func (keeper Keeper) GetSubOrganizationsPage(ctx cTypes.Context, parentID aclTypes.OrganizationID, page int, limit int) []aclTypes.GenesisOrganization {
+	prefix := aclTypes.GetSubOrganizationsKey(parentID)
+
+	organizations := []aclTypes.GenesisOrganization{}
+	keeper.iteratePage(ctx, prefix, page, limit, func(key []byte, _ []byte) {
+		organizationID := aclTypes.OrganizationID(key[len(prefix):])
+
+		organization, err := keeper.GetOrganization(ctx, organizationID)
+		if err != nil {
+			return
+		}
+		organizations = append(organizations, aclTypes.NewGenesisOrganization(organizationID, organization))
+	})
+	return organizations
+}
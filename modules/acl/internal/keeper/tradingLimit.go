@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// Trading limit
+
+// SetOrganizationTradingLimit : stores the trading limit of the organization, a zero limit is removed
+func (keeper Keeper) SetOrganizationTradingLimit(ctx cTypes.Context, organizationID aclTypes.OrganizationID, tradingLimit aclTypes.TradingLimit) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if tradingLimit.IsZero() {
+		store.Delete(aclTypes.GetOrganizationTradingLimitKey(organizationID))
+		return
+	}
+	store.Set(aclTypes.GetOrganizationTradingLimitKey(organizationID), keeper.cdc.MustMarshalBinaryLengthPrefixed(tradingLimit))
+}
+
+func (keeper Keeper) GetOrganizationTradingLimit(ctx cTypes.Context, organizationID aclTypes.OrganizationID) aclTypes.TradingLimit {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var tradingLimit aclTypes.TradingLimit
+	data := store.Get(aclTypes.GetOrganizationTradingLimitKey(organizationID))
+	if data != nil {
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &tradingLimit)
+	}
+	return tradingLimit
+}
+
+// IterateOrganizationTradingLimits : calls handler with the trading limit of every organization until it returns true
+func (keeper Keeper) IterateOrganizationTradingLimits(ctx cTypes.Context,
+	handler func(organizationID aclTypes.OrganizationID, tradingLimit aclTypes.TradingLimit) (stop bool)) {
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.OrganizationTradingLimitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradingLimit aclTypes.TradingLimit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tradingLimit)
+
+		if handler(aclTypes.OrganizationID(iterator.Key()[len(aclTypes.OrganizationTradingLimitKey):]), tradingLimit) {
+			break
+		}
+	}
+}
+
+// SetAccountTradingLimit : stores the trading limit of the account, a zero limit is removed
+func (keeper Keeper) SetAccountTradingLimit(ctx cTypes.Context, address cTypes.AccAddress, tradingLimit aclTypes.TradingLimit) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if tradingLimit.IsZero() {
+		store.Delete(aclTypes.GetAccountTradingLimitKey(address))
+		return
+	}
+	store.Set(aclTypes.GetAccountTradingLimitKey(address), keeper.cdc.MustMarshalBinaryLengthPrefixed(tradingLimit))
+}
+
+func (keeper Keeper) GetAccountTradingLimit(ctx cTypes.Context, address cTypes.AccAddress) aclTypes.TradingLimit {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var tradingLimit aclTypes.TradingLimit
+	data := store.Get(aclTypes.GetAccountTradingLimitKey(address))
+	if data != nil {
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &tradingLimit)
+	}
+	return tradingLimit
+}
+
+// IterateAccountTradingLimits : calls handler with the trading limit of every account until it returns true
+func (keeper Keeper) IterateAccountTradingLimits(ctx cTypes.Context,
+	handler func(address cTypes.AccAddress, tradingLimit aclTypes.TradingLimit) (stop bool)) {
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.AccountTradingLimitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradingLimit aclTypes.TradingLimit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tradingLimit)
+
+		if handler(cTypes.AccAddress(iterator.Key()[len(aclTypes.AccountTradingLimitKey):]), tradingLimit) {
+			break
+		}
+	}
+}
+
+// GetEffectiveTradingLimit : the limit of the account tightened by the limits of its organization and of each of its parents
+func (keeper Keeper) GetEffectiveTradingLimit(ctx cTypes.Context, address cTypes.AccAddress) aclTypes.TradingLimit {
+	tradingLimit := keeper.GetAccountTradingLimit(ctx, address)
+
+	aclAccount, err := keeper.GetACLAccount(ctx, address)
+	if err != nil {
+		return tradingLimit
+	}
+	keeper.iterateOrganizationAncestors(ctx, aclAccount.GetOrganizationID(), func(id aclTypes.OrganizationID, _ aclTypes.Organization) bool {
+		tradingLimit = tradingLimit.Tighten(keeper.GetOrganizationTradingLimit(ctx, id))
+		return false
+	})
+	return tradingLimit
+}
+
+// LimitOrganizationTrading : sets the trading limit of the accounts of the organization and its sub organizations
+func (keeper Keeper) LimitOrganizationTrading(ctx cTypes.Context, organizationID aclTypes.OrganizationID, tradingLimit aclTypes.TradingLimit) cTypes.Error {
+	if _, err := keeper.GetOrganization(ctx, organizationID); err != nil {
+		return err
+	}
+	if err := tradingLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	keeper.SetOrganizationTradingLimit(ctx, organizationID, tradingLimit)
+	keeper.emitSetTradingLimitEvent(ctx, cTypes.NewAttribute(aclTypes.AttributeKeyOrganizationID, organizationID.String()), tradingLimit)
+	return nil
+}
+
+// LimitAccountTrading : sets the trading limit of the acl account
+func (keeper Keeper) LimitAccountTrading(ctx cTypes.Context, address cTypes.AccAddress, tradingLimit aclTypes.TradingLimit) cTypes.Error {
+	if _, err := keeper.GetACLAccount(ctx, address); err != nil {
+		return err
+	}
+	if err := tradingLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	keeper.SetAccountTradingLimit(ctx, address, tradingLimit)
+	keeper.emitSetTradingLimitEvent(ctx, cTypes.NewAttribute(aclTypes.AttributeACLAccountAddress, address.String()), tradingLimit)
+	return nil
+}
+
+func (keeper Keeper) emitSetTradingLimitEvent(ctx cTypes.Context, attribute cTypes.Attribute, tradingLimit aclTypes.TradingLimit) {
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeSetTradingLimit,
+			attribute,
+			cTypes.NewAttribute(aclTypes.AttributeKeyMaxBid, strconv.FormatInt(tradingLimit.MaxBid, 10)),
+			cTypes.NewAttribute(aclTypes.AttributeKeyMaxDailyFiatOutflow, strconv.FormatInt(tradingLimit.MaxDailyFiatOutflow, 10)),
+			cTypes.NewAttribute(aclTypes.AttributeKeyMaxOpenNegotiations, strconv.FormatInt(tradingLimit.MaxOpenNegotiations, 10)),
+		))
+}
+
+// Trading usage
+
+// SetTradingUsage : stores the trading usage of the account, an empty usage is removed
+func (keeper Keeper) SetTradingUsage(ctx cTypes.Context, tradingUsage aclTypes.TradingUsage) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if tradingUsage.IsZero() {
+		store.Delete(aclTypes.GetTradingUsageKey(tradingUsage.Address))
+		return
+	}
+	store.Set(aclTypes.GetTradingUsageKey(tradingUsage.Address), keeper.cdc.MustMarshalBinaryLengthPrefixed(tradingUsage))
+}
+
+func (keeper Keeper) GetTradingUsage(ctx cTypes.Context, address cTypes.AccAddress) aclTypes.TradingUsage {
+	store := ctx.KVStore(keeper.storeKey)
+
+	tradingUsage := aclTypes.TradingUsage{Address: address}
+	data := store.Get(aclTypes.GetTradingUsageKey(address))
+	if data != nil {
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(data, &tradingUsage)
+	}
+	return tradingUsage
+}
+
+// IterateTradingUsages : calls handler with the trading usage of every account until it returns true
+func (keeper Keeper) IterateTradingUsages(ctx cTypes.Context, handler func(tradingUsage aclTypes.TradingUsage) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := cTypes.KVStorePrefixIterator(store, aclTypes.TradingUsageKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradingUsage aclTypes.TradingUsage
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tradingUsage)
+
+		if handler(tradingUsage) {
+			break
+		}
+	}
+}
+
+// CheckBid : fails when the bid exceeds the maximum bid of the account
+func (keeper Keeper) CheckBid(ctx cTypes.Context, address cTypes.AccAddress, bid int64) cTypes.Error {
+	tradingLimit := keeper.GetEffectiveTradingLimit(ctx, address)
+	if tradingLimit.MaxBid != 0 && bid > tradingLimit.MaxBid {
+		return aclTypes.ErrTradingLimitExceeded(aclTypes.DefaultCodeSpace, fmt.Sprintf("bid %d exceeds the maximum bid %d of account %v",
+			bid, tradingLimit.MaxBid, address.String()))
+	}
+	return nil
+}
+
+// RecordFiatOutflow : adds the amount to the fiat sent by the account within the rolling window, failing when it
+// exceeds the maximum daily fiat outflow of the account
+func (keeper Keeper) RecordFiatOutflow(ctx cTypes.Context, address cTypes.AccAddress, amount int64) cTypes.Error {
+	time := ctx.BlockHeader().Time.Unix()
+	tradingUsage := keeper.GetTradingUsage(ctx, address).Prune(time)
+
+	tradingLimit := keeper.GetEffectiveTradingLimit(ctx, address)
+	if tradingLimit.MaxDailyFiatOutflow != 0 && tradingUsage.DailyFiatOutflow(time)+amount > tradingLimit.MaxDailyFiatOutflow {
+		return aclTypes.ErrTradingLimitExceeded(aclTypes.DefaultCodeSpace, fmt.Sprintf("sending %d fiat exceeds the maximum "+
+			"daily fiat outflow %d of account %v", amount, tradingLimit.MaxDailyFiatOutflow, address.String()))
+	}
+
+	tradingUsage.FiatOutflows = append(tradingUsage.FiatOutflows, aclTypes.FiatOutflow{Time: time, Amount: amount})
+	keeper.SetTradingUsage(ctx, tradingUsage)
+	return nil
+}
+
+// OpenNegotiation : counts a negotiation signed by the account, failing when the account has its maximum open negotiations
+func (keeper Keeper) OpenNegotiation(ctx cTypes.Context, address cTypes.AccAddress) cTypes.Error {
+	tradingUsage := keeper.GetTradingUsage(ctx, address)
+
+	tradingLimit := keeper.GetEffectiveTradingLimit(ctx, address)
+	if tradingLimit.MaxOpenNegotiations != 0 && tradingUsage.OpenNegotiations >= tradingLimit.MaxOpenNegotiations {
+		return aclTypes.ErrTradingLimitExceeded(aclTypes.DefaultCodeSpace, fmt.Sprintf("account %v already has the maximum "+
+			"%d open negotiations", address.String(), tradingLimit.MaxOpenNegotiations))
+	}
+
+	tradingUsage.OpenNegotiations++
+	keeper.SetTradingUsage(ctx, tradingUsage)
+	return nil
+}
+
+// CloseNegotiation : stops counting a negotiation of the account once its counterparty signed it too
+func (keeper Keeper) CloseNegotiation(ctx cTypes.Context, address cTypes.AccAddress) {
+	tradingUsage := keeper.GetTradingUsage(ctx, address)
+	if tradingUsage.OpenNegotiations > 0 {
+		tradingUsage.OpenNegotiations--
+	}
+	keeper.SetTradingUsage(ctx, tradingUsage)
+}
+
+// GetTradingHeadroom : the effective trading limit of the account and what remains of it at the current block time
+func (keeper Keeper) GetTradingHeadroom(ctx cTypes.Context, address cTypes.AccAddress) aclTypes.TradingHeadroom {
+	return aclTypes.NewTradingHeadroom(address, keeper.GetEffectiveTradingLimit(ctx, address), keeper.GetTradingUsage(ctx, address),
+		ctx.BlockHeader().Time.Unix())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+func setupTradingLimitInput(t *testing.T) (cTypes.Context, Keeper, cTypes.AccAddress) {
+	ctx, k := setupTestInput(t)
+
+	zoneID, organizationID := aclTypes.ZoneID([]byte("zone")), aclTypes.OrganizationID([]byte("organization"))
+	require.Nil(t, k.SetOrganization(ctx, organizationID, aclTypes.NewOrganization(cTypes.AccAddress([]byte("organization")), zoneID)))
+	trader := cTypes.AccAddress([]byte("trader"))
+	require.Nil(t, k.SetACLAccount(ctx, &aclTypes.BaseACLAccount{Address: trader, ZoneID: zoneID,
+		OrganizationID: organizationID, Status: aclTypes.ACLStatusActive}))
+
+	// the organization caps what the account allows
+	require.Nil(t, k.LimitOrganizationTrading(ctx, organizationID, aclTypes.NewTradingLimit(100, 0, 2)))
+	require.Nil(t, k.LimitAccountTrading(ctx, trader, aclTypes.NewTradingLimit(200, 50, 3)))
+	return ctx, k, trader
+}
+
+func TestCheckBidOfTheEffectiveLimit(t *testing.T) {
+	ctx, k, trader := setupTradingLimitInput(t)
+
+	require.Nil(t, k.CheckBid(ctx, trader, 100))
+	require.NotNil(t, k.CheckBid(ctx, trader, 101))
+	require.Nil(t, k.CheckBid(ctx, cTypes.AccAddress([]byte("unlimited")), 1000))
+}
+
+func TestOpenNegotiationCounter(t *testing.T) {
+	ctx, k, trader := setupTradingLimitInput(t)
+
+	require.Nil(t, k.OpenNegotiation(ctx, trader))
+	require.Nil(t, k.OpenNegotiation(ctx, trader))
+	require.NotNil(t, k.OpenNegotiation(ctx, trader))
+	require.Equal(t, int64(2), k.GetTradingUsage(ctx, trader).OpenNegotiations)
+
+	k.CloseNegotiation(ctx, trader)
+	require.Nil(t, k.OpenNegotiation(ctx, trader))
+
+	// closing never counts below zero
+	for i := 0; i < 3; i++ {
+		k.CloseNegotiation(ctx, trader)
+	}
+	require.Equal(t, int64(0), k.GetTradingUsage(ctx, trader).OpenNegotiations)
+}
+
+func TestFiatOutflowRollsOver(t *testing.T) {
+	ctx, k, trader := setupTradingLimitInput(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.Nil(t, k.RecordFiatOutflow(ctx, trader, 30))
+	require.Nil(t, k.RecordFiatOutflow(ctx, trader, 20))
+	require.NotNil(t, k.RecordFiatOutflow(ctx, trader, 1))
+	require.Equal(t, int64(0), k.GetTradingHeadroom(ctx, trader).RemainingDailyFiatOutflow)
+
+	// the outflows leave the window a day later
+	ctx = ctx.WithBlockTime(time.Unix(1000+aclTypes.FiatOutflowWindow, 0))
+	require.Nil(t, k.RecordFiatOutflow(ctx, trader, 50))
+	require.Len(t, k.GetTradingUsage(ctx, trader).FiatOutflows, 1)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	aclTypes "github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+)
+
+// IsZoneSuspended : whether the zone is suspended
+func (keeper Keeper) IsZoneSuspended(ctx cTypes.Context, zoneID aclTypes.ZoneID) bool {
+	return ctx.KVStore(keeper.storeKey).Has(aclTypes.GetSuspendedZoneKey(zoneID))
+}
+
+// SetZoneSuspended : suspends the zone or reinstates it
+func (keeper Keeper) SetZoneSuspended(ctx cTypes.Context, zoneID aclTypes.ZoneID, suspended bool) cTypes.Error {
+	if _, err := keeper.GetZoneAddress(ctx, zoneID); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	if suspended {
+		store.Set(aclTypes.GetSuspendedZoneKey(zoneID), []byte{})
+	} else {
+		store.Delete(aclTypes.GetSuspendedZoneKey(zoneID))
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeSuspendZone,
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, zoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeySuspended, fmt.Sprintf("%t", suspended)),
+		))
+	return nil
+}
+
+// RotateZoneAddress : moves the zone to a new address, recording the rotation from the old address
+func (keeper Keeper) RotateZoneAddress(ctx cTypes.Context, zoneID aclTypes.ZoneID, newAddress cTypes.AccAddress) cTypes.Error {
+	oldAddress, err := keeper.GetZoneAddress(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	if err := keeper.checkRotationAddresses(ctx, oldAddress, newAddress, zoneID, nil); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(aclTypes.GetZoneKey(zoneID), keeper.cdc.MustMarshalBinaryLengthPrefixed(newAddress))
+	keeper.SetAddressRotation(ctx, aclTypes.NewAddressRotation(oldAddress, newAddress, zoneID, nil, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeRotateZoneAddress,
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, zoneID.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyOldAddress, oldAddress.String()),
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneAddress, newAddress.String()),
+		))
+	return nil
+}
+
+// RemoveZone : removes a zone without organizations, with its roles
+func (keeper Keeper) RemoveZone(ctx cTypes.Context, zoneID aclTypes.ZoneID) cTypes.Error {
+	if _, err := keeper.GetZoneAddress(ctx, zoneID); err != nil {
+		return err
+	}
+	if len(keeper.GetOrganizationsByZoneID(ctx, zoneID)) != 0 {
+		return aclTypes.ErrInvalidID(aclTypes.DefaultCodeSpace, "zone with organizations can't be removed")
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	for _, role := range keeper.GetRoles(ctx, zoneID, nil) {
+		store.Delete(aclTypes.GetRoleKey(role.ZoneID, role.OrganizationID, role.Name))
+	}
+	store.Delete(aclTypes.GetSuspendedZoneKey(zoneID))
+	store.Delete(aclTypes.GetZoneKey(zoneID))
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(
+			aclTypes.EventTypeRemoveZone,
+			cTypes.NewAttribute(aclTypes.AttributeKeyZoneID, zoneID.String()),
+		))
+	return nil
+}
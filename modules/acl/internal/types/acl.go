@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/common"
@@ -20,9 +21,13 @@ func GetZoneIDFromString(zoneID string) (ZoneID, error) {
 
 type OrganizationID = common.HexBytes
 
+// Organization : ParentID is set for sub organizations, whose acl bounds what their addresses and admins can grant
 type Organization struct {
-	Address cTypes.AccAddress `json:"address"`
-	ZoneID  ZoneID            `json:"zoneID"`
+	Address  cTypes.AccAddress   `json:"address"`
+	ZoneID   ZoneID              `json:"zoneID"`
+	ParentID OrganizationID      `json:"parentID"`
+	Admins   []cTypes.AccAddress `json:"admins"`
+	ACL      *ACL                `json:"acl"`
 }
 
 func NewOrganization(address cTypes.AccAddress, id ZoneID) Organization {
@@ -32,11 +37,36 @@ func NewOrganization(address cTypes.AccAddress, id ZoneID) Organization {
 	}
 }
 
+func NewSubOrganization(address cTypes.AccAddress, id ZoneID, parentID OrganizationID, acl ACL) Organization {
+	return Organization{
+		Address:  address,
+		ZoneID:   id,
+		ParentID: parentID,
+		ACL:      &acl,
+	}
+}
+
+// IsAdmin : address is the organization address or one of its admins
+func (org Organization) IsAdmin(address cTypes.AccAddress) bool {
+	if org.Address.Equals(address) {
+		return true
+	}
+	for _, admin := range org.Admins {
+		if admin.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
 func (org Organization) String() string {
 	return fmt.Sprintf(`
 Address: %s
 ZoneID: %s
-`, org.Address.String(), org.ZoneID.String())
+ParentID: %s
+Admins: %v
+ACL: %v
+`, org.Address.String(), org.ZoneID.String(), org.ParentID.String(), org.Admins, org.ACL)
 }
 
 func GetOrganizationIDFromString(organizationID string) (OrganizationID, error) {
@@ -84,6 +114,117 @@ ReleaseAsset: %t
 		acl.Negotiation, acl.RedeemFiat, acl.RedeemAsset, acl.ReleaseAsset)
 }
 
+// Merge : permissions granted by either acl
+func (acl ACL) Merge(other ACL) ACL {
+	return ACL{
+		IssueAsset:         acl.IssueAsset || other.IssueAsset,
+		IssueFiat:          acl.IssueFiat || other.IssueFiat,
+		SendAsset:          acl.SendAsset || other.SendAsset,
+		SendFiat:           acl.SendFiat || other.SendFiat,
+		BuyerExecuteOrder:  acl.BuyerExecuteOrder || other.BuyerExecuteOrder,
+		SellerExecuteOrder: acl.SellerExecuteOrder || other.SellerExecuteOrder,
+		ChangeBuyerBid:     acl.ChangeBuyerBid || other.ChangeBuyerBid,
+		ChangeSellerBid:    acl.ChangeSellerBid || other.ChangeSellerBid,
+		ConfirmBuyerBid:    acl.ConfirmBuyerBid || other.ConfirmBuyerBid,
+		ConfirmSellerBid:   acl.ConfirmSellerBid || other.ConfirmSellerBid,
+		Negotiation:        acl.Negotiation || other.Negotiation,
+		RedeemFiat:         acl.RedeemFiat || other.RedeemFiat,
+		RedeemAsset:        acl.RedeemAsset || other.RedeemAsset,
+		ReleaseAsset:       acl.ReleaseAsset || other.ReleaseAsset,
+	}
+}
+
+// Intersect : permissions granted by both acls
+func (acl ACL) Intersect(other ACL) ACL {
+	return ACL{
+		IssueAsset:         acl.IssueAsset && other.IssueAsset,
+		IssueFiat:          acl.IssueFiat && other.IssueFiat,
+		SendAsset:          acl.SendAsset && other.SendAsset,
+		SendFiat:           acl.SendFiat && other.SendFiat,
+		BuyerExecuteOrder:  acl.BuyerExecuteOrder && other.BuyerExecuteOrder,
+		SellerExecuteOrder: acl.SellerExecuteOrder && other.SellerExecuteOrder,
+		ChangeBuyerBid:     acl.ChangeBuyerBid && other.ChangeBuyerBid,
+		ChangeSellerBid:    acl.ChangeSellerBid && other.ChangeSellerBid,
+		ConfirmBuyerBid:    acl.ConfirmBuyerBid && other.ConfirmBuyerBid,
+		ConfirmSellerBid:   acl.ConfirmSellerBid && other.ConfirmSellerBid,
+		Negotiation:        acl.Negotiation && other.Negotiation,
+		RedeemFiat:         acl.RedeemFiat && other.RedeemFiat,
+		RedeemAsset:        acl.RedeemAsset && other.RedeemAsset,
+		ReleaseAsset:       acl.ReleaseAsset && other.ReleaseAsset,
+	}
+}
+
+// permissions : permissions of the acl by their json name
+func (acl *ACL) permissions() map[string]*bool {
+	return map[string]*bool{
+		"issueAsset":         &acl.IssueAsset,
+		"issueFiat":          &acl.IssueFiat,
+		"sendAsset":          &acl.SendAsset,
+		"sendFiat":           &acl.SendFiat,
+		"buyerExecuteOrder":  &acl.BuyerExecuteOrder,
+		"sellerExecuteOrder": &acl.SellerExecuteOrder,
+		"changeBuyerBid":     &acl.ChangeBuyerBid,
+		"changeSellerBid":    &acl.ChangeSellerBid,
+		"confirmBuyerBid":    &acl.ConfirmBuyerBid,
+		"confirmSellerBid":   &acl.ConfirmSellerBid,
+		"negotiation":        &acl.Negotiation,
+		"redeemFiat":         &acl.RedeemFiat,
+		"redeemAsset":        &acl.RedeemAsset,
+		"releaseAsset":       &acl.ReleaseAsset,
+	}
+}
+
+// Without : acl with the permission withdrawn
+func (acl ACL) Without(permission string) ACL {
+	if granted, found := acl.permissions()[permission]; found {
+		*granted = false
+	}
+	return acl
+}
+
+// IsPermission : permission is the json name of a permission of the acl, e.g. sendFiat
+func IsPermission(permission string) bool {
+	_, found := (&ACL{}).permissions()[permission]
+	return found
+}
+
+// PermissionExpiry : the permission granted by the acl of an account is withdrawn from ExpiryHeight on
+type PermissionExpiry struct {
+	Permission   string `json:"permission"`
+	ExpiryHeight int64  `json:"expiryHeight"`
+}
+
+func NewPermissionExpiry(permission string, expiryHeight int64) PermissionExpiry {
+	return PermissionExpiry{
+		Permission:   permission,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+func (permissionExpiry PermissionExpiry) ValidateBasic() cTypes.Error {
+	if !IsPermission(permissionExpiry.Permission) {
+		return ErrInvalidID(DefaultCodeSpace, "unknown permission "+permissionExpiry.Permission)
+	} else if permissionExpiry.ExpiryHeight <= 0 {
+		return ErrInvalidID(DefaultCodeSpace, "expiry height of "+permissionExpiry.Permission+" should be positive")
+	}
+	return nil
+}
+
+// ExpireACL : the acl without the permissions expired at the height
+func ExpireACL(acl ACL, permissionExpiries []PermissionExpiry, height int64) ACL {
+	for _, permissionExpiry := range permissionExpiries {
+		if height >= permissionExpiry.ExpiryHeight {
+			acl = acl.Without(permissionExpiry.Permission)
+		}
+	}
+	return acl
+}
+
+// Covers : every permission granted by other is granted by acl
+func (acl ACL) Covers(other ACL) bool {
+	return acl.Merge(other) == acl
+}
+
 type ACLAccount interface {
 	GetAddress() cTypes.AccAddress
 	SetAddress(address cTypes.AccAddress) error
@@ -96,14 +237,30 @@ type ACLAccount interface {
 
 	GetACL() ACL
 	SetACL(acl ACL) error
+
+	GetRoles() []string
+	SetRoles(roles []string) error
+
+	GetStatus() string
+	SetStatus(status string) error
+
+	GetStatusAuthority() string
+	SetStatusAuthority(statusAuthority string) error
+
+	GetPermissionExpiries() []PermissionExpiry
+	SetPermissionExpiries(permissionExpiries []PermissionExpiry) error
 }
 
 // BaseACLAccount : Acl account type
 type BaseACLAccount struct {
-	Address        cTypes.AccAddress `json:"address" valid:"required~Mandatory Parameter Address missing,matches(^[A-F0-9]+$)~Parameter Address is Invalid,length(2|40)~ToAddress length between 2-40"`
-	ZoneID         ZoneID            `json:"zoneID" valid:"required~matches(^[A-F0-9]+$)~Invalid TOAddress,length(2|40)~ToAddress length between 2-40"`
-	OrganizationID OrganizationID    `json:"organizationID" valid:"required~matches(^[A-F0-9]+$)~Invalid TOAddress,length(2|40)~ToAddress length between 2-40"`
-	ACL            ACL               `json:"acl"`
+	Address            cTypes.AccAddress  `json:"address" valid:"required~Mandatory Parameter Address missing,matches(^[A-F0-9]+$)~Parameter Address is Invalid,length(2|40)~ToAddress length between 2-40"`
+	ZoneID             ZoneID             `json:"zoneID" valid:"required~matches(^[A-F0-9]+$)~Invalid TOAddress,length(2|40)~ToAddress length between 2-40"`
+	OrganizationID     OrganizationID     `json:"organizationID" valid:"required~matches(^[A-F0-9]+$)~Invalid TOAddress,length(2|40)~ToAddress length between 2-40"`
+	ACL                ACL                `json:"acl"`
+	Roles              []string           `json:"roles"`
+	Status             string             `json:"status"`
+	StatusAuthority    string             `json:"statusAuthority"`
+	PermissionExpiries []PermissionExpiry `json:"permissionExpiries"`
 }
 
 var _ ACLAccount = (*BaseACLAccount)(nil)
@@ -141,6 +298,50 @@ func (baseACLAccount *BaseACLAccount) SetACL(acl ACL) error {
 	return nil
 }
 
+// GetRoles : getter
+func (baseACLAccount BaseACLAccount) GetRoles() []string {
+	return baseACLAccount.Roles
+}
+
+// SetRoles : setter
+func (baseACLAccount *BaseACLAccount) SetRoles(roles []string) error {
+	baseACLAccount.Roles = roles
+	return nil
+}
+
+// GetStatus : getter
+func (baseACLAccount BaseACLAccount) GetStatus() string {
+	return baseACLAccount.Status
+}
+
+// SetStatus : setter
+func (baseACLAccount *BaseACLAccount) SetStatus(status string) error {
+	baseACLAccount.Status = status
+	return nil
+}
+
+// GetStatusAuthority : getter
+func (baseACLAccount BaseACLAccount) GetStatusAuthority() string {
+	return baseACLAccount.StatusAuthority
+}
+
+// SetStatusAuthority : setter
+func (baseACLAccount *BaseACLAccount) SetStatusAuthority(statusAuthority string) error {
+	baseACLAccount.StatusAuthority = statusAuthority
+	return nil
+}
+
+// GetPermissionExpiries : getter
+func (baseACLAccount BaseACLAccount) GetPermissionExpiries() []PermissionExpiry {
+	return baseACLAccount.PermissionExpiries
+}
+
+// SetPermissionExpiries : setter
+func (baseACLAccount *BaseACLAccount) SetPermissionExpiries(permissionExpiries []PermissionExpiry) error {
+	baseACLAccount.PermissionExpiries = permissionExpiries
+	return nil
+}
+
 // GetZoneID : getter
 func (baseACLAccount BaseACLAccount) GetZoneID() ZoneID {
 	return baseACLAccount.ZoneID
@@ -158,7 +359,12 @@ Address: %s
 ZoneID: %s
 OrganizationID: %s
 ACL: %s
-`, baseACLAccount.GetAddress().String(), baseACLAccount.ZoneID.String(), baseACLAccount.OrganizationID.String(), baseACLAccount.ACL.String())
+Roles: %s
+Status: %s
+StatusAuthority: %s
+PermissionExpiries: %v
+`, baseACLAccount.GetAddress().String(), baseACLAccount.ZoneID.String(), baseACLAccount.OrganizationID.String(), baseACLAccount.ACL.String(),
+		strings.Join(baseACLAccount.Roles, ","), baseACLAccount.Status, baseACLAccount.StatusAuthority, baseACLAccount.PermissionExpiries)
 }
 
 // ACLAccountDecoder : decoder function for acl account
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// acl account status, an empty status is active
+const (
+	ACLStatusActive    = "active"
+	ACLStatusSuspended = "suspended"
+	ACLStatusRevoked   = "revoked"
+)
+
+// authority suspending or revoking an acl account, only the same or a higher authority reinstates it
+const (
+	ACLAuthorityOrganization = "organization"
+	ACLAuthorityZone         = "zone"
+	ACLAuthorityGenesis      = "genesis"
+)
+
+// ACLAuthorityLevel : rank of the authority, an unknown or empty authority ranks lowest
+func ACLAuthorityLevel(authority string) int {
+	switch authority {
+	case ACLAuthorityOrganization:
+		return 1
+	case ACLAuthorityZone:
+		return 2
+	case ACLAuthorityGenesis:
+		return 3
+	}
+	return 0
+}
+
+// acl change actions
+const (
+	ACLActionDefine    = "define"
+	ACLActionSuspend   = "suspend"
+	ACLActionReinstate = "reinstate"
+	ACLActionRevoke    = "revoke"
+)
+
+// ACLChange : entry of the change log of an acl account
+type ACLChange struct {
+	Action             string             `json:"action"`
+	By                 cTypes.AccAddress  `json:"by"`
+	Reason             string             `json:"reason"`
+	Height             int64              `json:"height"`
+	ACL                ACL                `json:"acl"`
+	Roles              []string           `json:"roles"`
+	PermissionExpiries []PermissionExpiry `json:"permissionExpiries"`
+}
+
+func NewACLChange(action string, by cTypes.AccAddress, reason string, height int64, aclAccount ACLAccount) ACLChange {
+	return ACLChange{
+		Action:             action,
+		By:                 by,
+		Reason:             reason,
+		Height:             height,
+		ACL:                aclAccount.GetACL(),
+		Roles:              aclAccount.GetRoles(),
+		PermissionExpiries: aclAccount.GetPermissionExpiries(),
+	}
+}
+
+func (aclChange ACLChange) String() string {
+	return fmt.Sprintf(`
+Action: %s
+By: %s
+Reason: %s
+Height: %d
+ACL: %s
+Roles: %v
+PermissionExpiries: %v
+`, aclChange.Action, aclChange.By.String(), aclChange.Reason, aclChange.Height, aclChange.ACL.String(), aclChange.Roles,
+		aclChange.PermissionExpiries)
+}
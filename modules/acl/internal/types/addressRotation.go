@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressRotation : move of the authority of a zone, or of an organization when OrganizationID is set, from OldAddress to NewAddress
+type AddressRotation struct {
+	OldAddress     cTypes.AccAddress `json:"oldAddress"`
+	NewAddress     cTypes.AccAddress `json:"newAddress"`
+	ZoneID         ZoneID            `json:"zoneID"`
+	OrganizationID OrganizationID    `json:"organizationID"`
+	Height         int64             `json:"height"`
+}
+
+func NewAddressRotation(oldAddress cTypes.AccAddress, newAddress cTypes.AccAddress, zoneID ZoneID,
+	organizationID OrganizationID, height int64) AddressRotation {
+	return AddressRotation{
+		OldAddress:     oldAddress,
+		NewAddress:     newAddress,
+		ZoneID:         zoneID,
+		OrganizationID: organizationID,
+		Height:         height,
+	}
+}
+
+func (addressRotation AddressRotation) String() string {
+	return fmt.Sprintf(`
+OldAddress: %s
+NewAddress: %s
+ZoneID: %s
+OrganizationID: %s
+Height: %d
+`, addressRotation.OldAddress.String(), addressRotation.NewAddress.String(), addressRotation.ZoneID.String(),
+		addressRotation.OrganizationID.String(), addressRotation.Height)
+}
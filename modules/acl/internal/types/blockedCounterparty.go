@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockedCounterparty : address on the denylist of a zone, or on the blocked partners of an organization when it has an
+// organization id, no account trades with it
+type BlockedCounterparty struct {
+	Address        cTypes.AccAddress `json:"address"`
+	ZoneID         ZoneID            `json:"zoneID"`
+	OrganizationID OrganizationID    `json:"organizationID"`
+	Reason         string            `json:"reason"`
+	Height         int64             `json:"height"`
+}
+
+func NewBlockedCounterparty(address cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID, reason string, height int64) BlockedCounterparty {
+	return BlockedCounterparty{
+		Address:        address,
+		ZoneID:         zoneID,
+		OrganizationID: organizationID,
+		Reason:         reason,
+		Height:         height,
+	}
+}
+
+// GetKey : key of the zone denylist or of the organization blocked partners
+func (blockedCounterparty BlockedCounterparty) GetKey() []byte {
+	if len(blockedCounterparty.OrganizationID) != 0 {
+		return GetOrganizationBlockedCounterpartyKey(blockedCounterparty.OrganizationID, blockedCounterparty.Address)
+	}
+	return GetZoneDenylistKey(blockedCounterparty.ZoneID, blockedCounterparty.Address)
+}
+
+func (blockedCounterparty BlockedCounterparty) String() string {
+	return fmt.Sprintf(`
+Address: %s
+ZoneID: %s
+OrganizationID: %s
+Reason: %s
+Height: %d
+`, blockedCounterparty.Address.String(), blockedCounterparty.ZoneID.String(), blockedCounterparty.OrganizationID.String(),
+		blockedCounterparty.Reason, blockedCounterparty.Height)
+}
+
+// RejectionEvents : the counterparty rejections of the events, kept on the result of a failed message for monitoring
+func RejectionEvents(events cTypes.Events) cTypes.Events {
+	rejections := cTypes.EmptyEvents()
+	for _, event := range events {
+		if event.Type == EventTypeRejectCounterparty {
+			rejections = rejections.AppendEvent(event)
+		}
+	}
+	return rejections
+}
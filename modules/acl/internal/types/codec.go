@@ -5,7 +5,14 @@ import (
 )
 
 func RegisterCodec(cdc *codec.Codec) {
-
+	cdc.RegisterConcrete(CreateZoneProposal{}, "commit-blockchain/CreateZoneProposal", nil)
+	cdc.RegisterConcrete(RotateZoneAddressProposal{}, "commit-blockchain/RotateZoneAddressProposal", nil)
+	cdc.RegisterConcrete(SuspendZoneProposal{}, "commit-blockchain/SuspendZoneProposal", nil)
+	cdc.RegisterConcrete(RemoveZoneProposal{}, "commit-blockchain/RemoveZoneProposal", nil)
+	cdc.RegisterConcrete(MsgBlockCounterparties{}, "commit-blockchain/MsgBlockCounterparties", nil)
+	cdc.RegisterConcrete(MsgUnblockCounterparties{}, "commit-blockchain/MsgUnblockCounterparties", nil)
+	cdc.RegisterConcrete(MsgFreezePegs{}, "commit-blockchain/MsgFreezePegs", nil)
+	cdc.RegisterConcrete(MsgUnfreezePegs{}, "commit-blockchain/MsgUnfreezePegs", nil)
 }
 
 var ModuleCdc *codec.Codec
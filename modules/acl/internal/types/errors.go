@@ -12,6 +12,12 @@ const (
 	CodeInvalidInputsOutputs cTypes.CodeType = 101
 	CodeInvalidID            cTypes.CodeType = 102
 	CodeInvalidAddress       cTypes.CodeType = 103
+	CodeInvalidStatus        cTypes.CodeType = 104
+	CodeACLNotCovered        cTypes.CodeType = 105
+	CodeKYCExpired           cTypes.CodeType = 106
+	CodeTradingLimitExceeded cTypes.CodeType = 107
+	CodeCounterpartyBlocked  cTypes.CodeType = 108
+	CodeFrozen               cTypes.CodeType = 109
 )
 
 func ErrNoInputs(codespace cTypes.CodespaceType) cTypes.Error {
@@ -31,3 +37,45 @@ func ErrInvalidAddress(codespace cTypes.CodespaceType, msg string) cTypes.Error
 	}
 	return cTypes.NewError(codespace, CodeInvalidAddress, "")
 }
+
+func ErrInvalidStatus(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidStatus, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidStatus, "invalid acl status")
+}
+
+func ErrACLNotCovered(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeACLNotCovered, msg)
+	}
+	return cTypes.NewError(codespace, CodeACLNotCovered, "acl exceeds the permissions of the organization")
+}
+
+func ErrKYCExpired(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeKYCExpired, msg)
+	}
+	return cTypes.NewError(codespace, CodeKYCExpired, "kyc expired")
+}
+
+func ErrTradingLimitExceeded(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeTradingLimitExceeded, msg)
+	}
+	return cTypes.NewError(codespace, CodeTradingLimitExceeded, "trading limit exceeded")
+}
+
+func ErrCounterpartyBlocked(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeCounterpartyBlocked, msg)
+	}
+	return cTypes.NewError(codespace, CodeCounterpartyBlocked, "counterparty blocked")
+}
+
+func ErrFrozen(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeFrozen, msg)
+	}
+	return cTypes.NewError(codespace, CodeFrozen, "frozen")
+}
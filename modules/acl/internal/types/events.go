@@ -1,15 +1,51 @@
 package types
 
 var (
-	EventTypeDefineZone         = "defineZone"
-	EventTypeDefineOrganization = "defineOrganization"
-	EventTypeDefineACL          = "defineACL"
+	EventTypeDefineZone                = "defineZone"
+	EventTypeDefineOrganization        = "defineOrganization"
+	EventTypeDefineSubOrganization     = "defineSubOrganization"
+	EventTypeSetOrganizationAdmins     = "setOrganizationAdmins"
+	EventTypeDefineACL                 = "defineACL"
+	EventTypeDefineRole                = "defineRole"
+	EventTypeSuspendACL                = "suspendACL"
+	EventTypeReinstateACL              = "reinstateACL"
+	EventTypeRevokeACL                 = "revokeACL"
+	EventTypeRotateZoneAddress         = "rotateZoneAddress"
+	EventTypeRotateOrganizationAddress = "rotateOrganizationAddress"
+	EventTypeSuspendZone               = "suspendZone"
+	EventTypeRemoveZone                = "removeZone"
+	EventTypeRecordKYC                 = "recordKYC"
+	EventTypeSetTradingLimit           = "setTradingLimit"
+	EventTypeBlockCounterparty         = "blockCounterparty"
+	EventTypeUnblockCounterparty       = "unblockCounterparty"
+	EventTypeRejectCounterparty        = "rejectCounterparty"
+	EventTypeFreeze                    = "freeze"
+	EventTypeUnfreeze                  = "unfreeze"
 
 	AttributeKeyZoneAddress = "zoneAddress"
 	AttributeKeyZoneID      = "zoneID"
+	AttributeKeySuspended   = "suspended"
 
 	AttributeKeyOrganizationAddress = "organizationAddress"
 	AttributeKeyOrganizationID      = "organizationID"
+	AttributeKeyParentID            = "parentID"
+	AttributeKeyAdmin               = "admin"
+
+	AttributeKeyOldAddress = "oldAddress"
 
 	AttributeACLAccountAddress = "aclAccountAddress"
+	AttributeKeyCounterparty   = "counterparty"
+
+	AttributeKeyRoleName = "roleName"
+	AttributeKeyReason   = "reason"
+
+	AttributeKeyKYCLevel     = "kycLevel"
+	AttributeKeyKYCExpiresAt = "kycExpiresAt"
+
+	AttributeKeyMaxBid              = "maxBid"
+	AttributeKeyMaxDailyFiatOutflow = "maxDailyFiatOutflow"
+	AttributeKeyMaxOpenNegotiations = "maxOpenNegotiations"
+
+	AttributeKeyPegType = "pegType"
+	AttributeKeyPegHash = "pegHash"
 )
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	PegTypeAsset = "asset"
+	PegTypeFiat  = "fiat"
+)
+
+// ValidatePeg : a whole wallet without peg type and peg hash, or an asset or fiat peg
+func ValidatePeg(pegType string, pegHash types.PegHash) cTypes.Error {
+	if len(pegHash) == 0 {
+		if len(pegType) != 0 {
+			return cTypes.ErrUnknownRequest("pegType should be empty without a pegHash")
+		}
+		return nil
+	}
+	if pegType != PegTypeAsset && pegType != PegTypeFiat {
+		return cTypes.ErrUnknownRequest(fmt.Sprintf("pegType should be %s or %s", PegTypeAsset, PegTypeFiat))
+	}
+	return nil
+}
+
+// Freeze : compliance freeze by a zone of the whole wallet of an account, or of an asset or fiat peg in that wallet when
+// it has a peg hash, frozen pegs do not leave the wallet
+type Freeze struct {
+	ZoneID  ZoneID            `json:"zoneID"`
+	Address cTypes.AccAddress `json:"address"`
+	PegType string            `json:"pegType"`
+	PegHash types.PegHash     `json:"pegHash"`
+	Reason  string            `json:"reason"`
+	Height  int64             `json:"height"`
+}
+
+func NewFreeze(zoneID ZoneID, address cTypes.AccAddress, pegType string, pegHash types.PegHash, reason string, height int64) Freeze {
+	return Freeze{
+		ZoneID:  zoneID,
+		Address: address,
+		PegType: pegType,
+		PegHash: pegHash,
+		Reason:  reason,
+		Height:  height,
+	}
+}
+
+// GetKey : key of the frozen wallet or of the frozen peg
+func (freeze Freeze) GetKey() []byte {
+	if len(freeze.PegHash) == 0 {
+		return GetFrozenWalletKey(freeze.Address)
+	}
+	return GetFrozenPegKey(freeze.Address, freeze.PegType, freeze.PegHash)
+}
+
+func (freeze Freeze) String() string {
+	return fmt.Sprintf(`
+ZoneID: %s
+Address: %s
+PegType: %s
+PegHash: %s
+Reason: %s
+Height: %d
+`, freeze.ZoneID.String(), freeze.Address.String(), freeze.PegType, freeze.PegHash.String(), freeze.Reason, freeze.Height)
+}
+
+// Clawback : pegs a zone returned from a holder to their issuer, the fiat amount taken back of a fiat peg
+type Clawback struct {
+	ZoneID        ZoneID            `json:"zoneID"`
+	FromAddress   cTypes.AccAddress `json:"fromAddress"`
+	IssuerAddress cTypes.AccAddress `json:"issuerAddress"`
+	PegType       string            `json:"pegType"`
+	PegHash       types.PegHash     `json:"pegHash"`
+	Amount        int64             `json:"amount"`
+	Reason        string            `json:"reason"`
+	Height        int64             `json:"height"`
+}
+
+func NewClawback(zoneID ZoneID, fromAddress cTypes.AccAddress, issuerAddress cTypes.AccAddress, pegType string,
+	pegHash types.PegHash, amount int64, reason string, height int64) Clawback {
+	return Clawback{
+		ZoneID:        zoneID,
+		FromAddress:   fromAddress,
+		IssuerAddress: issuerAddress,
+		PegType:       pegType,
+		PegHash:       pegHash,
+		Amount:        amount,
+		Reason:        reason,
+		Height:        height,
+	}
+}
+
+func (clawback Clawback) GetKey() []byte {
+	return GetClawbackKey(clawback.PegType, clawback.PegHash, clawback.Height, clawback.FromAddress)
+}
+
+func (clawback Clawback) String() string {
+	return fmt.Sprintf(`
+ZoneID: %s
+FromAddress: %s
+IssuerAddress: %s
+PegType: %s
+PegHash: %s
+Amount: %d
+Reason: %s
+Height: %d
+`, clawback.ZoneID.String(), clawback.FromAddress.String(), clawback.IssuerAddress.String(), clawback.PegType,
+		clawback.PegHash.String(), clawback.Amount, clawback.Reason, clawback.Height)
+}
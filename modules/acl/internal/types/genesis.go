@@ -1,31 +1,306 @@
 package types
 
 import (
+	"fmt"
+
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
+// GenesisZone : zone id with the address of the zone
+type GenesisZone struct {
+	ZoneID    ZoneID            `json:"zoneID"`
+	Address   cTypes.AccAddress `json:"address"`
+	Suspended bool              `json:"suspended"`
+}
+
+// GenesisOrganization : organization id with the organization
+type GenesisOrganization struct {
+	OrganizationID OrganizationID      `json:"organizationID"`
+	Address        cTypes.AccAddress   `json:"address"`
+	ZoneID         ZoneID              `json:"zoneID"`
+	ParentID       OrganizationID      `json:"parentID"`
+	Admins         []cTypes.AccAddress `json:"admins"`
+	ACL            *ACL                `json:"acl"`
+}
+
+func NewGenesisOrganization(organizationID OrganizationID, organization Organization) GenesisOrganization {
+	return GenesisOrganization{
+		OrganizationID: organizationID,
+		Address:        organization.Address,
+		ZoneID:         organization.ZoneID,
+		ParentID:       organization.ParentID,
+		Admins:         organization.Admins,
+		ACL:            organization.ACL,
+	}
+}
+
+func (organization GenesisOrganization) GetOrganization() Organization {
+	return Organization{
+		Address:  organization.Address,
+		ZoneID:   organization.ZoneID,
+		ParentID: organization.ParentID,
+		Admins:   organization.Admins,
+		ACL:      organization.ACL,
+	}
+}
+
+// GenesisACLChangeLog : acl change log of an account
+type GenesisACLChangeLog struct {
+	Address   cTypes.AccAddress `json:"address"`
+	ChangeLog []ACLChange       `json:"changeLog"`
+}
+
+// GenesisOrganizationTradingLimit : trading limit of the accounts of an organization
+type GenesisOrganizationTradingLimit struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	TradingLimit   TradingLimit   `json:"tradingLimit"`
+}
+
+// GenesisAccountTradingLimit : trading limit of an account
+type GenesisAccountTradingLimit struct {
+	Address      cTypes.AccAddress `json:"address"`
+	TradingLimit TradingLimit      `json:"tradingLimit"`
+}
+
 type GenesisState struct {
-	Accounts     []ACLAccount      `json:"accounts"`
-	ZoneID       cTypes.AccAddress `json:"zone_id"`
-	Organization Organization      `json:"organization"`
+	Zones            []GenesisZone         `json:"zones"`
+	Organizations    []GenesisOrganization `json:"organizations"`
+	Accounts         []BaseACLAccount      `json:"accounts"`
+	Roles            []Role                `json:"roles"`
+	ACLChangeLogs    []GenesisACLChangeLog `json:"aclChangeLogs"`
+	AddressRotations []AddressRotation     `json:"addressRotations"`
+	KYCs             []KYC                 `json:"kycs"`
+
+	OrganizationTradingLimits []GenesisOrganizationTradingLimit `json:"organizationTradingLimits"`
+	AccountTradingLimits      []GenesisAccountTradingLimit      `json:"accountTradingLimits"`
+	TradingUsages             []TradingUsage                    `json:"tradingUsages"`
+	BlockedCounterparties     []BlockedCounterparty             `json:"blockedCounterparties"`
+	Freezes                   []Freeze                          `json:"freezes"`
+	Clawbacks                 []Clawback                        `json:"clawbacks"`
 }
 
-func NewGenesisState(accounts []ACLAccount, zoneID cTypes.AccAddress, organization Organization) GenesisState {
+func NewGenesisState(zones []GenesisZone, organizations []GenesisOrganization, accounts []BaseACLAccount, roles []Role,
+	aclChangeLogs []GenesisACLChangeLog, addressRotations []AddressRotation, kycs []KYC,
+	organizationTradingLimits []GenesisOrganizationTradingLimit, accountTradingLimits []GenesisAccountTradingLimit,
+	tradingUsages []TradingUsage, blockedCounterparties []BlockedCounterparty, freezes []Freeze, clawbacks []Clawback) GenesisState {
 	return GenesisState{
-		Accounts:     accounts,
-		ZoneID:       zoneID,
-		Organization: organization,
+		Zones:            zones,
+		Organizations:    organizations,
+		Accounts:         accounts,
+		Roles:            roles,
+		ACLChangeLogs:    aclChangeLogs,
+		AddressRotations: addressRotations,
+		KYCs:             kycs,
+
+		OrganizationTradingLimits: organizationTradingLimits,
+		AccountTradingLimits:      accountTradingLimits,
+		TradingUsages:             tradingUsages,
+		BlockedCounterparties:     blockedCounterparties,
+		Freezes:                   freezes,
+		Clawbacks:                 clawbacks,
 	}
 }
 
 func DefaultGenesisState() GenesisState {
-	return GenesisState{
-		Accounts:     nil,
-		ZoneID:       nil,
-		Organization: Organization{},
-	}
+	return GenesisState{}
 }
 
+// ValidateGenesis : ids and addresses are unique, and organizations, accounts and roles reference zones and organizations of the genesis
 func ValidateGenesis(data GenesisState) error {
+	zones := make(map[string]bool)
+	for _, zone := range data.Zones {
+		if len(zone.ZoneID) == 0 || zone.Address.Empty() {
+			return fmt.Errorf("zone %s should have an id and an address", zone.ZoneID.String())
+		}
+		if zones[zone.ZoneID.String()] {
+			return fmt.Errorf("duplicate zone %s", zone.ZoneID.String())
+		}
+		zones[zone.ZoneID.String()] = true
+	}
+
+	organizationZones := make(map[string]string)
+	for _, organization := range data.Organizations {
+		if len(organization.OrganizationID) == 0 || organization.Address.Empty() {
+			return fmt.Errorf("organization %s should have an id and an address", organization.OrganizationID.String())
+		}
+		if _, found := organizationZones[organization.OrganizationID.String()]; found {
+			return fmt.Errorf("duplicate organization %s", organization.OrganizationID.String())
+		}
+		if !zones[organization.ZoneID.String()] {
+			return fmt.Errorf("organization %s references zone %s which is not in genesis",
+				organization.OrganizationID.String(), organization.ZoneID.String())
+		}
+		organizationZones[organization.OrganizationID.String()] = organization.ZoneID.String()
+	}
+
+	parents := make(map[string]string)
+	for _, organization := range data.Organizations {
+		if len(organization.ParentID) == 0 {
+			continue
+		}
+		if organizationZones[organization.ParentID.String()] != organization.ZoneID.String() {
+			return fmt.Errorf("organization %s references parent organization %s of zone %s which is not in genesis",
+				organization.OrganizationID.String(), organization.ParentID.String(), organization.ZoneID.String())
+		}
+		parents[organization.OrganizationID.String()] = organization.ParentID.String()
+	}
+	for organizationID := range parents {
+		visited := make(map[string]bool)
+		for id, found := organizationID, true; found; id, found = parents[id] {
+			if visited[id] {
+				return fmt.Errorf("organization %s is its own ancestor", organizationID)
+			}
+			visited[id] = true
+		}
+	}
+
+	accounts := make(map[string]bool)
+	for _, account := range data.Accounts {
+		if account.Address.Empty() {
+			return fmt.Errorf("acl account should have an address")
+		}
+		if accounts[account.Address.String()] {
+			return fmt.Errorf("duplicate acl account %s", account.Address.String())
+		}
+		if zoneID, found := organizationZones[account.OrganizationID.String()]; !found || zoneID != account.ZoneID.String() {
+			return fmt.Errorf("acl account %s references organization %s of zone %s which is not in genesis",
+				account.Address.String(), account.OrganizationID.String(), account.ZoneID.String())
+		}
+		accounts[account.Address.String()] = true
+	}
+
+	for _, role := range data.Roles {
+		if err := role.ValidateBasic(); err != nil {
+			return err
+		}
+		if !zones[role.ZoneID.String()] {
+			return fmt.Errorf("role %s references zone %s which is not in genesis", role.Name, role.ZoneID.String())
+		}
+		if len(role.OrganizationID) != 0 && organizationZones[role.OrganizationID.String()] != role.ZoneID.String() {
+			return fmt.Errorf("role %s references organization %s of zone %s which is not in genesis",
+				role.Name, role.OrganizationID.String(), role.ZoneID.String())
+		}
+	}
+
+	for _, aclChangeLog := range data.ACLChangeLogs {
+		if !accounts[aclChangeLog.Address.String()] {
+			return fmt.Errorf("acl change log of %s which is not an acl account in genesis", aclChangeLog.Address.String())
+		}
+	}
+
+	rotated := make(map[string]bool)
+	for _, addressRotation := range data.AddressRotations {
+		if addressRotation.OldAddress.Empty() || addressRotation.NewAddress.Empty() ||
+			addressRotation.OldAddress.Equals(addressRotation.NewAddress) {
+			return fmt.Errorf("address rotation of zone %s should move between two different addresses",
+				addressRotation.ZoneID.String())
+		}
+		if rotated[addressRotation.OldAddress.String()] {
+			return fmt.Errorf("duplicate address rotation from %s", addressRotation.OldAddress.String())
+		}
+		rotated[addressRotation.OldAddress.String()] = true
+	}
+
+	kycs := make(map[string]bool)
+	for _, kyc := range data.KYCs {
+		if !accounts[kyc.Address.String()] {
+			return fmt.Errorf("kyc of %s which is not an acl account in genesis", kyc.Address.String())
+		}
+		if !zones[kyc.ZoneID.String()] {
+			return fmt.Errorf("kyc of %s references zone %s which is not in genesis", kyc.Address.String(), kyc.ZoneID.String())
+		}
+		if kycs[kyc.Address.String()] {
+			return fmt.Errorf("duplicate kyc of %s", kyc.Address.String())
+		}
+		kycs[kyc.Address.String()] = true
+	}
+
+	organizationTradingLimits := make(map[string]bool)
+	for _, organizationTradingLimit := range data.OrganizationTradingLimits {
+		if _, found := organizationZones[organizationTradingLimit.OrganizationID.String()]; !found {
+			return fmt.Errorf("trading limit of organization %s which is not in genesis",
+				organizationTradingLimit.OrganizationID.String())
+		}
+		if err := organizationTradingLimit.TradingLimit.ValidateBasic(); err != nil {
+			return err
+		}
+		if organizationTradingLimits[organizationTradingLimit.OrganizationID.String()] {
+			return fmt.Errorf("duplicate trading limit of organization %s", organizationTradingLimit.OrganizationID.String())
+		}
+		organizationTradingLimits[organizationTradingLimit.OrganizationID.String()] = true
+	}
+
+	accountTradingLimits := make(map[string]bool)
+	for _, accountTradingLimit := range data.AccountTradingLimits {
+		if accountTradingLimit.Address.Empty() {
+			return fmt.Errorf("account trading limit should have an address")
+		}
+		if err := accountTradingLimit.TradingLimit.ValidateBasic(); err != nil {
+			return err
+		}
+		if accountTradingLimits[accountTradingLimit.Address.String()] {
+			return fmt.Errorf("duplicate trading limit of %s", accountTradingLimit.Address.String())
+		}
+		accountTradingLimits[accountTradingLimit.Address.String()] = true
+	}
+
+	tradingUsages := make(map[string]bool)
+	for _, tradingUsage := range data.TradingUsages {
+		if tradingUsage.Address.Empty() || tradingUsage.OpenNegotiations < 0 {
+			return fmt.Errorf("trading usage should have an address and no negative open negotiations")
+		}
+		if tradingUsages[tradingUsage.Address.String()] {
+			return fmt.Errorf("duplicate trading usage of %s", tradingUsage.Address.String())
+		}
+		tradingUsages[tradingUsage.Address.String()] = true
+	}
+
+	blockedCounterparties := make(map[string]bool)
+	for _, blockedCounterparty := range data.BlockedCounterparties {
+		if blockedCounterparty.Address.Empty() {
+			return fmt.Errorf("blocked counterparty should have an address")
+		}
+		if !zones[blockedCounterparty.ZoneID.String()] {
+			return fmt.Errorf("blocked counterparty %s references zone %s which is not in genesis",
+				blockedCounterparty.Address.String(), blockedCounterparty.ZoneID.String())
+		}
+		if len(blockedCounterparty.OrganizationID) != 0 &&
+			organizationZones[blockedCounterparty.OrganizationID.String()] != blockedCounterparty.ZoneID.String() {
+			return fmt.Errorf("blocked counterparty %s references organization %s of zone %s which is not in genesis",
+				blockedCounterparty.Address.String(), blockedCounterparty.OrganizationID.String(), blockedCounterparty.ZoneID.String())
+		}
+		if blockedCounterparties[string(blockedCounterparty.GetKey())] {
+			return fmt.Errorf("duplicate blocked counterparty %s", blockedCounterparty.Address.String())
+		}
+		blockedCounterparties[string(blockedCounterparty.GetKey())] = true
+	}
+
+	freezes := make(map[string]bool)
+	for _, freeze := range data.Freezes {
+		if freeze.Address.Empty() {
+			return fmt.Errorf("freeze should have an address")
+		}
+		if err := ValidatePeg(freeze.PegType, freeze.PegHash); err != nil {
+			return err
+		}
+		if !zones[freeze.ZoneID.String()] {
+			return fmt.Errorf("freeze of %s references zone %s which is not in genesis", freeze.Address.String(),
+				freeze.ZoneID.String())
+		}
+		if freezes[string(freeze.GetKey())] {
+			return fmt.Errorf("duplicate freeze of %s", freeze.Address.String())
+		}
+		freezes[string(freeze.GetKey())] = true
+	}
+
+	for _, clawback := range data.Clawbacks {
+		if clawback.FromAddress.Empty() || clawback.IssuerAddress.Empty() || len(clawback.PegHash) == 0 {
+			return fmt.Errorf("clawback should have addresses and a pegHash")
+		}
+		if err := ValidatePeg(clawback.PegType, clawback.PegHash); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
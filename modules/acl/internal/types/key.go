@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +23,40 @@ var (
 
 	ACLKey = []byte{0x03}
 
+	RoleKey = []byte{0x04}
+
+	ACLChangeLogKey = []byte{0x05}
+
+	ZoneOrganizationKey = []byte{0x06}
+
+	OrganizationACLAccountKey = []byte{0x07}
+
+	SuspendedZoneKey = []byte{0x08}
+
+	AddressRotationKey = []byte{0x09}
+
+	SubOrganizationKey = []byte{0x0A}
+
+	KYCKey = []byte{0x0B}
+
+	KYCExpiryKey = []byte{0x0C}
+
+	OrganizationTradingLimitKey = []byte{0x0D}
+
+	AccountTradingLimitKey = []byte{0x0E}
+
+	TradingUsageKey = []byte{0x0F}
+
+	ZoneDenylistKey = []byte{0x10}
+
+	OrganizationBlockedCounterpartyKey = []byte{0x11}
+
+	FrozenWalletKey = []byte{0x12}
+
+	FrozenPegKey = []byte{0x13}
+
+	ClawbackKey = []byte{0x14}
+
 	DefaultZoneID         = []byte("zone")
 	DefaultOrganizationID = []byte("organization")
 )
@@ -36,3 +72,157 @@ func GetOrganizationKey(id OrganizationID) []byte {
 func GetACLAccountKey(address cTypes.AccAddress) []byte {
 	return append(ACLKey, address.Bytes()...)
 }
+
+// acl/{0x04}/{len(zoneID)}/{zoneID}/{len(organizationID)}/{organizationID}/{name}
+func GetRoleKey(zoneID ZoneID, organizationID OrganizationID, name string) []byte {
+	return append(GetRolesKey(zoneID, organizationID), []byte(name)...)
+}
+
+// acl/{0x04}/{len(zoneID)}/{zoneID}/{len(organizationID)}/{organizationID}
+func GetRolesKey(zoneID ZoneID, organizationID OrganizationID) []byte {
+	key := append(append(RoleKey, byte(len(zoneID))), zoneID...)
+	return append(append(key, byte(len(organizationID))), organizationID...)
+}
+
+// acl/{0x05}/{len(address)}/{address}/{height}/{seq} entry of the change log of an account, seq orders the changes of a height
+func GetACLChangeKey(address cTypes.AccAddress, height int64, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(GetACLChangeHeightKey(address, height), bz...)
+}
+
+func GetACLChangeHeightKey(address cTypes.AccAddress, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetACLChangeLogKey(address), bz...)
+}
+
+func GetACLChangeLogKey(address cTypes.AccAddress) []byte {
+	return append(append(ACLChangeLogKey, byte(len(address))), address.Bytes()...)
+}
+
+// GetACLChangeLogAddress : address of the account of a change log entry key
+func GetACLChangeLogAddress(key []byte) cTypes.AccAddress {
+	return cTypes.AccAddress(key[len(ACLChangeLogKey)+1 : len(ACLChangeLogKey)+1+int(key[len(ACLChangeLogKey)])])
+}
+
+// acl/{0x06}/{len(zoneID)}/{zoneID}/{organizationID} index of the organizations of a zone
+func GetZoneOrganizationKey(zoneID ZoneID, organizationID OrganizationID) []byte {
+	return append(GetZoneOrganizationsKey(zoneID), organizationID...)
+}
+
+func GetZoneOrganizationsKey(zoneID ZoneID) []byte {
+	return append(append(ZoneOrganizationKey, byte(len(zoneID))), zoneID...)
+}
+
+// acl/{0x07}/{len(organizationID)}/{organizationID}/{address} index of the acl accounts of an organization
+func GetOrganizationACLAccountKey(organizationID OrganizationID, address cTypes.AccAddress) []byte {
+	return append(GetOrganizationACLAccountsKey(organizationID), address.Bytes()...)
+}
+
+func GetOrganizationACLAccountsKey(organizationID OrganizationID) []byte {
+	return append(append(OrganizationACLAccountKey, byte(len(organizationID))), organizationID...)
+}
+
+// acl/{0x08}/{zoneID}
+func GetSuspendedZoneKey(zoneID ZoneID) []byte {
+	return append(SuspendedZoneKey, zoneID...)
+}
+
+// acl/{0x09}/{len(oldAddress)}/{oldAddress}/{len(zoneID)}/{zoneID}/{organizationID}, the organizationID is empty for zones
+func GetAddressRotationKey(oldAddress cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID) []byte {
+	key := append(append(GetAddressRotationsKey(oldAddress), byte(len(zoneID))), zoneID...)
+	return append(key, organizationID...)
+}
+
+func GetAddressRotationsKey(oldAddress cTypes.AccAddress) []byte {
+	return append(append(AddressRotationKey, byte(len(oldAddress))), oldAddress.Bytes()...)
+}
+
+// acl/{0x0A}/{len(parentID)}/{parentID}/{organizationID} index of the sub organizations of an organization
+func GetSubOrganizationKey(parentID OrganizationID, organizationID OrganizationID) []byte {
+	return append(GetSubOrganizationsKey(parentID), organizationID...)
+}
+
+func GetSubOrganizationsKey(parentID OrganizationID) []byte {
+	return append(append(SubOrganizationKey, byte(len(parentID))), parentID...)
+}
+
+// acl/{0x0B}/{address}
+func GetKYCKey(address cTypes.AccAddress) []byte {
+	return append(KYCKey, address.Bytes()...)
+}
+
+// acl/{0x0C}/{len(zoneID)}/{zoneID}/{expiresAt}/{address} index of the kyc of a zone by expiry height
+func GetKYCExpiryKey(zoneID ZoneID, expiresAt int64, address cTypes.AccAddress) []byte {
+	return append(GetKYCExpiryHeightKey(zoneID, expiresAt), address.Bytes()...)
+}
+
+func GetKYCExpiryHeightKey(zoneID ZoneID, expiresAt int64) []byte {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(expiresAt))
+	return append(GetKYCExpiriesKey(zoneID), height...)
+}
+
+func GetKYCExpiriesKey(zoneID ZoneID) []byte {
+	return append(append(KYCExpiryKey, byte(len(zoneID))), zoneID...)
+}
+
+// acl/{0x0D}/{organizationID}
+func GetOrganizationTradingLimitKey(organizationID OrganizationID) []byte {
+	return append(OrganizationTradingLimitKey, organizationID...)
+}
+
+// acl/{0x0E}/{address}
+func GetAccountTradingLimitKey(address cTypes.AccAddress) []byte {
+	return append(AccountTradingLimitKey, address.Bytes()...)
+}
+
+// acl/{0x0F}/{address}
+func GetTradingUsageKey(address cTypes.AccAddress) []byte {
+	return append(TradingUsageKey, address.Bytes()...)
+}
+
+// acl/{0x10}/{len(zoneID)}/{zoneID}/{address} denylist of a zone
+func GetZoneDenylistKey(zoneID ZoneID, address cTypes.AccAddress) []byte {
+	return append(GetZoneDenylistPrefix(zoneID), address.Bytes()...)
+}
+
+func GetZoneDenylistPrefix(zoneID ZoneID) []byte {
+	return append(append(ZoneDenylistKey, byte(len(zoneID))), zoneID...)
+}
+
+// acl/{0x11}/{len(organizationID)}/{organizationID}/{address} blocked partners of an organization
+func GetOrganizationBlockedCounterpartyKey(organizationID OrganizationID, address cTypes.AccAddress) []byte {
+	return append(GetOrganizationBlockedCounterpartiesKey(organizationID), address.Bytes()...)
+}
+
+func GetOrganizationBlockedCounterpartiesKey(organizationID OrganizationID) []byte {
+	return append(append(OrganizationBlockedCounterpartyKey, byte(len(organizationID))), organizationID...)
+}
+
+// acl/{0x12}/{address}
+func GetFrozenWalletKey(address cTypes.AccAddress) []byte {
+	return append(FrozenWalletKey, address.Bytes()...)
+}
+
+// acl/{0x13}/{len(address)}/{address}/{len(pegType)}/{pegType}/{pegHash} pegs frozen in the wallet of a holder
+func GetFrozenPegKey(address cTypes.AccAddress, pegType string, pegHash []byte) []byte {
+	return append(append(append(GetFrozenPegsKey(address), byte(len(pegType))), pegType...), pegHash...)
+}
+
+func GetFrozenPegsKey(address cTypes.AccAddress) []byte {
+	return append(append(FrozenPegKey, byte(len(address))), address.Bytes()...)
+}
+
+// acl/{0x14}/{len(pegType)}/{pegType}/{len(pegHash)}/{pegHash}/{height}/{address} clawbacks of a peg by height
+func GetClawbackKey(pegType string, pegHash []byte, height int64, address cTypes.AccAddress) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(append(GetPegClawbacksKey(pegType, pegHash), heightBytes...), address.Bytes()...)
+}
+
+func GetPegClawbacksKey(pegType string, pegHash []byte) []byte {
+	key := append(append(ClawbackKey, byte(len(pegType))), pegType...)
+	return append(append(key, byte(len(pegHash))), pegHash...)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KYC : kyc of an account verified off chain by the zone, a zero ExpiresAt never expires
+type KYC struct {
+	Address      cTypes.AccAddress `json:"address"`
+	Level        int64             `json:"level"`
+	ZoneID       ZoneID            `json:"zoneID"`
+	DocumentHash string            `json:"documentHash"`
+	VerifiedAt   int64             `json:"verifiedAt"`
+	ExpiresAt    int64             `json:"expiresAt"`
+}
+
+func NewKYC(address cTypes.AccAddress, level int64, zoneID ZoneID, documentHash string, verifiedAt int64, expiresAt int64) KYC {
+	return KYC{
+		Address:      address,
+		Level:        level,
+		ZoneID:       zoneID,
+		DocumentHash: documentHash,
+		VerifiedAt:   verifiedAt,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// IsExpired : the kyc expired at or before the height
+func (kyc KYC) IsExpired(height int64) bool {
+	return kyc.ExpiresAt != 0 && kyc.ExpiresAt <= height
+}
+
+func (kyc KYC) String() string {
+	return fmt.Sprintf(`
+Address: %s
+Level: %d
+ZoneID: %s
+DocumentHash: %s
+VerifiedAt: %d
+ExpiresAt: %d
+`, kyc.Address.String(), kyc.Level, kyc.ZoneID.String(), kyc.DocumentHash, kyc.VerifiedAt, kyc.ExpiresAt)
+}
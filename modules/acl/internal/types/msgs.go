@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/types"
 )
 
 // DefineZone : singular define zone message
@@ -361,3 +363,443 @@ func BuildMsgDefineACL(from cTypes.AccAddress, to cTypes.AccAddress, aclAccount
 	defineACL := NewDefineACL(from, to, aclAccount)
 	return NewMsgDefineACLs([]DefineACL{defineACL})
 }
+
+// BlockCounterparty : singular block counterparty message, onto the blocked partners of the organization when it has
+// an organization id and onto the denylist of the zone otherwise
+type BlockCounterparty struct {
+	From           cTypes.AccAddress `json:"from"`
+	ZoneID         ZoneID            `json:"zoneID"`
+	OrganizationID OrganizationID    `json:"organizationID"`
+	Address        cTypes.AccAddress `json:"address"`
+	Reason         string            `json:"reason"`
+}
+
+// NewBlockCounterparty : new block counterparty struct
+func NewBlockCounterparty(from cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID, address cTypes.AccAddress, reason string) BlockCounterparty {
+	return BlockCounterparty{from, zoneID, organizationID, address, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in BlockCounterparty) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From           string `json:"from"`
+		ZoneID         string `json:"zoneID"`
+		OrganizationID string `json:"organizationID"`
+		Address        string `json:"address"`
+		Reason         string `json:"reason"`
+	}{
+		From:           in.From.String(),
+		ZoneID:         in.ZoneID.String(),
+		OrganizationID: in.OrganizationID.String(),
+		Address:        in.Address.String(),
+		Reason:         in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in BlockCounterparty) ValidateBasic() cTypes.Error {
+	if len(in.From) == 0 {
+		return cTypes.ErrInvalidAddress(in.From.String())
+	} else if len(in.Address) == 0 {
+		return cTypes.ErrInvalidAddress(in.Address.String())
+	} else if (len(in.ZoneID) == 0) == (len(in.OrganizationID) == 0) {
+		return cTypes.ErrUnknownRequest("either zoneID or organizationID should be set")
+	} else if len(in.Reason) == 0 {
+		return cTypes.ErrUnknownRequest("reason should not be empty")
+	}
+	return nil
+}
+
+// MsgBlockCounterparties : message block counterparties
+type MsgBlockCounterparties struct {
+	BlockCounterparties []BlockCounterparty `json:"blockCounterparties"`
+}
+
+// NewMsgBlockCounterparties : new message block counterparties
+func NewMsgBlockCounterparties(blockCounterparties []BlockCounterparty) MsgBlockCounterparties {
+	return MsgBlockCounterparties{blockCounterparties}
+}
+
+var _ cTypes.Msg = MsgBlockCounterparties{}
+
+// Type : implements msg
+func (msg MsgBlockCounterparties) Type() string { return "blockCounterparty" }
+
+func (msg MsgBlockCounterparties) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBlockCounterparties) ValidateBasic() cTypes.Error {
+	if len(msg.BlockCounterparties) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.BlockCounterparties {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBlockCounterparties) GetSignBytes() []byte {
+	var blockCounterparties []json.RawMessage
+	for _, blockCounterparty := range msg.BlockCounterparties {
+		blockCounterparties = append(blockCounterparties, blockCounterparty.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		BlockCounterparties []json.RawMessage `json:"blockCounterparties"`
+	}{
+		BlockCounterparties: blockCounterparties,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBlockCounterparties) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.BlockCounterparties))
+	for i, in := range msg.BlockCounterparties {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgBlockCounterparty : build block counterparties message
+func BuildMsgBlockCounterparty(from cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID, address cTypes.AccAddress, reason string) cTypes.Msg {
+	blockCounterparty := NewBlockCounterparty(from, zoneID, organizationID, address, reason)
+	return NewMsgBlockCounterparties([]BlockCounterparty{blockCounterparty})
+}
+
+// UnblockCounterparty : singular unblock counterparty message, from the blocked partners of the organization when it
+// has an organization id and from the denylist of the zone otherwise
+type UnblockCounterparty struct {
+	From           cTypes.AccAddress `json:"from"`
+	ZoneID         ZoneID            `json:"zoneID"`
+	OrganizationID OrganizationID    `json:"organizationID"`
+	Address        cTypes.AccAddress `json:"address"`
+}
+
+// NewUnblockCounterparty : new unblock counterparty struct
+func NewUnblockCounterparty(from cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID, address cTypes.AccAddress) UnblockCounterparty {
+	return UnblockCounterparty{from, zoneID, organizationID, address}
+}
+
+// GetSignBytes : get bytes to sign
+func (in UnblockCounterparty) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From           string `json:"from"`
+		ZoneID         string `json:"zoneID"`
+		OrganizationID string `json:"organizationID"`
+		Address        string `json:"address"`
+	}{
+		From:           in.From.String(),
+		ZoneID:         in.ZoneID.String(),
+		OrganizationID: in.OrganizationID.String(),
+		Address:        in.Address.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in UnblockCounterparty) ValidateBasic() cTypes.Error {
+	if len(in.From) == 0 {
+		return cTypes.ErrInvalidAddress(in.From.String())
+	} else if len(in.Address) == 0 {
+		return cTypes.ErrInvalidAddress(in.Address.String())
+	} else if (len(in.ZoneID) == 0) == (len(in.OrganizationID) == 0) {
+		return cTypes.ErrUnknownRequest("either zoneID or organizationID should be set")
+	}
+	return nil
+}
+
+// MsgUnblockCounterparties : message unblock counterparties
+type MsgUnblockCounterparties struct {
+	UnblockCounterparties []UnblockCounterparty `json:"unblockCounterparties"`
+}
+
+// NewMsgUnblockCounterparties : new message unblock counterparties
+func NewMsgUnblockCounterparties(unblockCounterparties []UnblockCounterparty) MsgUnblockCounterparties {
+	return MsgUnblockCounterparties{unblockCounterparties}
+}
+
+var _ cTypes.Msg = MsgUnblockCounterparties{}
+
+// Type : implements msg
+func (msg MsgUnblockCounterparties) Type() string { return "unblockCounterparty" }
+
+func (msg MsgUnblockCounterparties) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgUnblockCounterparties) ValidateBasic() cTypes.Error {
+	if len(msg.UnblockCounterparties) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.UnblockCounterparties {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgUnblockCounterparties) GetSignBytes() []byte {
+	var unblockCounterparties []json.RawMessage
+	for _, unblockCounterparty := range msg.UnblockCounterparties {
+		unblockCounterparties = append(unblockCounterparties, unblockCounterparty.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		UnblockCounterparties []json.RawMessage `json:"unblockCounterparties"`
+	}{
+		UnblockCounterparties: unblockCounterparties,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgUnblockCounterparties) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.UnblockCounterparties))
+	for i, in := range msg.UnblockCounterparties {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgUnblockCounterparty : build unblock counterparties message
+func BuildMsgUnblockCounterparty(from cTypes.AccAddress, zoneID ZoneID, organizationID OrganizationID, address cTypes.AccAddress) cTypes.Msg {
+	unblockCounterparty := NewUnblockCounterparty(from, zoneID, organizationID, address)
+	return NewMsgUnblockCounterparties([]UnblockCounterparty{unblockCounterparty})
+}
+
+// FreezePeg : singular freeze peg message, of the asset or fiat peg when it has a peg hash and of the whole wallet of
+// the address otherwise
+type FreezePeg struct {
+	From    cTypes.AccAddress `json:"from"`
+	Address cTypes.AccAddress `json:"address"`
+	PegType string            `json:"pegType"`
+	PegHash types.PegHash     `json:"pegHash"`
+	Reason  string            `json:"reason"`
+}
+
+// NewFreezePeg : new freeze peg struct
+func NewFreezePeg(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash, reason string) FreezePeg {
+	return FreezePeg{from, address, pegType, pegHash, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in FreezePeg) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From    string `json:"from"`
+		Address string `json:"address"`
+		PegType string `json:"pegType"`
+		PegHash string `json:"pegHash"`
+		Reason  string `json:"reason"`
+	}{
+		From:    in.From.String(),
+		Address: in.Address.String(),
+		PegType: in.PegType,
+		PegHash: in.PegHash.String(),
+		Reason:  in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in FreezePeg) ValidateBasic() cTypes.Error {
+	if len(in.From) == 0 {
+		return cTypes.ErrInvalidAddress(in.From.String())
+	} else if len(in.Address) == 0 {
+		return cTypes.ErrInvalidAddress(in.Address.String())
+	} else if err := ValidatePeg(in.PegType, in.PegHash); err != nil {
+		return err
+	} else if len(in.Reason) == 0 {
+		return cTypes.ErrUnknownRequest("reason should not be empty")
+	}
+	return nil
+}
+
+// MsgFreezePegs : message freeze pegs
+type MsgFreezePegs struct {
+	FreezePegs []FreezePeg `json:"freezePegs"`
+}
+
+// NewMsgFreezePegs : new message freeze pegs
+func NewMsgFreezePegs(freezePegs []FreezePeg) MsgFreezePegs {
+	return MsgFreezePegs{freezePegs}
+}
+
+var _ cTypes.Msg = MsgFreezePegs{}
+
+// Type : implements msg
+func (msg MsgFreezePegs) Type() string { return "freezePeg" }
+
+func (msg MsgFreezePegs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgFreezePegs) ValidateBasic() cTypes.Error {
+	if len(msg.FreezePegs) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.FreezePegs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgFreezePegs) GetSignBytes() []byte {
+	var freezePegs []json.RawMessage
+	for _, freezePeg := range msg.FreezePegs {
+		freezePegs = append(freezePegs, freezePeg.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		FreezePegs []json.RawMessage `json:"freezePegs"`
+	}{
+		FreezePegs: freezePegs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgFreezePegs) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.FreezePegs))
+	for i, in := range msg.FreezePegs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgFreezePeg : build freeze pegs message
+func BuildMsgFreezePeg(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash, reason string) cTypes.Msg {
+	freezePeg := NewFreezePeg(from, address, pegType, pegHash, reason)
+	return NewMsgFreezePegs([]FreezePeg{freezePeg})
+}
+
+// UnfreezePeg : singular unfreeze peg message, of the asset or fiat peg when it has a peg hash and of the whole wallet
+// of the address otherwise
+type UnfreezePeg struct {
+	From    cTypes.AccAddress `json:"from"`
+	Address cTypes.AccAddress `json:"address"`
+	PegType string            `json:"pegType"`
+	PegHash types.PegHash     `json:"pegHash"`
+}
+
+// NewUnfreezePeg : new unfreeze peg struct
+func NewUnfreezePeg(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash) UnfreezePeg {
+	return UnfreezePeg{from, address, pegType, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in UnfreezePeg) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From    string `json:"from"`
+		Address string `json:"address"`
+		PegType string `json:"pegType"`
+		PegHash string `json:"pegHash"`
+	}{
+		From:    in.From.String(),
+		Address: in.Address.String(),
+		PegType: in.PegType,
+		PegHash: in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in UnfreezePeg) ValidateBasic() cTypes.Error {
+	if len(in.From) == 0 {
+		return cTypes.ErrInvalidAddress(in.From.String())
+	} else if len(in.Address) == 0 {
+		return cTypes.ErrInvalidAddress(in.Address.String())
+	}
+	return ValidatePeg(in.PegType, in.PegHash)
+}
+
+// MsgUnfreezePegs : message unfreeze pegs
+type MsgUnfreezePegs struct {
+	UnfreezePegs []UnfreezePeg `json:"unfreezePegs"`
+}
+
+// NewMsgUnfreezePegs : new message unfreeze pegs
+func NewMsgUnfreezePegs(unfreezePegs []UnfreezePeg) MsgUnfreezePegs {
+	return MsgUnfreezePegs{unfreezePegs}
+}
+
+var _ cTypes.Msg = MsgUnfreezePegs{}
+
+// Type : implements msg
+func (msg MsgUnfreezePegs) Type() string { return "unfreezePeg" }
+
+func (msg MsgUnfreezePegs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgUnfreezePegs) ValidateBasic() cTypes.Error {
+	if len(msg.UnfreezePegs) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.UnfreezePegs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgUnfreezePegs) GetSignBytes() []byte {
+	var unfreezePegs []json.RawMessage
+	for _, unfreezePeg := range msg.UnfreezePegs {
+		unfreezePegs = append(unfreezePegs, unfreezePeg.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		UnfreezePegs []json.RawMessage `json:"unfreezePegs"`
+	}{
+		UnfreezePegs: unfreezePegs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgUnfreezePegs) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.UnfreezePegs))
+	for i, in := range msg.UnfreezePegs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgUnfreezePeg : build unfreeze pegs message
+func BuildMsgUnfreezePeg(from cTypes.AccAddress, address cTypes.AccAddress, pegType string, pegHash types.PegHash) cTypes.Msg {
+	unfreezePeg := NewUnfreezePeg(from, address, pegType, pegHash)
+	return NewMsgUnfreezePegs([]UnfreezePeg{unfreezePeg})
+}
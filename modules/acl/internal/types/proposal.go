@@ -0,0 +1,181 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+)
+
+const (
+	ProposalTypeCreateZone        = "CreateZone"
+	ProposalTypeRotateZoneAddress = "RotateZoneAddress"
+	ProposalTypeSuspendZone       = "SuspendZone"
+	ProposalTypeRemoveZone        = "RemoveZone"
+)
+
+var (
+	_ govTypes.Content = CreateZoneProposal{}
+	_ govTypes.Content = RotateZoneAddressProposal{}
+	_ govTypes.Content = SuspendZoneProposal{}
+	_ govTypes.Content = RemoveZoneProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeCreateZone)
+	govTypes.RegisterProposalTypeCodec(CreateZoneProposal{}, "commit-blockchain/CreateZoneProposal")
+	govTypes.RegisterProposalType(ProposalTypeRotateZoneAddress)
+	govTypes.RegisterProposalTypeCodec(RotateZoneAddressProposal{}, "commit-blockchain/RotateZoneAddressProposal")
+	govTypes.RegisterProposalType(ProposalTypeSuspendZone)
+	govTypes.RegisterProposalTypeCodec(SuspendZoneProposal{}, "commit-blockchain/SuspendZoneProposal")
+	govTypes.RegisterProposalType(ProposalTypeRemoveZone)
+	govTypes.RegisterProposalTypeCodec(RemoveZoneProposal{}, "commit-blockchain/RemoveZoneProposal")
+}
+
+// CreateZoneProposal : defines a zone with its address
+type CreateZoneProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	ZoneID      ZoneID            `json:"zoneID"`
+	Address     cTypes.AccAddress `json:"address"`
+}
+
+func NewCreateZoneProposal(title, description string, zoneID ZoneID, address cTypes.AccAddress) CreateZoneProposal {
+	return CreateZoneProposal{title, description, zoneID, address}
+}
+
+func (proposal CreateZoneProposal) GetTitle() string       { return proposal.Title }
+func (proposal CreateZoneProposal) GetDescription() string { return proposal.Description }
+func (proposal CreateZoneProposal) ProposalRoute() string  { return RouterKey }
+func (proposal CreateZoneProposal) ProposalType() string   { return ProposalTypeCreateZone }
+
+func (proposal CreateZoneProposal) ValidateBasic() cTypes.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodeSpace, proposal); err != nil {
+		return err
+	}
+	if len(proposal.ZoneID) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "zoneID should not be empty")
+	} else if proposal.Address.Empty() {
+		return ErrInvalidAddress(DefaultCodeSpace, "zone address should not be empty")
+	}
+	return nil
+}
+
+func (proposal CreateZoneProposal) String() string {
+	return fmt.Sprintf(`Create Zone Proposal:
+  Title:       %s
+  Description: %s
+  ZoneID:      %s
+  Address:     %s
+`, proposal.Title, proposal.Description, proposal.ZoneID.String(), proposal.Address.String())
+}
+
+// RotateZoneAddressProposal : moves a zone to a new address
+type RotateZoneAddressProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	ZoneID      ZoneID            `json:"zoneID"`
+	NewAddress  cTypes.AccAddress `json:"newAddress"`
+}
+
+func NewRotateZoneAddressProposal(title, description string, zoneID ZoneID, newAddress cTypes.AccAddress) RotateZoneAddressProposal {
+	return RotateZoneAddressProposal{title, description, zoneID, newAddress}
+}
+
+func (proposal RotateZoneAddressProposal) GetTitle() string       { return proposal.Title }
+func (proposal RotateZoneAddressProposal) GetDescription() string { return proposal.Description }
+func (proposal RotateZoneAddressProposal) ProposalRoute() string  { return RouterKey }
+func (proposal RotateZoneAddressProposal) ProposalType() string   { return ProposalTypeRotateZoneAddress }
+
+func (proposal RotateZoneAddressProposal) ValidateBasic() cTypes.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodeSpace, proposal); err != nil {
+		return err
+	}
+	if len(proposal.ZoneID) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "zoneID should not be empty")
+	} else if proposal.NewAddress.Empty() {
+		return ErrInvalidAddress(DefaultCodeSpace, "new zone address should not be empty")
+	}
+	return nil
+}
+
+func (proposal RotateZoneAddressProposal) String() string {
+	return fmt.Sprintf(`Rotate Zone Address Proposal:
+  Title:       %s
+  Description: %s
+  ZoneID:      %s
+  NewAddress:  %s
+`, proposal.Title, proposal.Description, proposal.ZoneID.String(), proposal.NewAddress.String())
+}
+
+// SuspendZoneProposal : suspends a zone, or reinstates it when Suspended is false. While suspended the zone address has no
+// zone authority and the acl accounts of the zone have no permissions
+type SuspendZoneProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ZoneID      ZoneID `json:"zoneID"`
+	Suspended   bool   `json:"suspended"`
+}
+
+func NewSuspendZoneProposal(title, description string, zoneID ZoneID, suspended bool) SuspendZoneProposal {
+	return SuspendZoneProposal{title, description, zoneID, suspended}
+}
+
+func (proposal SuspendZoneProposal) GetTitle() string       { return proposal.Title }
+func (proposal SuspendZoneProposal) GetDescription() string { return proposal.Description }
+func (proposal SuspendZoneProposal) ProposalRoute() string  { return RouterKey }
+func (proposal SuspendZoneProposal) ProposalType() string   { return ProposalTypeSuspendZone }
+
+func (proposal SuspendZoneProposal) ValidateBasic() cTypes.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodeSpace, proposal); err != nil {
+		return err
+	}
+	if len(proposal.ZoneID) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "zoneID should not be empty")
+	}
+	return nil
+}
+
+func (proposal SuspendZoneProposal) String() string {
+	return fmt.Sprintf(`Suspend Zone Proposal:
+  Title:       %s
+  Description: %s
+  ZoneID:      %s
+  Suspended:   %t
+`, proposal.Title, proposal.Description, proposal.ZoneID.String(), proposal.Suspended)
+}
+
+// RemoveZoneProposal : removes a zone without organizations, with its roles
+type RemoveZoneProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ZoneID      ZoneID `json:"zoneID"`
+}
+
+func NewRemoveZoneProposal(title, description string, zoneID ZoneID) RemoveZoneProposal {
+	return RemoveZoneProposal{title, description, zoneID}
+}
+
+func (proposal RemoveZoneProposal) GetTitle() string       { return proposal.Title }
+func (proposal RemoveZoneProposal) GetDescription() string { return proposal.Description }
+func (proposal RemoveZoneProposal) ProposalRoute() string  { return RouterKey }
+func (proposal RemoveZoneProposal) ProposalType() string   { return ProposalTypeRemoveZone }
+
+func (proposal RemoveZoneProposal) ValidateBasic() cTypes.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodeSpace, proposal); err != nil {
+		return err
+	}
+	if len(proposal.ZoneID) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "zoneID should not be empty")
+	}
+	return nil
+}
+
+func (proposal RemoveZoneProposal) String() string {
+	return fmt.Sprintf(`Remove Zone Proposal:
+  Title:       %s
+  Description: %s
+  ZoneID:      %s
+`, proposal.Title, proposal.Description, proposal.ZoneID.String())
+}
@@ -0,0 +1,69 @@
+package types
+
+// QueryZonesParams : page of the zones
+type QueryZonesParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+func NewQueryZonesParams(page, limit int) QueryZonesParams {
+	return QueryZonesParams{page, limit}
+}
+
+// QueryOrganizationsParams : page of the organizations of a zone
+type QueryOrganizationsParams struct {
+	ZoneID ZoneID `json:"zoneID"`
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+}
+
+func NewQueryOrganizationsParams(zoneID ZoneID, page, limit int) QueryOrganizationsParams {
+	return QueryOrganizationsParams{zoneID, page, limit}
+}
+
+// QueryACLAccountsParams : page of the acl accounts of an organization
+type QueryACLAccountsParams struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Page           int            `json:"page"`
+	Limit          int            `json:"limit"`
+}
+
+func NewQueryACLAccountsParams(organizationID OrganizationID, page, limit int) QueryACLAccountsParams {
+	return QueryACLAccountsParams{organizationID, page, limit}
+}
+
+// QuerySubOrganizationsParams : page of the sub organizations of an organization
+type QuerySubOrganizationsParams struct {
+	ParentID OrganizationID `json:"parentID"`
+	Page     int            `json:"page"`
+	Limit    int            `json:"limit"`
+}
+
+func NewQuerySubOrganizationsParams(parentID OrganizationID, page, limit int) QuerySubOrganizationsParams {
+	return QuerySubOrganizationsParams{parentID, page, limit}
+}
+
+// QueryExpiringKYCsParams : page of the kyc of a zone expiring at or before the height
+type QueryExpiringKYCsParams struct {
+	ZoneID ZoneID `json:"zoneID"`
+	Height int64  `json:"height"`
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+}
+
+func NewQueryExpiringKYCsParams(zoneID ZoneID, height int64, page, limit int) QueryExpiringKYCsParams {
+	return QueryExpiringKYCsParams{zoneID, height, page, limit}
+}
+
+// QueryBlockedCounterpartiesParams : page of the blocked partners of an organization, or of the denylist of a zone
+// without an organization id
+type QueryBlockedCounterpartiesParams struct {
+	ZoneID         ZoneID         `json:"zoneID"`
+	OrganizationID OrganizationID `json:"organizationID"`
+	Page           int            `json:"page"`
+	Limit          int            `json:"limit"`
+}
+
+func NewQueryBlockedCounterpartiesParams(zoneID ZoneID, organizationID OrganizationID, page, limit int) QueryBlockedCounterpartiesParams {
+	return QueryBlockedCounterpartiesParams{zoneID, organizationID, page, limit}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Role : named permission set of a zone, or of one organization of the zone when OrganizationID is set. Accounts assigned
+// the role get its permissions on top of their own acl, so editing the role applies to all of them at once.
+type Role struct {
+	Name           string         `json:"name"`
+	ZoneID         ZoneID         `json:"zoneID"`
+	OrganizationID OrganizationID `json:"organizationID"`
+	ACL            ACL            `json:"acl"`
+}
+
+func NewRole(name string, zoneID ZoneID, organizationID OrganizationID, acl ACL) Role {
+	return Role{
+		Name:           name,
+		ZoneID:         zoneID,
+		OrganizationID: organizationID,
+		ACL:            acl,
+	}
+}
+
+func (role Role) ValidateBasic() cTypes.Error {
+	if len(role.Name) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "role name should not be empty")
+	} else if len(role.ZoneID) == 0 {
+		return ErrInvalidID(DefaultCodeSpace, "zoneID should not be empty")
+	}
+	return nil
+}
+
+func (role Role) String() string {
+	return fmt.Sprintf(`
+Name: %s
+ZoneID: %s
+OrganizationID: %s
+ACL: %s
+`, role.Name, role.ZoneID.String(), role.OrganizationID.String(), role.ACL.String())
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FiatOutflowWindow : seconds of block time over which the daily fiat outflow of an account rolls
+const FiatOutflowWindow int64 = 24 * 60 * 60
+
+// TradingLimit : caps on the trading of an account, or of every account of an organization and its sub organizations,
+// a zero cap is unlimited
+type TradingLimit struct {
+	MaxBid              int64 `json:"maxBid"`
+	MaxDailyFiatOutflow int64 `json:"maxDailyFiatOutflow"`
+	MaxOpenNegotiations int64 `json:"maxOpenNegotiations"`
+}
+
+func NewTradingLimit(maxBid int64, maxDailyFiatOutflow int64, maxOpenNegotiations int64) TradingLimit {
+	return TradingLimit{
+		MaxBid:              maxBid,
+		MaxDailyFiatOutflow: maxDailyFiatOutflow,
+		MaxOpenNegotiations: maxOpenNegotiations,
+	}
+}
+
+func (limit TradingLimit) ValidateBasic() cTypes.Error {
+	if limit.MaxBid < 0 || limit.MaxDailyFiatOutflow < 0 || limit.MaxOpenNegotiations < 0 {
+		return cTypes.ErrUnknownRequest("trading limits should not be negative")
+	}
+	return nil
+}
+
+func (limit TradingLimit) IsZero() bool {
+	return limit == TradingLimit{}
+}
+
+// Tighten : the smaller of each cap of both limits, unlimited caps do not bound
+func (limit TradingLimit) Tighten(other TradingLimit) TradingLimit {
+	tighten := func(bound int64, otherBound int64) int64 {
+		if bound == 0 || (otherBound != 0 && otherBound < bound) {
+			return otherBound
+		}
+		return bound
+	}
+	return TradingLimit{
+		MaxBid:              tighten(limit.MaxBid, other.MaxBid),
+		MaxDailyFiatOutflow: tighten(limit.MaxDailyFiatOutflow, other.MaxDailyFiatOutflow),
+		MaxOpenNegotiations: tighten(limit.MaxOpenNegotiations, other.MaxOpenNegotiations),
+	}
+}
+
+func (limit TradingLimit) String() string {
+	return fmt.Sprintf(`
+MaxBid: %d
+MaxDailyFiatOutflow: %d
+MaxOpenNegotiations: %d
+`, limit.MaxBid, limit.MaxDailyFiatOutflow, limit.MaxOpenNegotiations)
+}
+
+// FiatOutflow : fiat sent by an account at a block time
+type FiatOutflow struct {
+	Time   int64 `json:"time"`
+	Amount int64 `json:"amount"`
+}
+
+// TradingUsage : fiat sent by an account within the rolling window and the negotiations it signed that its counterparty has not
+type TradingUsage struct {
+	Address          cTypes.AccAddress `json:"address"`
+	FiatOutflows     []FiatOutflow     `json:"fiatOutflows"`
+	OpenNegotiations int64             `json:"openNegotiations"`
+}
+
+// Prune : drops the fiat outflows that rolled out of the window ending at the time
+func (usage TradingUsage) Prune(time int64) TradingUsage {
+	var fiatOutflows []FiatOutflow
+	for _, fiatOutflow := range usage.FiatOutflows {
+		if fiatOutflow.Time > time-FiatOutflowWindow {
+			fiatOutflows = append(fiatOutflows, fiatOutflow)
+		}
+	}
+	usage.FiatOutflows = fiatOutflows
+	return usage
+}
+
+// DailyFiatOutflow : fiat sent within the window ending at the time
+func (usage TradingUsage) DailyFiatOutflow(time int64) int64 {
+	var total int64
+	for _, fiatOutflow := range usage.Prune(time).FiatOutflows {
+		total += fiatOutflow.Amount
+	}
+	return total
+}
+
+func (usage TradingUsage) IsZero() bool {
+	return len(usage.FiatOutflows) == 0 && usage.OpenNegotiations == 0
+}
+
+// TradingHeadroom : effective limit of an account with its usage and what remains of it, -1 remains of unlimited caps
+type TradingHeadroom struct {
+	Address                   cTypes.AccAddress `json:"address"`
+	TradingLimit              TradingLimit      `json:"tradingLimit"`
+	DailyFiatOutflow          int64             `json:"dailyFiatOutflow"`
+	RemainingDailyFiatOutflow int64             `json:"remainingDailyFiatOutflow"`
+	OpenNegotiations          int64             `json:"openNegotiations"`
+	RemainingOpenNegotiations int64             `json:"remainingOpenNegotiations"`
+}
+
+func NewTradingHeadroom(address cTypes.AccAddress, limit TradingLimit, usage TradingUsage, time int64) TradingHeadroom {
+	remaining := func(bound int64, used int64) int64 {
+		if bound == 0 {
+			return -1
+		}
+		if used >= bound {
+			return 0
+		}
+		return bound - used
+	}
+	dailyFiatOutflow := usage.DailyFiatOutflow(time)
+	return TradingHeadroom{
+		Address:                   address,
+		TradingLimit:              limit,
+		DailyFiatOutflow:          dailyFiatOutflow,
+		RemainingDailyFiatOutflow: remaining(limit.MaxDailyFiatOutflow, dailyFiatOutflow),
+		OpenNegotiations:          usage.OpenNegotiations,
+		RemainingOpenNegotiations: remaining(limit.MaxOpenNegotiations, usage.OpenNegotiations),
+	}
+}
+
+func (headroom TradingHeadroom) String() string {
+	return fmt.Sprintf(`
+Address: %s
+TradingLimit: %s
+DailyFiatOutflow: %d
+RemainingDailyFiatOutflow: %d
+OpenNegotiations: %d
+RemainingOpenNegotiations: %d
+`, headroom.Address.String(), headroom.TradingLimit.String(), headroom.DailyFiatOutflow,
+		headroom.RemainingDailyFiatOutflow, headroom.OpenNegotiations, headroom.RemainingOpenNegotiations)
+}
@@ -44,11 +44,26 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
-	rest.RegisterRoutes(ctx, rtr)
+	rest.RegisterRoutes(ctx, rtr, kafkaBool, kafkaState)
 }
 
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil // TODO
+	aclTxCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "acl transaction sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	aclTxCmd.AddCommand(client.PostCommands(
+		cli.BlockCounterpartyCmd(cdc),
+		cli.UnblockCounterpartyCmd(cdc),
+		cli.FreezePegCmd(cdc),
+		cli.UnfreezePegCmd(cdc),
+	)...)
+
+	return aclTxCmd
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -62,8 +77,22 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	aclQueryCmd.AddCommand(client.GetCommands(
 		cli.GetACLAccountCmd(cdc),
+		cli.GetACLChangeLogCmd(cdc),
 		cli.GetOrganizationCmd(cdc),
 		cli.GetZoneCmd(cdc),
+		cli.GetRolesCmd(cdc),
+		cli.GetZonesCmd(cdc),
+		cli.GetOrganizationsCmd(cdc),
+		cli.GetACLAccountsCmd(cdc),
+		cli.GetSubOrganizationsCmd(cdc),
+		cli.GetKYCCmd(cdc),
+		cli.GetExpiringKYCsCmd(cdc),
+		cli.GetTradingHeadroomCmd(cdc),
+		cli.GetZoneDenylistCmd(cdc),
+		cli.GetBlockedCounterpartiesCmd(cdc),
+		cli.GetFreezesCmd(cdc),
+		cli.GetClawbacksCmd(cdc),
+		cli.GetAddressRotationsCmd(cdc),
 	)...)
 
 	return aclQueryCmd
@@ -88,7 +117,7 @@ func (AppModule) Name() string {
 }
 
 func (am AppModule) RegisterInvariants(ir cTypes.InvariantRegistry) {
-
+	RegisterInvariants(ir, am.keeper)
 }
 
 func (AppModule) Route() string { return RouterKey }
@@ -102,7 +131,9 @@ func (am AppModule) NewQuerierHandler() cTypes.Querier { return NewQuerier(am.ke
 func (am AppModule) InitGenesis(ctx cTypes.Context, data json.RawMessage) []abciTypes.ValidatorUpdate {
 	var genesisState GenesisState
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
-	_ = InitGenesis(ctx, am.keeper, genesisState)
+	if err := InitGenesis(ctx, am.keeper, genesisState); err != nil {
+		panic(err)
+	}
 
 	return []abciTypes.ValidatorUpdate{}
 }
@@ -0,0 +1,33 @@
+package acl
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl/internal/types"
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+)
+
+// NewZoneProposalHandler : handles the zone lifecycle proposals passed by governance
+func NewZoneProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx cTypes.Context, content govTypes.Content) cTypes.Error {
+		switch c := content.(type) {
+		case types.CreateZoneProposal:
+			return k.DefineZoneAddress(ctx, c.Address, c.ZoneID)
+
+		case types.RotateZoneAddressProposal:
+			return k.RotateZoneAddress(ctx, c.ZoneID, c.NewAddress)
+
+		case types.SuspendZoneProposal:
+			return k.SetZoneSuspended(ctx, c.ZoneID, c.Suspended)
+
+		case types.RemoveZoneProposal:
+			return k.RemoveZone(ctx, c.ZoneID)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized acl proposal content type: %T", c)
+			return cTypes.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package approvals
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/approvals/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	ActionIssueAsset        = types.ActionIssueAsset
+	ActionIssueFiat         = types.ActionIssueFiat
+	ActionRedeemAsset       = types.ActionRedeemAsset
+	ActionRedeemFiat        = types.ActionRedeemFiat
+	ActionSetApprovalPolicy = types.ActionSetApprovalPolicy
+
+	QueryApprovalPolicy   = keeper.QueryApprovalPolicy
+	QueryApprovalPolicies = keeper.QueryApprovalPolicies
+	QueryPendingAction    = keeper.QueryPendingAction
+	QueryPendingActions   = keeper.QueryPendingActions
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewApprovalPolicy    = types.NewApprovalPolicy
+	GetApprovalPolicyKey = types.GetApprovalPolicyKey
+	GetPendingActionKey  = types.GetPendingActionKey
+
+	ErrInvalidApprovalPolicy = types.ErrInvalidApprovalPolicy
+	ErrInvalidPendingAction  = types.ErrInvalidPendingAction
+	ErrPendingActionExpired  = types.ErrPendingActionExpired
+	ErrAlreadyApproved       = types.ErrAlreadyApproved
+	ErrUnauthorized          = types.ErrUnauthorized
+
+	BuildMsgSetApprovalPolicy    = types.BuildMsgSetApprovalPolicy
+	BuildMsgApprovePendingAction = types.BuildMsgApprovePendingAction
+
+	EventTypeSetApprovalPolicy    = types.EventTypeSetApprovalPolicy
+	EventTypeAddPendingAction     = types.EventTypeAddPendingAction
+	EventTypeApprovePendingAction = types.EventTypeApprovePendingAction
+	EventTypeExecutePendingAction = types.EventTypeExecutePendingAction
+	EventTypeExpirePendingAction  = types.EventTypeExpirePendingAction
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+	BankKeeper   = types.BankKeeper
+
+	ApprovalPolicy = types.ApprovalPolicy
+	PendingAction  = types.PendingAction
+
+	MsgSetApprovalPolicies   = types.MsgSetApprovalPolicies
+	MsgApprovePendingActions = types.MsgApprovePendingActions
+
+	SetApprovalPolicy    = types.SetApprovalPolicy
+	ApprovePendingAction = types.ApprovePendingAction
+)
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+func ApprovePendingActionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a pending issuance, redemption or policy change of the zone",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := approvalTypes.BuildMsgApprovePendingAction(cliCtx.GetFromAddress(), uint64(viper.GetInt64(FlagPendingID)))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPendingID)
+	return cmd
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagZoneID       = "zone-id"
+	FlagApprovers    = "approvers"
+	FlagThreshold    = "threshold"
+	FlagExpiryBlocks = "expiry-blocks"
+	FlagPendingID    = "pending-id"
+)
+
+var (
+	fsZoneID       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsApprovers    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsThreshold    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsExpiryBlocks = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPendingID    = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsZoneID.String(FlagZoneID, "", "ZoneID of the approval policy")
+	fsApprovers.String(FlagApprovers, "", "Comma separated addresses of the approvers")
+	fsThreshold.Int64(FlagThreshold, 0, "Number of approvers that must approve a pending action")
+	fsExpiryBlocks.Int64(FlagExpiryBlocks, 0, "Number of blocks a pending action waits for approvals")
+	fsPendingID.Int64(FlagPendingID, 0, "PendingID of the pending action")
+}
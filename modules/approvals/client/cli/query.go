@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+func GetApprovalPolicyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy [zoneID]",
+		Short: "Query approval policy of the zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", approvalTypes.QuerierRoute, "queryApprovalPolicy", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var approvalPolicy approvalTypes.ApprovalPolicy
+			cdc.MustUnmarshalJSON(res, &approvalPolicy)
+			return cliCtx.PrintOutput(approvalPolicy)
+		},
+	}
+
+	return cmd
+}
+
+func GetApprovalPoliciesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policies",
+		Short: "Query approval policies of all zones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", approvalTypes.QuerierRoute, "queryApprovalPolicies"), nil)
+			if err != nil {
+				return err
+			}
+
+			var approvalPolicies []approvalTypes.ApprovalPolicy
+			cdc.MustUnmarshalJSON(res, &approvalPolicies)
+
+			output, err := cdc.MarshalJSONIndent(approvalPolicies, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetPendingActionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-action [pendingID]",
+		Short: "Query pending action details and approvals",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", approvalTypes.QuerierRoute, "queryPendingAction", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var pendingAction approvalTypes.PendingAction
+			cdc.MustUnmarshalJSON(res, &pendingAction)
+			return cliCtx.PrintOutput(pendingAction)
+		},
+	}
+
+	return cmd
+}
+
+func GetPendingActionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-actions [zoneID]",
+		Short: "Query pending actions, optionally only those of the zone",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", approvalTypes.QuerierRoute, "queryPendingActions")
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pendingActions []approvalTypes.PendingAction
+			cdc.MustUnmarshalJSON(res, &pendingActions)
+
+			output, err := cdc.MarshalJSONIndent(pendingActions, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
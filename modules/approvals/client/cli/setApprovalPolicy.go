@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+func SetApprovalPolicyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-policy",
+		Short: "Set the approvers of the issuances and redemptions of the zone",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			var approvers []cTypes.AccAddress
+			for _, approverStr := range strings.Split(viper.GetString(FlagApprovers), ",") {
+				approver, err := cTypes.AccAddressFromBech32(strings.TrimSpace(approverStr))
+				if err != nil {
+					return err
+				}
+				approvers = append(approvers, approver)
+			}
+
+			msg := approvalTypes.BuildMsgSetApprovalPolicy(cliCtx.GetFromAddress(), zoneID, approvers,
+				viper.GetInt64(FlagThreshold), viper.GetInt64(FlagExpiryBlocks))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsApprovers)
+	cmd.Flags().AddFlagSet(fsThreshold)
+	cmd.Flags().AddFlagSet(fsExpiryBlocks)
+	return cmd
+}
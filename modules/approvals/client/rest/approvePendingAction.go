@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+type approvePendingActionReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	PendingID string       `json:"pendingID" valid:"required~Enter the PendingID,matches(^[1-9]{1}[0-9]*$)~Invalid PendingID"`
+	Password  string       `json:"password" valid:"required~Enter the Password"`
+	Mode      string       `json:"mode"`
+}
+
+func ApprovePendingActionRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req approvePendingActionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(approvalTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pendingID, err := strconv.ParseUint(req.PendingID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		msg := approvalTypes.BuildMsgApprovePendingAction(fromAddr, pendingID)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("APPA")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
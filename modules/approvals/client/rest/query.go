@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+func QueryApprovalPolicyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", approvalTypes.QuerierRoute, "queryApprovalPolicy", vars["zoneID"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query ApprovalPolicy. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var approvalPolicy approvalTypes.ApprovalPolicy
+		cliCtx.Codec.MustUnmarshalJSON(res, &approvalPolicy)
+
+		rest.PostProcessResponse(w, cliCtx, approvalPolicy)
+	}
+}
+
+func QueryApprovalPoliciesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", approvalTypes.QuerierRoute, "queryApprovalPolicies"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query ApprovalPolicies. Error: %s", err.Error()))
+			return
+		}
+
+		var approvalPolicies []approvalTypes.ApprovalPolicy
+		cliCtx.Codec.MustUnmarshalJSON(res, &approvalPolicies)
+
+		rest.PostProcessResponse(w, cliCtx, approvalPolicies)
+	}
+}
+
+func QueryPendingActionRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", approvalTypes.QuerierRoute, "queryPendingAction", vars["pendingID"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query PendingAction. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var pendingAction approvalTypes.PendingAction
+		cliCtx.Codec.MustUnmarshalJSON(res, &pendingAction)
+
+		rest.PostProcessResponse(w, cliCtx, pendingAction)
+	}
+}
+
+// QueryPendingActionsRequestHandlerFn : pending actions, filtered by the zoneID query parameter when given
+func QueryPendingActionsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		route := fmt.Sprintf("custom/%s/%s", approvalTypes.QuerierRoute, "queryPendingActions")
+		if zoneID := r.URL.Query().Get("zoneID"); zoneID != "" {
+			route = fmt.Sprintf("%s/%s", route, zoneID)
+		}
+
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query PendingActions. Error: %s", err.Error()))
+			return
+		}
+
+		var pendingActions []approvalTypes.PendingAction
+		cliCtx.Codec.MustUnmarshalJSON(res, &pendingActions)
+
+		rest.PostProcessResponse(w, cliCtx, pendingActions)
+	}
+}
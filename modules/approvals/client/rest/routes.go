@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/approvalPolicy/{zoneID}", QueryApprovalPolicyRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/approvalPolicies", QueryApprovalPoliciesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/pendingAction/{pendingID}", QueryPendingActionRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/pendingActions", QueryPendingActionsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/setApprovalPolicy", SetApprovalPolicyRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/approvePendingAction", ApprovePendingActionRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+type setApprovalPolicyReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	ZoneID       string       `json:"zoneID" valid:"required~Enter the ZoneID,hexadecimal~Invalid ZoneID"`
+	Approvers    []string     `json:"approvers"`
+	Threshold    int64        `json:"threshold" valid:"required~Enter the Threshold,matches(^[1-9]{1}[0-9]*$)~Enter valid Threshold"`
+	ExpiryBlocks int64        `json:"expiryBlocks" valid:"required~Enter the ExpiryBlocks,matches(^[1-9]{1}[0-9]*$)~Enter valid ExpiryBlocks"`
+	Password     string       `json:"password" valid:"required~Enter the Password"`
+	Mode         string       `json:"mode"`
+}
+
+func SetApprovalPolicyRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req setApprovalPolicyReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(approvalTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		zoneID, err := acl.GetZoneIDFromString(req.ZoneID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var approvers []cTypes.AccAddress
+		for _, approverStr := range req.Approvers {
+			approver, err := cTypes.AccAddressFromBech32(approverStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			approvers = append(approvers, approver)
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryZone", zoneID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query zone. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("Unauthorized transaction. Zone is not defined"))
+			return
+		}
+
+		msg := approvalTypes.BuildMsgSetApprovalPolicy(fromAddr, zoneID, approvers, req.Threshold, req.ExpiryBlocks)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("STAP")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package approvals
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker : remove pending actions that were not approved in time
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.ExpirePendingActions(ctx)
+}
@@ -0,0 +1,27 @@
+package approvals
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, approvalPolicy := range data.ApprovalPolicies {
+		keeper.SetApprovalPolicy(ctx, approvalPolicy)
+	}
+
+	nextPendingID := uint64(1)
+	for _, pendingAction := range data.PendingActions {
+		keeper.SetPendingAction(ctx, pendingAction)
+		if pendingAction.PendingID >= nextPendingID {
+			nextPendingID = pendingAction.PendingID + 1
+		}
+	}
+	keeper.SetNextPendingID(ctx, nextPendingID)
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	approvalPolicies := keeper.GetApprovalPolicies(ctx)
+	pendingActions := keeper.GetPendingActions(ctx, nil)
+
+	return GenesisState{ApprovalPolicies: approvalPolicies, PendingActions: pendingActions}
+}
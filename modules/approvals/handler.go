@@ -0,0 +1,48 @@
+package approvals
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgSetApprovalPolicies:
+			return handleMsgSetApprovalPolicies(ctx, k, msg)
+		case MsgApprovePendingActions:
+			return handleMsgApprovePendingActions(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSetApprovalPolicies(ctx cTypes.Context, k Keeper, msg MsgSetApprovalPolicies) cTypes.Result {
+	for _, setApprovalPolicy := range msg.SetApprovalPolicies {
+		if err := k.SetZoneApprovalPolicy(ctx, setApprovalPolicy); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgApprovePendingActions(ctx cTypes.Context, k Keeper, msg MsgApprovePendingActions) cTypes.Result {
+	for _, approvePendingAction := range msg.ApprovePendingActions {
+		if err := k.ApprovePendingAction(ctx, approvePendingAction); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
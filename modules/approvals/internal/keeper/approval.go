@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+// RequireApproval : issuances and redemptions of the zone wait for approvals when it has an approval policy
+func (k Keeper) RequireApproval(ctx cTypes.Context, zoneID acl.ZoneID) bool {
+	_, err := k.GetApprovalPolicy(ctx, zoneID)
+	return err == nil
+}
+
+// AddPendingAction : records an action of the zone to be executed once approved, expiring after ExpiryBlocks of the policy
+func (k Keeper) AddPendingAction(ctx cTypes.Context, pendingAction approvalTypes.PendingAction) (approvalTypes.PendingAction, cTypes.Error) {
+	approvalPolicy, err := k.GetApprovalPolicy(ctx, pendingAction.ZoneID)
+	if err != nil {
+		return pendingAction, err
+	}
+
+	pendingAction.PendingID = k.GetNextPendingID(ctx)
+	k.SetNextPendingID(ctx, pendingAction.PendingID+1)
+	pendingAction.Approvals = nil
+	pendingAction.CreatedHeight = ctx.BlockHeight()
+	pendingAction.ExpiryHeight = ctx.BlockHeight() + approvalPolicy.ExpiryBlocks
+	k.SetPendingAction(ctx, pendingAction)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(approvalTypes.EventTypeAddPendingAction,
+			cTypes.NewAttribute(approvalTypes.AttributeKeyPendingID, strconv.FormatUint(pendingAction.PendingID, 10)),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyZoneID, pendingAction.ZoneID.String()),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyAction, pendingAction.Action),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyMaker, pendingAction.MakerAddress.String()),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyExpiryHeight, strconv.FormatInt(pendingAction.ExpiryHeight, 10)),
+		))
+
+	return pendingAction, nil
+}
+
+// SetZoneApprovalPolicy : zone sets its first approval policy directly, later changes wait for the approvals of the current one
+func (k Keeper) SetZoneApprovalPolicy(ctx cTypes.Context, setApprovalPolicy approvalTypes.SetApprovalPolicy) cTypes.Error {
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, setApprovalPolicy.ZoneID, setApprovalPolicy.FromAddress) {
+		return approvalTypes.ErrUnauthorized(approvalTypes.DefaultCodeSpace)
+	}
+
+	approvalPolicy := setApprovalPolicy.GetApprovalPolicy()
+	if !k.RequireApproval(ctx, approvalPolicy.ZoneID) {
+		k.setApprovalPolicy(ctx, approvalPolicy)
+		return nil
+	}
+
+	_, err := k.AddPendingAction(ctx, approvalTypes.PendingAction{
+		ZoneID:         approvalPolicy.ZoneID,
+		Action:         approvalTypes.ActionSetApprovalPolicy,
+		MakerAddress:   setApprovalPolicy.FromAddress,
+		ApprovalPolicy: approvalPolicy,
+	})
+	return err
+}
+
+// ApprovePendingAction : approver of the zone policy approves a pending action other than its own, executing it at the threshold
+func (k Keeper) ApprovePendingAction(ctx cTypes.Context, approvePendingAction approvalTypes.ApprovePendingAction) cTypes.Error {
+	pendingAction, err := k.GetPendingAction(ctx, approvePendingAction.PendingID)
+	if err != nil {
+		return err
+	}
+	if pendingAction.IsExpired(ctx.BlockHeight()) {
+		return approvalTypes.ErrPendingActionExpired(approvalTypes.DefaultCodeSpace)
+	}
+
+	approvalPolicy, err := k.GetApprovalPolicy(ctx, pendingAction.ZoneID)
+	if err != nil {
+		return err
+	}
+	if !approvalPolicy.IsApprover(approvePendingAction.FromAddress) || pendingAction.MakerAddress.Equals(approvePendingAction.FromAddress) {
+		return approvalTypes.ErrUnauthorized(approvalTypes.DefaultCodeSpace)
+	}
+	if pendingAction.HasApproved(approvePendingAction.FromAddress) {
+		return approvalTypes.ErrAlreadyApproved(approvalTypes.DefaultCodeSpace)
+	}
+
+	pendingAction.Approvals = append(pendingAction.Approvals, approvePendingAction.FromAddress)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(approvalTypes.EventTypeApprovePendingAction,
+			cTypes.NewAttribute(approvalTypes.AttributeKeyPendingID, strconv.FormatUint(pendingAction.PendingID, 10)),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyApprover, approvePendingAction.FromAddress.String()),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyApprovals, strconv.Itoa(len(pendingAction.Approvals))),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyThreshold, strconv.FormatInt(approvalPolicy.Threshold, 10)),
+		))
+
+	if int64(len(pendingAction.Approvals)) < approvalPolicy.Threshold {
+		k.SetPendingAction(ctx, pendingAction)
+		return nil
+	}
+
+	k.DeletePendingAction(ctx, pendingAction.PendingID)
+	if pendingAction.Action == approvalTypes.ActionSetApprovalPolicy {
+		k.setApprovalPolicy(ctx, pendingAction.ApprovalPolicy)
+	} else if err := k.bankKeeper.ExecutePendingAction(ctx, pendingAction); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(approvalTypes.EventTypeExecutePendingAction,
+			cTypes.NewAttribute(approvalTypes.AttributeKeyPendingID, strconv.FormatUint(pendingAction.PendingID, 10)),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyAction, pendingAction.Action),
+		))
+
+	return nil
+}
+
+// ExpirePendingActions : removes pending actions not approved before their expiry height
+func (k Keeper) ExpirePendingActions(ctx cTypes.Context) {
+	var expiredPendingActions []approvalTypes.PendingAction
+	k.IterateExpiredPendingActions(ctx, ctx.BlockHeight(), func(pendingAction approvalTypes.PendingAction) (stop bool) {
+		expiredPendingActions = append(expiredPendingActions, pendingAction)
+		return false
+	},
+	)
+
+	for _, pendingAction := range expiredPendingActions {
+		k.DeletePendingAction(ctx, pendingAction.PendingID)
+
+		ctx.EventManager().EmitEvent(
+			cTypes.NewEvent(approvalTypes.EventTypeExpirePendingAction,
+				cTypes.NewAttribute(approvalTypes.AttributeKeyPendingID, strconv.FormatUint(pendingAction.PendingID, 10)),
+				cTypes.NewAttribute(approvalTypes.AttributeKeyZoneID, pendingAction.ZoneID.String()),
+				cTypes.NewAttribute(approvalTypes.AttributeKeyAction, pendingAction.Action),
+			))
+	}
+}
+
+func (k Keeper) setApprovalPolicy(ctx cTypes.Context, approvalPolicy approvalTypes.ApprovalPolicy) {
+	k.SetApprovalPolicy(ctx, approvalPolicy)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(approvalTypes.EventTypeSetApprovalPolicy,
+			cTypes.NewAttribute(approvalTypes.AttributeKeyZoneID, approvalPolicy.ZoneID.String()),
+			cTypes.NewAttribute(approvalTypes.AttributeKeyThreshold, strconv.FormatInt(approvalPolicy.Threshold, 10)),
+		))
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+type testACLKeeper struct {
+	zone cTypes.AccAddress
+}
+
+func (ak testACLKeeper) CheckValidZoneAddress(_ cTypes.Context, _ acl.ZoneID, address cTypes.AccAddress) bool {
+	return address.Equals(ak.zone)
+}
+
+type testBankKeeper struct {
+	executed *[]uint64
+}
+
+func (bk testBankKeeper) ExecutePendingAction(_ cTypes.Context, pendingAction approvalTypes.PendingAction) cTypes.Error {
+	*bk.executed = append(*bk.executed, pendingAction.PendingID)
+	return nil
+}
+
+type testInput struct {
+	ctx       cTypes.Context
+	k         Keeper
+	executed  *[]uint64
+	zone      cTypes.AccAddress
+	zoneID    acl.ZoneID
+	approvers []cTypes.AccAddress
+}
+
+// setupTestInput : a zone with three approvers, two of them approving pending actions that expire after 10 blocks
+func setupTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(approvalTypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	approvalTypes.RegisterCodec(cdc)
+
+	zone := cTypes.AccAddress([]byte("zone"))
+	var executed []uint64
+	k := NewKeeper(key, cdc, testACLKeeper{zone})
+	k.SetBankKeeper(testBankKeeper{&executed})
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+
+	zoneID := acl.ZoneID([]byte("zoneID"))
+	approvers := []cTypes.AccAddress{cTypes.AccAddress([]byte("approver1")), cTypes.AccAddress([]byte("approver2")),
+		cTypes.AccAddress([]byte("approver3"))}
+	require.NoError(t, k.SetZoneApprovalPolicy(ctx, approvalTypes.NewSetApprovalPolicy(zone, zoneID, approvers, 2, 10)))
+
+	return testInput{ctx, k, &executed, zone, zoneID, approvers}
+}
+
+func (input testInput) addPendingAction(t *testing.T, ctx cTypes.Context) approvalTypes.PendingAction {
+	pendingAction, err := input.k.AddPendingAction(ctx, approvalTypes.PendingAction{
+		ZoneID:       input.zoneID,
+		Action:       approvalTypes.ActionIssueFiat,
+		MakerAddress: input.approvers[0],
+	})
+	require.NoError(t, err)
+	return pendingAction
+}
+
+func TestPendingActionExecutesAtTheThreshold(t *testing.T) {
+	input := setupTestInput(t)
+	pendingAction := input.addPendingAction(t, input.ctx)
+
+	// the maker and addresses outside the policy can't approve
+	require.Error(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(input.approvers[0], pendingAction.PendingID)))
+	require.Error(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(input.zone, pendingAction.PendingID)))
+
+	require.NoError(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(input.approvers[1], pendingAction.PendingID)))
+	require.Error(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(input.approvers[1], pendingAction.PendingID)))
+	require.Empty(t, *input.executed)
+
+	require.NoError(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(input.approvers[2], pendingAction.PendingID)))
+	require.Equal(t, []uint64{pendingAction.PendingID}, *input.executed)
+	_, err := input.k.GetPendingAction(input.ctx, pendingAction.PendingID)
+	require.Error(t, err)
+}
+
+func TestApprovalPolicyChangeWaitsForTheCurrentPolicy(t *testing.T) {
+	input := setupTestInput(t)
+
+	require.NoError(t, input.k.SetZoneApprovalPolicy(input.ctx, approvalTypes.NewSetApprovalPolicy(input.zone, input.zoneID,
+		input.approvers[:1], 1, 5)))
+	approvalPolicy, _ := input.k.GetApprovalPolicy(input.ctx, input.zoneID)
+	require.Equal(t, int64(2), approvalPolicy.Threshold)
+
+	pendingActions := input.k.GetPendingActions(input.ctx, input.zoneID)
+	require.Len(t, pendingActions, 1)
+	for _, approver := range input.approvers[1:] {
+		require.NoError(t, input.k.ApprovePendingAction(input.ctx, approvalTypes.NewApprovePendingAction(approver, pendingActions[0].PendingID)))
+	}
+
+	approvalPolicy, _ = input.k.GetApprovalPolicy(input.ctx, input.zoneID)
+	require.Equal(t, int64(1), approvalPolicy.Threshold)
+	require.Empty(t, *input.executed)
+}
+
+func TestPendingActionsExpireByHeight(t *testing.T) {
+	input := setupTestInput(t)
+	first := input.addPendingAction(t, input.ctx)
+	second := input.addPendingAction(t, input.ctx.WithBlockHeight(5))
+	require.Equal(t, int64(11), first.ExpiryHeight)
+	require.Equal(t, int64(15), second.ExpiryHeight)
+
+	// a pending action can still be approved at its expiry height
+	ctx := input.ctx.WithBlockHeight(11)
+	input.k.ExpirePendingActions(ctx)
+	require.Len(t, input.k.GetPendingActions(ctx, nil), 2)
+	require.NoError(t, input.k.ApprovePendingAction(ctx, approvalTypes.NewApprovePendingAction(input.approvers[1], first.PendingID)))
+
+	ctx = input.ctx.WithBlockHeight(12)
+	require.Error(t, input.k.ApprovePendingAction(ctx, approvalTypes.NewApprovePendingAction(input.approvers[2], first.PendingID)))
+	input.k.ExpirePendingActions(ctx)
+	pendingActions := input.k.GetPendingActions(ctx, nil)
+	require.Len(t, pendingActions, 1)
+	require.Equal(t, second.PendingID, pendingActions[0].PendingID)
+
+	input.k.ExpirePendingActions(input.ctx.WithBlockHeight(16))
+	require.Empty(t, input.k.GetPendingActions(ctx, nil))
+	require.Empty(t, *input.executed)
+
+	var expired []approvalTypes.PendingAction
+	input.k.IterateExpiredPendingActions(ctx, 100, func(pendingAction approvalTypes.PendingAction) bool {
+		expired = append(expired, pendingAction)
+		return false
+	})
+	require.Empty(t, expired)
+}
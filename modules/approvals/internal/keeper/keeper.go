@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+)
+
+type Keeper struct {
+	storeKey   cTypes.StoreKey
+	cdc        *codec.Codec
+	aclKeeper  approvalTypes.ACLKeeper
+	bankKeeper approvalTypes.BankKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, aclKeeper approvalTypes.ACLKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		aclKeeper: aclKeeper,
+	}
+}
+
+// SetBankKeeper : the bank keeper depends on the approvals keeper, so it is set once both are created
+func (k *Keeper) SetBankKeeper(bankKeeper approvalTypes.BankKeeper) *Keeper {
+	if k.bankKeeper != nil {
+		panic("cannot set approvals bank keeper twice")
+	}
+	k.bankKeeper = bankKeeper
+	return k
+}
+
+// approvals/{0x01}/{zoneID} => approvalPolicy
+func (k Keeper) SetApprovalPolicy(ctx cTypes.Context, approvalPolicy approvalTypes.ApprovalPolicy) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(approvalPolicy)
+	store.Set(approvalTypes.GetApprovalPolicyKey(approvalPolicy.ZoneID), bz)
+}
+
+// returns approval policy of the zone
+func (k Keeper) GetApprovalPolicy(ctx cTypes.Context, zoneID acl.ZoneID) (approvalPolicy approvalTypes.ApprovalPolicy, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(approvalTypes.GetApprovalPolicyKey(zoneID))
+	if bz == nil {
+		return approvalPolicy, approvalTypes.ErrInvalidApprovalPolicy(approvalTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &approvalPolicy)
+	return approvalPolicy, nil
+}
+
+// get all approval policies => []ApprovalPolicy from store
+func (k Keeper) GetApprovalPolicies(ctx cTypes.Context) (approvalPolicies []approvalTypes.ApprovalPolicy) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, approvalTypes.ApprovalPolicyKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approvalPolicy approvalTypes.ApprovalPolicy
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approvalPolicy)
+		approvalPolicies = append(approvalPolicies, approvalPolicy)
+	}
+	return
+}
+
+// approvals/{0x02}/{pendingID} => pendingAction, indexed by expiry height
+func (k Keeper) SetPendingAction(ctx cTypes.Context, pendingAction approvalTypes.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+
+	if oldPendingAction, err := k.GetPendingAction(ctx, pendingAction.PendingID); err == nil {
+		store.Delete(approvalTypes.GetPendingExpiryKey(oldPendingAction.ExpiryHeight, oldPendingAction.PendingID))
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pendingAction)
+	store.Set(approvalTypes.GetPendingActionKey(pendingAction.PendingID), bz)
+	store.Set(approvalTypes.GetPendingExpiryKey(pendingAction.ExpiryHeight, pendingAction.PendingID), []byte{})
+}
+
+// returns pending action by pendingID
+func (k Keeper) GetPendingAction(ctx cTypes.Context, pendingID uint64) (pendingAction approvalTypes.PendingAction, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(approvalTypes.GetPendingActionKey(pendingID))
+	if bz == nil {
+		return pendingAction, approvalTypes.ErrInvalidPendingAction(approvalTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pendingAction)
+	return pendingAction, nil
+}
+
+func (k Keeper) DeletePendingAction(ctx cTypes.Context, pendingID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if pendingAction, err := k.GetPendingAction(ctx, pendingID); err == nil {
+		store.Delete(approvalTypes.GetPendingExpiryKey(pendingAction.ExpiryHeight, pendingID))
+	}
+	store.Delete(approvalTypes.GetPendingActionKey(pendingID))
+}
+
+// get pending actions of the zone, or of all zones if zoneID is empty => []PendingAction from store
+func (k Keeper) GetPendingActions(ctx cTypes.Context, zoneID acl.ZoneID) (pendingActions []approvalTypes.PendingAction) {
+	k.IteratePendingActions(ctx, func(pendingAction approvalTypes.PendingAction) (stop bool) {
+		if len(zoneID) == 0 || pendingAction.ZoneID.String() == zoneID.String() {
+			pendingActions = append(pendingActions, pendingAction)
+		}
+		return false
+	},
+	)
+	return
+}
+
+func (k Keeper) IteratePendingActions(ctx cTypes.Context, handler func(pendingAction approvalTypes.PendingAction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, approvalTypes.PendingActionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAction approvalTypes.PendingAction
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pendingAction)
+		if handler(pendingAction) {
+			break
+		}
+	}
+}
+
+// IterateExpiredPendingActions : pending actions with an expiry height below the height, soonest first
+func (k Keeper) IterateExpiredPendingActions(ctx cTypes.Context, height int64,
+	handler func(pendingAction approvalTypes.PendingAction) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(approvalTypes.PendingExpiryKey, approvalTypes.GetPendingExpiryHeightKey(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingID := approvalTypes.GetPendingIDFromBytes(iterator.Key()[len(approvalTypes.PendingExpiryKey)+8:])
+		pendingAction, err := k.GetPendingAction(ctx, pendingID)
+		if err != nil {
+			continue
+		}
+		if handler(pendingAction) {
+			break
+		}
+	}
+}
+
+// approvals/{0x03} => next pendingID
+func (k Keeper) SetNextPendingID(ctx cTypes.Context, pendingID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(approvalTypes.NextPendingIDKey, approvalTypes.GetPendingIDBytes(pendingID))
+}
+
+func (k Keeper) GetNextPendingID(ctx cTypes.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(approvalTypes.NextPendingIDKey)
+	if bz == nil {
+		return 1
+	}
+	return approvalTypes.GetPendingIDFromBytes(bz)
+}
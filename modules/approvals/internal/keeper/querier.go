@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	approvalTypes "github.com/commitHub/commitBlockchain/modules/approvals/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryApprovalPolicy   = "queryApprovalPolicy"
+	QueryApprovalPolicies = "queryApprovalPolicies"
+	QueryPendingAction    = "queryPendingAction"
+	QueryPendingActions   = "queryPendingActions"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryApprovalPolicy:
+			return queryApprovalPolicy(ctx, path[1:], k)
+		case QueryApprovalPolicies:
+			return queryApprovalPolicies(ctx, k)
+		case QueryPendingAction:
+			return queryPendingAction(ctx, path[1:], k)
+		case QueryPendingActions:
+			return queryPendingActions(ctx, path[1:], k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown approvals query endpoint")
+		}
+	}
+}
+
+func queryApprovalPolicy(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	zoneID, errRes := acl.GetZoneIDFromString(path[0])
+	if errRes != nil {
+		return nil, approvalTypes.ErrInvalidApprovalPolicy(approvalTypes.DefaultCodeSpace, fmt.Sprintf("invalid zoneID %s", path[0]))
+	}
+
+	approvalPolicy, err := k.GetApprovalPolicy(ctx, zoneID)
+	if err != nil {
+		return nil, approvalTypes.ErrInvalidApprovalPolicy(approvalTypes.DefaultCodeSpace, fmt.Sprintf("approval policy of zone %s "+
+			" not found", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, approvalPolicy)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryApprovalPolicies(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	approvalPolicies := k.GetApprovalPolicies(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, approvalPolicies)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPendingAction(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	pendingID, errRes := strconv.ParseUint(path[0], 10, 64)
+	if errRes != nil {
+		return nil, approvalTypes.ErrInvalidPendingAction(approvalTypes.DefaultCodeSpace, fmt.Sprintf("invalid pendingID %s", path[0]))
+	}
+
+	pendingAction, err := k.GetPendingAction(ctx, pendingID)
+	if err != nil {
+		return nil, approvalTypes.ErrInvalidPendingAction(approvalTypes.DefaultCodeSpace, fmt.Sprintf("pending action %s "+
+			" not found", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, pendingAction)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+// queryPendingActions : pending actions of the zone in path, of all zones without one
+func queryPendingActions(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	var zoneID acl.ZoneID
+	if len(path) > 0 && path[0] != "" {
+		var errRes error
+		zoneID, errRes = acl.GetZoneIDFromString(path[0])
+		if errRes != nil {
+			return nil, approvalTypes.ErrInvalidPendingAction(approvalTypes.DefaultCodeSpace, fmt.Sprintf("invalid zoneID %s", path[0]))
+		}
+	}
+
+	pendingActions := k.GetPendingActions(ctx, zoneID)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, pendingActions)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
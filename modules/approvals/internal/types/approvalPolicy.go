@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// ApprovalPolicy : Threshold of the Approvers of a zone approve its issuances and redemptions, which expire after ExpiryBlocks
+type ApprovalPolicy struct {
+	ZoneID       acl.ZoneID          `json:"zoneID"`
+	Approvers    []cTypes.AccAddress `json:"approvers"`
+	Threshold    int64               `json:"threshold"`
+	ExpiryBlocks int64               `json:"expiryBlocks"`
+}
+
+func NewApprovalPolicy(zoneID acl.ZoneID, approvers []cTypes.AccAddress, threshold int64, expiryBlocks int64) ApprovalPolicy {
+	return ApprovalPolicy{
+		ZoneID:       zoneID,
+		Approvers:    approvers,
+		Threshold:    threshold,
+		ExpiryBlocks: expiryBlocks,
+	}
+}
+
+func (approvalPolicy ApprovalPolicy) String() string {
+	return fmt.Sprintf(`ApprovalPolicy:
+ZoneID: %s,
+Approvers: %v,
+Threshold: %d,
+ExpiryBlocks: %d,
+`, approvalPolicy.ZoneID.String(), approvalPolicy.Approvers, approvalPolicy.Threshold, approvalPolicy.ExpiryBlocks)
+}
+
+func (approvalPolicy ApprovalPolicy) ValidateBasic() cTypes.Error {
+	if len(approvalPolicy.ZoneID) == 0 {
+		return ErrInvalidApprovalPolicy(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if len(approvalPolicy.Approvers) == 0 {
+		return ErrInvalidApprovalPolicy(DefaultCodeSpace, "Approvers should not be empty.")
+	} else if approvalPolicy.Threshold <= 0 || approvalPolicy.Threshold > int64(len(approvalPolicy.Approvers)) {
+		return ErrInvalidApprovalPolicy(DefaultCodeSpace, "Threshold should be between one and the number of approvers.")
+	} else if approvalPolicy.ExpiryBlocks <= 0 {
+		return ErrInvalidApprovalPolicy(DefaultCodeSpace, "ExpiryBlocks should be positive.")
+	}
+
+	approvers := make(map[string]bool)
+	for _, approver := range approvalPolicy.Approvers {
+		if len(approver) == 0 {
+			return ErrInvalidApprovalPolicy(DefaultCodeSpace, "Approver address should not be empty.")
+		}
+		if approvers[approver.String()] {
+			return ErrInvalidApprovalPolicy(DefaultCodeSpace, fmt.Sprintf("Duplicate approver %s.", approver.String()))
+		}
+		approvers[approver.String()] = true
+	}
+	return nil
+}
+
+func (approvalPolicy ApprovalPolicy) IsApprover(address cTypes.AccAddress) bool {
+	for _, approver := range approvalPolicy.Approvers {
+		if approver.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSetApprovalPolicies{}, "commit-blockchain/MsgSetApprovalPolicies", nil)
+	cdc.RegisterConcrete(MsgApprovePendingActions{}, "commit-blockchain/MsgApprovePendingActions", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
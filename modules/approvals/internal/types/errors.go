@@ -0,0 +1,47 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidApprovalPolicy cTypes.CodeType = 1201
+	CodeInvalidPendingAction  cTypes.CodeType = 1202
+	CodePendingActionExpired  cTypes.CodeType = 1203
+	CodeAlreadyApproved       cTypes.CodeType = 1204
+	CodeUnauthorized          cTypes.CodeType = 1205
+	CodeInvalidInputsOutputs  cTypes.CodeType = 1206
+)
+
+func ErrInvalidApprovalPolicy(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidApprovalPolicy, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidApprovalPolicy, "approval policy doesn't exist")
+}
+
+func ErrInvalidPendingAction(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidPendingAction, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidPendingAction, "pending action doesn't exist")
+}
+
+func ErrPendingActionExpired(codespace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codespace, CodePendingActionExpired, "pending action has expired")
+}
+
+func ErrAlreadyApproved(codespace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codespace, CodeAlreadyApproved, "pending action is already approved by the account")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
@@ -0,0 +1,18 @@
+package types
+
+var (
+	EventTypeSetApprovalPolicy    = "setApprovalPolicy"
+	EventTypeAddPendingAction     = "addPendingAction"
+	EventTypeApprovePendingAction = "approvePendingAction"
+	EventTypeExecutePendingAction = "executePendingAction"
+	EventTypeExpirePendingAction  = "expirePendingAction"
+
+	AttributeKeyZoneID       = "zoneID"
+	AttributeKeyPendingID    = "pendingID"
+	AttributeKeyAction       = "action"
+	AttributeKeyMaker        = "maker"
+	AttributeKeyApprover     = "approver"
+	AttributeKeyApprovals    = "approvals"
+	AttributeKeyThreshold    = "threshold"
+	AttributeKeyExpiryHeight = "expiryHeight"
+)
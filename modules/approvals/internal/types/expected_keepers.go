@@ -0,0 +1,16 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+type ACLKeeper interface {
+	CheckValidZoneAddress(ctx cTypes.Context, id acl.ZoneID, address cTypes.AccAddress) bool
+}
+
+// BankKeeper : executes the issuances and redemptions once approved
+type BankKeeper interface {
+	ExecutePendingAction(ctx cTypes.Context, pendingAction PendingAction) cTypes.Error
+}
@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	ApprovalPolicies []ApprovalPolicy `json:"approvalPolicies"`
+	PendingActions   []PendingAction  `json:"pendingActions"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	zoneIDs := make(map[string]bool)
+	for _, approvalPolicy := range data.ApprovalPolicies {
+		if err := approvalPolicy.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid approval policy in genesis: %s", err.Error())
+		}
+		if zoneIDs[approvalPolicy.ZoneID.String()] {
+			return fmt.Errorf("duplicate approval policy for zone %s in genesis", approvalPolicy.ZoneID.String())
+		}
+		zoneIDs[approvalPolicy.ZoneID.String()] = true
+	}
+
+	pendingIDs := make(map[uint64]bool)
+	for _, pendingAction := range data.PendingActions {
+		if err := pendingAction.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending action in genesis: %s", err.Error())
+		}
+		if pendingIDs[pendingAction.PendingID] {
+			return fmt.Errorf("duplicate pending action %d in genesis", pendingAction.PendingID)
+		}
+		pendingIDs[pendingAction.PendingID] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+const (
+	ModuleName   = "approvals"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	ApprovalPolicyKey = []byte{0x01}
+	PendingActionKey  = []byte{0x02}
+	NextPendingIDKey  = []byte{0x03}
+	PendingExpiryKey  = []byte{0x04}
+)
+
+// approvals/{0x01}/{zoneID}
+func GetApprovalPolicyKey(zoneID acl.ZoneID) []byte {
+	return append(ApprovalPolicyKey, zoneID...)
+}
+
+// approvals/{0x02}/{pendingID}
+func GetPendingActionKey(pendingID uint64) []byte {
+	return append(PendingActionKey, GetPendingIDBytes(pendingID)...)
+}
+
+// approvals/{0x04}/{expiryHeight}/{pendingID} index of the pending actions by expiry height
+func GetPendingExpiryKey(expiryHeight int64, pendingID uint64) []byte {
+	return append(GetPendingExpiryHeightKey(expiryHeight), GetPendingIDBytes(pendingID)...)
+}
+
+func GetPendingExpiryHeightKey(expiryHeight int64) []byte {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(expiryHeight))
+	return append(PendingExpiryKey, height...)
+}
+
+func GetPendingIDBytes(pendingID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, pendingID)
+	return bz
+}
+
+func GetPendingIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,232 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// *****SetApprovalPolicy
+
+// SetApprovalPolicy : zone sets the approvers of its issuances and redemptions, a change of an existing policy needs its approvals
+type SetApprovalPolicy struct {
+	FromAddress  cTypes.AccAddress   `json:"fromAddress"`
+	ZoneID       acl.ZoneID          `json:"zoneID"`
+	Approvers    []cTypes.AccAddress `json:"approvers"`
+	Threshold    int64               `json:"threshold"`
+	ExpiryBlocks int64               `json:"expiryBlocks"`
+}
+
+// NewSetApprovalPolicy : initializer
+func NewSetApprovalPolicy(fromAddress cTypes.AccAddress, zoneID acl.ZoneID, approvers []cTypes.AccAddress, threshold int64,
+	expiryBlocks int64) SetApprovalPolicy {
+
+	return SetApprovalPolicy{fromAddress, zoneID, approvers, threshold, expiryBlocks}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SetApprovalPolicy) GetSignBytes() []byte {
+	var approvers []string
+	for _, approver := range in.Approvers {
+		approvers = append(approvers, approver.String())
+	}
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress  string   `json:"fromAddress"`
+		ZoneID       string   `json:"zoneID"`
+		Approvers    []string `json:"approvers"`
+		Threshold    int64    `json:"threshold"`
+		ExpiryBlocks int64    `json:"expiryBlocks"`
+	}{
+		FromAddress:  in.FromAddress.String(),
+		ZoneID:       in.ZoneID.String(),
+		Approvers:    approvers,
+		Threshold:    in.Threshold,
+		ExpiryBlocks: in.ExpiryBlocks,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in SetApprovalPolicy) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	}
+	return in.GetApprovalPolicy().ValidateBasic()
+}
+
+func (in SetApprovalPolicy) GetApprovalPolicy() ApprovalPolicy {
+	return NewApprovalPolicy(in.ZoneID, in.Approvers, in.Threshold, in.ExpiryBlocks)
+}
+
+// MsgSetApprovalPolicies : high level approval policy setting of approvals module
+type MsgSetApprovalPolicies struct {
+	SetApprovalPolicies []SetApprovalPolicy `json:"setApprovalPolicies"`
+}
+
+// NewMsgSetApprovalPolicies : initializer
+func NewMsgSetApprovalPolicies(setApprovalPolicies []SetApprovalPolicy) MsgSetApprovalPolicies {
+	return MsgSetApprovalPolicies{setApprovalPolicies}
+}
+
+var _ cTypes.Msg = MsgSetApprovalPolicies{}
+
+// Type : implements msg
+func (msg MsgSetApprovalPolicies) Type() string { return "setApprovalPolicies" }
+
+func (msg MsgSetApprovalPolicies) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgSetApprovalPolicies) ValidateBasic() cTypes.Error {
+	if len(msg.SetApprovalPolicies) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.SetApprovalPolicies {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgSetApprovalPolicies) GetSignBytes() []byte {
+	var setApprovalPolicies []json.RawMessage
+	for _, setApprovalPolicy := range msg.SetApprovalPolicies {
+		setApprovalPolicies = append(setApprovalPolicies, setApprovalPolicy.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SetApprovalPolicies []json.RawMessage `json:"setApprovalPolicies"`
+	}{
+		SetApprovalPolicies: setApprovalPolicies,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgSetApprovalPolicies) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.SetApprovalPolicies))
+	for i, in := range msg.SetApprovalPolicies {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgSetApprovalPolicy : build the MsgSetApprovalPolicies
+func BuildMsgSetApprovalPolicy(fromAddress cTypes.AccAddress, zoneID acl.ZoneID, approvers []cTypes.AccAddress, threshold int64,
+	expiryBlocks int64) cTypes.Msg {
+
+	setApprovalPolicy := NewSetApprovalPolicy(fromAddress, zoneID, approvers, threshold, expiryBlocks)
+	msg := NewMsgSetApprovalPolicies([]SetApprovalPolicy{setApprovalPolicy})
+	return msg
+}
+
+// #####SetApprovalPolicy
+
+// *****ApprovePendingAction
+
+// ApprovePendingAction : approver of the zone approves a pending action, executing it once the threshold is reached
+type ApprovePendingAction struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	PendingID   uint64            `json:"pendingID"`
+}
+
+// NewApprovePendingAction : initializer
+func NewApprovePendingAction(fromAddress cTypes.AccAddress, pendingID uint64) ApprovePendingAction {
+	return ApprovePendingAction{fromAddress, pendingID}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ApprovePendingAction) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		PendingID   uint64 `json:"pendingID"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		PendingID:   in.PendingID,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ApprovePendingAction) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	}
+	return nil
+}
+
+// MsgApprovePendingActions : high level pending action approval of approvals module
+type MsgApprovePendingActions struct {
+	ApprovePendingActions []ApprovePendingAction `json:"approvePendingActions"`
+}
+
+// NewMsgApprovePendingActions : initializer
+func NewMsgApprovePendingActions(approvePendingActions []ApprovePendingAction) MsgApprovePendingActions {
+	return MsgApprovePendingActions{approvePendingActions}
+}
+
+var _ cTypes.Msg = MsgApprovePendingActions{}
+
+// Type : implements msg
+func (msg MsgApprovePendingActions) Type() string { return "approvePendingActions" }
+
+func (msg MsgApprovePendingActions) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgApprovePendingActions) ValidateBasic() cTypes.Error {
+	if len(msg.ApprovePendingActions) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.ApprovePendingActions {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgApprovePendingActions) GetSignBytes() []byte {
+	var approvePendingActions []json.RawMessage
+	for _, approvePendingAction := range msg.ApprovePendingActions {
+		approvePendingActions = append(approvePendingActions, approvePendingAction.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ApprovePendingActions []json.RawMessage `json:"approvePendingActions"`
+	}{
+		ApprovePendingActions: approvePendingActions,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgApprovePendingActions) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.ApprovePendingActions))
+	for i, in := range msg.ApprovePendingActions {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgApprovePendingAction : build the MsgApprovePendingActions
+func BuildMsgApprovePendingAction(fromAddress cTypes.AccAddress, pendingID uint64) cTypes.Msg {
+	approvePendingAction := NewApprovePendingAction(fromAddress, pendingID)
+	msg := NewMsgApprovePendingActions([]ApprovePendingAction{approvePendingAction})
+	return msg
+}
+
+// #####ApprovePendingAction
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	ActionIssueAsset        = "issueAsset"
+	ActionIssueFiat         = "issueFiat"
+	ActionRedeemAsset       = "redeemAsset"
+	ActionRedeemFiat        = "redeemFiat"
+	ActionSetApprovalPolicy = "setApprovalPolicy"
+)
+
+// PendingAction : issuance, redemption or policy change of a zone waiting for the approvals of its ApprovalPolicy.
+// HolderAddress receives issued pegs or has its pegs redeemed, only the fields of Action are set.
+type PendingAction struct {
+	PendingID      uint64              `json:"pendingID"`
+	ZoneID         acl.ZoneID          `json:"zoneID"`
+	Action         string              `json:"action"`
+	MakerAddress   cTypes.AccAddress   `json:"makerAddress"`
+	IssuerAddress  cTypes.AccAddress   `json:"issuerAddress"`
+	HolderAddress  cTypes.AccAddress   `json:"holderAddress"`
+	AssetPeg       types.BaseAssetPeg  `json:"assetPeg"`
+	FiatPeg        types.BaseFiatPeg   `json:"fiatPeg"`
+	PegHash        types.PegHash       `json:"pegHash"`
+	Amount         int64               `json:"amount"`
+	BankReference  string              `json:"bankReference"`
+	ApprovalPolicy ApprovalPolicy      `json:"approvalPolicy"`
+	Approvals      []cTypes.AccAddress `json:"approvals"`
+	CreatedHeight  int64               `json:"createdHeight"`
+	ExpiryHeight   int64               `json:"expiryHeight"`
+}
+
+func (pendingAction PendingAction) String() string {
+	return fmt.Sprintf(`PendingAction:
+PendingID: %d,
+ZoneID: %s,
+Action: %s,
+MakerAddress: %s,
+HolderAddress: %s,
+Approvals: %v,
+ExpiryHeight: %d,
+`, pendingAction.PendingID, pendingAction.ZoneID.String(), pendingAction.Action, pendingAction.MakerAddress.String(),
+		pendingAction.HolderAddress.String(), pendingAction.Approvals, pendingAction.ExpiryHeight)
+}
+
+func (pendingAction PendingAction) ValidateBasic() cTypes.Error {
+	if len(pendingAction.ZoneID) == 0 {
+		return ErrInvalidPendingAction(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if len(pendingAction.MakerAddress) == 0 {
+		return ErrInvalidPendingAction(DefaultCodeSpace, "MakerAddress should not be empty.")
+	}
+	switch pendingAction.Action {
+	case ActionIssueAsset, ActionIssueFiat, ActionRedeemAsset, ActionRedeemFiat:
+		return nil
+	case ActionSetApprovalPolicy:
+		return pendingAction.ApprovalPolicy.ValidateBasic()
+	default:
+		return ErrInvalidPendingAction(DefaultCodeSpace, fmt.Sprintf("Invalid action %s.", pendingAction.Action))
+	}
+}
+
+func (pendingAction PendingAction) HasApproved(address cTypes.AccAddress) bool {
+	for _, approver := range pendingAction.Approvals {
+		if approver.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (pendingAction PendingAction) IsExpired(height int64) bool {
+	return height > pendingAction.ExpiryHeight
+}
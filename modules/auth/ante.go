@@ -36,8 +36,9 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+// signer, or from the granter of its fee allowance when the allowance covers the tx.
+func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -120,7 +121,15 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 
 		// deduct the fees
 		if !stdTx.Fee.Amount.IsZero() {
-			res = DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
+			feePayerAcc := signerAccs[0]
+			if granter, ok := feeGrantKeeper.UseGrantedFees(newCtx, feePayerAcc.GetAddress(), stdTx.Fee.Amount, stdTx.GetMsgs()); ok {
+				feePayerAcc, res = GetSignerAcc(newCtx, ak, granter)
+				if !res.IsOK() {
+					return newCtx, res, true
+				}
+			}
+
+			res = DeductFees(supplyKeeper, newCtx, feePayerAcc, stdTx.Fee.Amount)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -198,6 +198,17 @@ func (ak AccountKeeper) decodeAccount(bz []byte) (acc exported.Account) {
 	return
 }
 
+// GetAssetPegHashCount : Returns the number of asset peg hashes handed out, without incrementing the counter
+func (ak AccountKeeper) GetAssetPegHashCount(ctx sdk.Context) int {
+	var assetNumber int
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.AssetPegHashKey)
+	if bz != nil {
+		ak.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &assetNumber)
+	}
+	return assetNumber
+}
+
 func (ak AccountKeeper) GetNextAssetPegHash(ctx sdk.Context) int {
 	var assetNumber int
 	store := ctx.KVStore(ak.key)
@@ -12,3 +12,8 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeeGrantKeeper defines the expected fee grant Keeper (noalias)
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (granter sdk.AccAddress, ok bool)
+}
@@ -17,6 +17,9 @@ const (
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
 	DefaultParamspace        = types.DefaultParamspace
+	DefaultClawbackGoverned  = types.DefaultClawbackGoverned
+	CodeClawbackGoverned     = types.CodeClawbackGoverned
+	ProposalTypeClawback     = types.ProposalTypeClawback
 )
 
 var (
@@ -26,14 +29,18 @@ var (
 	ErrNoOutputs           = types.ErrNoOutputs
 	ErrInputOutputMismatch = types.ErrInputOutputMismatch
 	ErrSendDisabled        = types.ErrSendDisabled
+	ErrClawbackGoverned    = types.ErrClawbackGoverned
 	NewBaseKeeper          = keeper.NewBaseKeeper
 	NewInput               = types.NewInput
 	NewOutput              = types.NewOutput
 	ParamKeyTable          = types.ParamKeyTable
+	NewClawback            = types.NewClawback
+	NewClawbackProposal    = types.NewClawbackProposal
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                     = types.ModuleCdc
+	ParamStoreKeySendEnabled      = types.ParamStoreKeySendEnabled
+	ParamStoreKeyClawbackGoverned = types.ParamStoreKeyClawbackGoverned
 )
 
 type (
@@ -44,4 +51,9 @@ type (
 	Input              = types.Input
 	Output             = types.Output
 	MsgBankIssueAssets = types.MsgBankIssueAssets
+	ReserveKeeper      = types.ReserveKeeper
+	PegEscrowKeeper    = types.PegEscrowKeeper
+	Clawback           = types.Clawback
+	MsgBankClawbacks   = types.MsgBankClawbacks
+	ClawbackProposal   = types.ClawbackProposal
 )
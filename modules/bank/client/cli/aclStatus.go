@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func SuspendACLCmd(cdc *codec.Codec) *cobra.Command {
+	return aclStatusCmd(cdc, "suspendACL", "Suspend all acl permissions of an address until reinstated",
+		types2.BuildMsgSuspendACL)
+}
+
+func ReinstateACLCmd(cdc *codec.Codec) *cobra.Command {
+	return aclStatusCmd(cdc, "reinstateACL", "Reinstate the acl permissions of a suspended address",
+		types2.BuildMsgReinstateACL)
+}
+
+func RevokeACLCmd(cdc *codec.Codec) *cobra.Command {
+	return aclStatusCmd(cdc, "revokeACL", "Revoke the acl and roles of an address",
+		types2.BuildMsgRevokeACL)
+}
+
+func aclStatusCmd(cdc *codec.Codec, use string, short string,
+	buildMsg func(from cTypes.AccAddress, to cTypes.AccAddress, reason string) cTypes.Msg) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			msg := buildMsg(cliCtx.GetFromAddress(), to, viper.GetString(FlagReason))
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsReason)
+	return cmd
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/gov"
+)
+
+// ClawbackCmd : the zone of an account claws back a fraudulent peg it holds
+func ClawbackCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback",
+		Short: "Claw back the --pegType peg --pegHash held by an account to its issuer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toStr := viper.GetString(FlagTo)
+			to, err := cTypes.AccAddressFromBech32(toStr)
+			if err != nil {
+				return err
+			}
+
+			pegHashStr := viper.GetString(FlagPegHash)
+			pegHashHex, err := types.GetAssetPegHashHex(pegHashStr)
+			if err != nil {
+				return err
+			}
+
+			msg := client.BuildClawbackMsg(cliCtx.GetFromAddress(), to, viper.GetString(FlagPegType), pegHashHex,
+				viper.GetString(FlagReason))
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsPegType)
+	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsReason)
+	return cmd
+}
+
+// ClawbackProposalJSON : clawback proposal with a deposit, read from a file
+type ClawbackProposalJSON struct {
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Clawback    bankTypes.Clawback `json:"clawback"`
+	Deposit     cTypes.Coins       `json:"deposit"`
+}
+
+// GetCmdSubmitClawbackProposal : submits a proposal clawing back a fraudulent peg
+func GetCmdSubmitClawbackProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clawback [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal clawing back a fraudulent peg to its issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal clawing back a fraudulent peg to the zone that issued it along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal clawback <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "...",
+  "description": "...",
+  "clawback": {
+    "issuerAddress": "commit1...",
+    "fromAddress": "commit1...",
+    "pegType": "asset",
+    "pegHash": "3130",
+    "reason": "..."
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal ClawbackProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			content := bankTypes.NewClawbackProposal(proposal.Title, proposal.Description, proposal.Clawback)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+}
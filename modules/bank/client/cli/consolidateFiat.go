@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+)
+
+func ConsolidateFiatCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consolidateFiat",
+		Short: "Merges fiat pegs of the wallet issued by the same zone in the same currency into one fiat peg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var pegHashes []types.PegHash
+			pegHashesStr := viper.GetString(FlagPegHashes)
+			if pegHashesStr != "" {
+				for _, pegHashStr := range strings.Split(pegHashesStr, ",") {
+					pegHashHex, err := types.GetFiatPegHashHex(strings.TrimSpace(pegHashStr))
+					if err != nil {
+						return err
+					}
+					pegHashes = append(pegHashes, pegHashHex)
+				}
+			}
+
+			msg := client.BuildConsolidateFiatMsg(cliCtx.GetFromAddress(), pegHashes)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsPegHashes)
+	return cmd
+}
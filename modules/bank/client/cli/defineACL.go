@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	cTypes "github.com/cosmos/cosmos-sdk/types"
@@ -48,7 +50,13 @@ func DefineACLCmd(cdc *codec.Codec) *cobra.Command {
 				ZoneID:         zoneID,
 				OrganizationID: organizationID,
 				ACL:            aclRequest,
+				Roles:          BuildRoles(),
 			}
+			permissionExpiries, err := BuildPermissionExpiries()
+			if err != nil {
+				return err
+			}
+			_ = aclAccount.SetPermissionExpiries(permissionExpiries)
 
 			msg := types2.BuildMsgDefineACL(cliCtx.GetFromAddress(), to, aclAccount)
 
@@ -72,9 +80,41 @@ func DefineACLCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsRedeemFiat)
 	cmd.Flags().AddFlagSet(fsRedeemAsset)
 	cmd.Flags().AddFlagSet(fsReleaseAsset)
+	cmd.Flags().AddFlagSet(fsRoles)
+	cmd.Flags().AddFlagSet(fsPermissionExpiries)
 	return cmd
 }
 
+func BuildRoles() []string {
+	var roles []string
+	for _, role := range strings.Split(viper.GetString(FlagRoles), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// BuildPermissionExpiries : expiry heights of granted permissions, given as comma separated permission:height pairs
+func BuildPermissionExpiries() ([]acl.PermissionExpiry, error) {
+	var permissionExpiries []acl.PermissionExpiry
+	for _, pair := range strings.Split(viper.GetString(FlagPermissionExpiries), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		fields := strings.Split(pair, ":")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("permission expiry %s should be permission:height", pair)
+		}
+		expiryHeight, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		permissionExpiries = append(permissionExpiries, acl.NewPermissionExpiry(fields[0], expiryHeight))
+	}
+	return permissionExpiries, nil
+}
+
 func BuildACL() acl.ACL {
 	var Request acl.ACL
 	data, err := strconv.ParseBool(viper.GetString(FlagIssueAsset))
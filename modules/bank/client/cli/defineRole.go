@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func DefineRoleCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "defineRole",
+		Short: "Define or edit a role of a zone or organization, applied to all accounts assigned the role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			var organizationID acl.OrganizationID
+			if strOrganizationID := viper.GetString(FlagOrganizationID); strOrganizationID != "" {
+				organizationID, err = acl.GetOrganizationIDFromString(strOrganizationID)
+				if err != nil {
+					return err
+				}
+			}
+
+			role := acl.NewRole(viper.GetString(FlagRoleName), zoneID, organizationID, BuildACL())
+
+			msg := types2.BuildMsgDefineRole(cliCtx.GetFromAddress(), role)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsRoleName)
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsIssueAsset)
+	cmd.Flags().AddFlagSet(fsIssueFiat)
+	cmd.Flags().AddFlagSet(fsSendAsset)
+	cmd.Flags().AddFlagSet(fsSendFiat)
+	cmd.Flags().AddFlagSet(fsBuyerExecuteOrder)
+	cmd.Flags().AddFlagSet(fsSellerExecuteOrder)
+	cmd.Flags().AddFlagSet(fsChangeBuyerBid)
+	cmd.Flags().AddFlagSet(fsChangeSellerBid)
+	cmd.Flags().AddFlagSet(fsConfirmBuyerBid)
+	cmd.Flags().AddFlagSet(fsConfirmSellerBid)
+	cmd.Flags().AddFlagSet(fsNegotiation)
+	cmd.Flags().AddFlagSet(fsRedeemFiat)
+	cmd.Flags().AddFlagSet(fsRedeemAsset)
+	cmd.Flags().AddFlagSet(fsReleaseAsset)
+	return cmd
+}
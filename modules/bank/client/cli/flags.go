@@ -6,37 +6,54 @@ import (
 
 // noLint
 const (
-	FlagTo                 = "to"
-	FlagAmount             = "amount"
-	FlagDocumentHash       = "documentHash"
-	FlagAssetType          = "assetType"
-	FlagAssetPrice         = "assetPrice"
-	FlagAssetQuantity      = "assetQuantity"
-	FlagQuantityUnit       = "quantityUnit"
-	FlagTransactionID      = "transactionID"
-	FlagTransactionAmount  = "transactionAmount"
-	FlagPegHash            = "pegHash"
-	FlagBuyerAddress       = "buyerAddress"
-	FlagSellerAddress      = "sellerAddress"
-	FlagFiatProofHash      = "fiatProofHash"
-	FlagAWBProofHash       = "awbProofHash"
-	FlagOrganizationID     = "organizationID"
-	FlagZoneID             = "zoneID"
-	FlagIssueAsset         = "issueAsset"
-	FlagIssueFiat          = "issueFiat"
-	FlagSendAsset          = "sendAsset"
-	FlagSendFiat           = "sendFiat"
-	FlagBuyerExecuteOrder  = "buyerExecuteOrder"
-	FlagSellerExecuteOrder = "sellerExecuteOrder"
-	FlagChangeBuyerBid     = "changeBuyerBid"
-	FlagChangeSellerBid    = "changeSellerBid"
-	FlagConfirmBuyerBid    = "confirmBuyerBid"
-	FlagConfirmSellerBid   = "confirmSellerBid"
-	FlagNegotiation        = "negotiation"
-	FlagRedeemAsset        = "redeemAsset"
-	FlagRedeemFiat         = "redeemFiat"
-	FlagReleaseAsset       = "releaseAsset"
-	FlagModerated          = "moderated"
+	FlagTo                  = "to"
+	FlagAmount              = "amount"
+	FlagDocumentHash        = "documentHash"
+	FlagAssetType           = "assetType"
+	FlagAssetPrice          = "assetPrice"
+	FlagAssetQuantity       = "assetQuantity"
+	FlagQuantityUnit        = "quantityUnit"
+	FlagTransactionID       = "transactionID"
+	FlagTransactionAmount   = "transactionAmount"
+	FlagPegHash             = "pegHash"
+	FlagBuyerAddress        = "buyerAddress"
+	FlagSellerAddress       = "sellerAddress"
+	FlagFiatProofHash       = "fiatProofHash"
+	FlagAWBProofHash        = "awbProofHash"
+	FlagOrganizationID      = "organizationID"
+	FlagZoneID              = "zoneID"
+	FlagIssueAsset          = "issueAsset"
+	FlagIssueFiat           = "issueFiat"
+	FlagSendAsset           = "sendAsset"
+	FlagSendFiat            = "sendFiat"
+	FlagBuyerExecuteOrder   = "buyerExecuteOrder"
+	FlagSellerExecuteOrder  = "sellerExecuteOrder"
+	FlagChangeBuyerBid      = "changeBuyerBid"
+	FlagChangeSellerBid     = "changeSellerBid"
+	FlagConfirmBuyerBid     = "confirmBuyerBid"
+	FlagConfirmSellerBid    = "confirmSellerBid"
+	FlagNegotiation         = "negotiation"
+	FlagRedeemAsset         = "redeemAsset"
+	FlagRedeemFiat          = "redeemFiat"
+	FlagReleaseAsset        = "releaseAsset"
+	FlagModerated           = "moderated"
+	FlagQuantity            = "quantity"
+	FlagDelivererAddress    = "delivererAddress"
+	FlagPegHashes           = "pegHashes"
+	FlagBankReference       = "bankReference"
+	FlagRoleName            = "roleName"
+	FlagRoles               = "roles"
+	FlagReason              = "reason"
+	FlagPermissionExpiries  = "permissionExpiries"
+	FlagParentID            = "parentID"
+	FlagAdmins              = "admins"
+	FlagLevel               = "level"
+	FlagExpiresAt           = "expiresAt"
+	FlagMaxBid              = "maxBid"
+	FlagMaxDailyFiatOutflow = "maxDailyFiatOutflow"
+	FlagMaxOpenNegotiations = "maxOpenNegotiations"
+	FlagPegType             = "pegType"
+	FlagCurrency            = "currency"
 )
 
 var (
@@ -71,6 +88,22 @@ var (
 	fsRedeemFiat         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsReleaseAsset       = flag.NewFlagSet("", flag.ContinueOnError)
 	fsModerated          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsQuantity           = flag.NewFlagSet("", flag.ContinueOnError)
+	fsDelivererAddress   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPegHashes          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBankReference      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRoleName           = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRoles              = flag.NewFlagSet("", flag.ContinueOnError)
+	fsReason             = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPermissionExpiries = flag.NewFlagSet("", flag.ContinueOnError)
+	fsParentID           = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAdmins             = flag.NewFlagSet("", flag.ContinueOnError)
+	fsLevel              = flag.NewFlagSet("", flag.ContinueOnError)
+	fsExpiresAt          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsKYCDocumentHash    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsTradingLimit       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPegType            = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCurrency           = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -105,4 +138,22 @@ func init() {
 	fsRedeemFiat.String(FlagRedeemFiat, "", "Redeem fiats")
 	fsReleaseAsset.String(FlagReleaseAsset, "", "Release assets")
 	fsModerated.Bool(FlagModerated, false, "moderated")
+	fsQuantity.Int64(FlagQuantity, 0, "Quantity delivered in the tranche")
+	fsDelivererAddress.String(FlagDelivererAddress, "", "Address of the swap party delivering its assets")
+	fsPegHashes.String(FlagPegHashes, "", "Comma separated fiat peg hashes to consolidate, all fiat pegs of the wallet if empty")
+	fsBankReference.String(FlagBankReference, "", "Bank account reference the redeemed fiat is paid out to")
+	fsRoleName.String(FlagRoleName, "", "Name of the role")
+	fsRoles.String(FlagRoles, "", "Comma separated roles of the account, granted on top of its own acl")
+	fsReason.String(FlagReason, "", "Reason of the acl change")
+	fsPermissionExpiries.String(FlagPermissionExpiries, "", "Comma separated permission:height pairs, each granted permission is withdrawn from its height on, e.g. sendFiat:1000")
+	fsParentID.String(FlagParentID, "", "Organization id of the parent organization")
+	fsAdmins.String(FlagAdmins, "", "Comma separated admin addresses of the organization")
+	fsLevel.Int64(FlagLevel, 0, "Kyc level of the account")
+	fsExpiresAt.Int64(FlagExpiresAt, 0, "Block height the kyc expires at, never if 0")
+	fsKYCDocumentHash.String(FlagDocumentHash, "", "Hash of the kyc documents of the account")
+	fsTradingLimit.Int64(FlagMaxBid, 0, "Maximum bid of a negotiation, unlimited if 0")
+	fsTradingLimit.Int64(FlagMaxDailyFiatOutflow, 0, "Maximum fiat sent by an account in a day, unlimited if 0")
+	fsTradingLimit.Int64(FlagMaxOpenNegotiations, 0, "Maximum negotiations signed by an account and not yet by its counterparty, unlimited if 0")
+	fsPegType.String(FlagPegType, "", "Type of the peg, asset or fiat")
+	fsCurrency.String(FlagCurrency, "", "Denom of the currency of the fiat, e.g. usd")
 }
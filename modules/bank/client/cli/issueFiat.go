@@ -36,6 +36,7 @@ func IssueFiatCmd(cdc *codec.Codec) *cobra.Command {
 			fiatPeg := &types.BaseFiatPeg{
 				TransactionID:     transactionIDStr,
 				TransactionAmount: transactionAmountInt64,
+				Currency:          viper.GetString(FlagCurrency),
 			}
 			msg := client.BuildIssueFiatMsg(cliCtx.GetFromAddress(), to, fiatPeg)
 
@@ -45,5 +46,6 @@ func IssueFiatCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsTransactionID)
 	cmd.Flags().AddFlagSet(fsTransactionAmount)
+	cmd.Flags().AddFlagSet(fsCurrency)
 	return cmd
 }
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func RecordKYCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recordKYC",
+		Short: "Record the kyc of an account of the zone, trades of the account are rejected once it expires",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			msg := types2.BuildMsgRecordKYC(cliCtx.GetFromAddress(), to, viper.GetInt64(FlagLevel),
+				viper.GetString(FlagDocumentHash), viper.GetInt64(FlagExpiresAt))
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsLevel)
+	cmd.Flags().AddFlagSet(fsKYCDocumentHash)
+	cmd.Flags().AddFlagSet(fsExpiresAt)
+	return cmd
+}
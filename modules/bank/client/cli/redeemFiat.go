@@ -15,7 +15,7 @@ import (
 func RedeemFiatCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "redeemFiat",
-		Short: "Redeem fiat with the given details and returns some/total amount to the issuer address",
+		Short: "Redeem fiat with the given details, requesting a payout of the amount from the issuer address",
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -29,13 +29,15 @@ func RedeemFiatCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			amount := viper.GetInt64(FlagAmount)
+			bankReference := viper.GetString(FlagBankReference)
 
-			msg := client.BuildRedeemFiatMsg(cliCtx.GetFromAddress(), to, amount)
+			msg := client.BuildRedeemFiatMsg(cliCtx.GetFromAddress(), to, amount, bankReference)
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
 		},
 	}
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsBankReference)
 	return cmd
 }
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func RotateZoneAddressCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotateZoneAddress",
+		Short: "move a zone to a new address, signed by the current zone address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			msg := types2.BuildMsgRotateZoneAddress(cliCtx.GetFromAddress(), zoneID, to)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsTo)
+	return cmd
+}
+
+func RotateOrganizationAddressCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotateOrganizationAddress",
+		Short: "move an organization to a new address, signed by the current organization or zone address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			organizationID, err := acl.GetOrganizationIDFromString(viper.GetString(FlagOrganizationID))
+			if err != nil {
+				return err
+			}
+
+			msg := types2.BuildMsgRotateOrganizationAddress(cliCtx.GetFromAddress(), organizationID, to)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsTo)
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+)
+
+func SellerExecuteTrancheCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sellerExecuteTranche",
+		Short: "delivers a tranche of the order and releases its share of the fiat to the seller",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			buyerAddressString := viper.GetString(FlagBuyerAddress)
+			buyerAddress, err := cTypes.AccAddressFromBech32(buyerAddressString)
+			if err != nil {
+				return err
+			}
+
+			sellerAddressString := viper.GetString(FlagSellerAddress)
+			sellerAddress, err := cTypes.AccAddressFromBech32(sellerAddressString)
+			if err != nil {
+				return err
+			}
+
+			pegHashStr := viper.GetString(FlagPegHash)
+			pegHashHex, err := types.GetAssetPegHashHex(pegHashStr)
+			if err != nil {
+				return err
+			}
+
+			awbProofHashStr := viper.GetString(FlagAWBProofHash)
+			quantity := viper.GetInt64(FlagQuantity)
+
+			msg := client.BuildSellerExecuteTrancheMsg(cliCtx.GetFromAddress(), buyerAddress,
+				sellerAddress, pegHashHex, awbProofHashStr, quantity)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsBuyerAddress)
+	cmd.Flags().AddFlagSet(fsSellerAddress)
+	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsAWBProofHash)
+	cmd.Flags().AddFlagSet(fsQuantity)
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+)
+
+func SendCoinToOrderCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sendCoinToOrder",
+		Short: "Escrows settlement coins into an order transaction with a given address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toStr := viper.GetString(FlagTo)
+
+			to, err := cTypes.AccAddressFromBech32(toStr)
+			if err != nil {
+				return nil
+			}
+
+			amount, err := cTypes.ParseCoins(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			pegHashStr := viper.GetString(FlagPegHash)
+			pegHashHex, err := types.GetAssetPegHashHex(pegHashStr)
+
+			msg := client.BuildSendCoinToOrderMsg(cliCtx.GetFromAddress(), to, pegHashHex, amount)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsPegHash)
+	return cmd
+}
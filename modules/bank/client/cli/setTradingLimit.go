@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func SetTradingLimitCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setTradingLimit",
+		Short: "Limit the trading of an organization with --organizationID or of an acl account with --to, 0 is unlimited",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var organizationID acl.OrganizationID
+			var to cTypes.AccAddress
+			var err error
+			if strOrganizationID := viper.GetString(FlagOrganizationID); strOrganizationID != "" {
+				organizationID, err = acl.GetOrganizationIDFromString(strOrganizationID)
+			} else {
+				to, err = cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			}
+			if err != nil {
+				return err
+			}
+
+			tradingLimit := acl.NewTradingLimit(viper.GetInt64(FlagMaxBid), viper.GetInt64(FlagMaxDailyFiatOutflow),
+				viper.GetInt64(FlagMaxOpenNegotiations))
+			msg := types2.BuildMsgSetTradingLimit(cliCtx.GetFromAddress(), organizationID, to, tradingLimit)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsTradingLimit)
+	return cmd
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	types2 "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func DefineSubOrganizationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "defineSubOrganization",
+		Short: "define an organization under a parent organization, bounded by the acl given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			organizationID, err := acl.GetOrganizationIDFromString(viper.GetString(FlagOrganizationID))
+			if err != nil {
+				return err
+			}
+
+			parentID, err := acl.GetOrganizationIDFromString(viper.GetString(FlagParentID))
+			if err != nil {
+				return err
+			}
+
+			msg := types2.BuildMsgDefineSubOrganization(cliCtx.GetFromAddress(), to, organizationID, parentID, BuildACL())
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsParentID)
+	cmd.Flags().AddFlagSet(fsIssueAsset)
+	cmd.Flags().AddFlagSet(fsIssueFiat)
+	cmd.Flags().AddFlagSet(fsSendAsset)
+	cmd.Flags().AddFlagSet(fsSendFiat)
+	cmd.Flags().AddFlagSet(fsBuyerExecuteOrder)
+	cmd.Flags().AddFlagSet(fsSellerExecuteOrder)
+	cmd.Flags().AddFlagSet(fsChangeBuyerBid)
+	cmd.Flags().AddFlagSet(fsChangeSellerBid)
+	cmd.Flags().AddFlagSet(fsConfirmBuyerBid)
+	cmd.Flags().AddFlagSet(fsConfirmSellerBid)
+	cmd.Flags().AddFlagSet(fsNegotiation)
+	cmd.Flags().AddFlagSet(fsRedeemFiat)
+	cmd.Flags().AddFlagSet(fsRedeemAsset)
+	cmd.Flags().AddFlagSet(fsReleaseAsset)
+	return cmd
+}
+
+func SetOrganizationAdminsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setOrganizationAdmins",
+		Short: "replace the admins of an organization, who can define acls for its accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			organizationID, err := acl.GetOrganizationIDFromString(viper.GetString(FlagOrganizationID))
+			if err != nil {
+				return err
+			}
+
+			var admins []cTypes.AccAddress
+			for _, strAdmin := range strings.Split(viper.GetString(FlagAdmins), ",") {
+				if strAdmin = strings.TrimSpace(strAdmin); strAdmin == "" {
+					continue
+				}
+				admin, err := cTypes.AccAddressFromBech32(strAdmin)
+				if err != nil {
+					return err
+				}
+				admins = append(admins, admin)
+			}
+
+			msg := types2.BuildMsgSetOrganizationAdmins(cliCtx.GetFromAddress(), organizationID, admins)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(fsOrganizationID)
+	cmd.Flags().AddFlagSet(fsAdmins)
+	return cmd
+}
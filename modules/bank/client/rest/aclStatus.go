@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+type ACLStatusReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	ACLAddress string       `json:"aclAddress" valid:"required~Enter the aclAddress,matches(^commit[a-z0-9]{39}$)~aclAddress is Invalid"`
+	Reason     string       `json:"reason" valid:"required~Enter the reason"`
+	Password   string       `json:"password" valid:"required~Enter the password"`
+	Mode       string       `json:"mode"`
+}
+
+func SuspendACLHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return aclStatusHandler(cliCtx, kafkaBool, kafkaState, "SUAC", bankTypes.BuildMsgSuspendACL)
+}
+
+func ReinstateACLHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return aclStatusHandler(cliCtx, kafkaBool, kafkaState, "RIAC", bankTypes.BuildMsgReinstateACL)
+}
+
+func RevokeACLHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return aclStatusHandler(cliCtx, kafkaBool, kafkaState, "RVAC", bankTypes.BuildMsgRevokeACL)
+}
+
+func aclStatusHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState, ticketPrefix string,
+	buildMsg func(from cTypes.AccAddress, to cTypes.AccAddress, reason string) cTypes.Msg) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ACLStatusReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		to, err := cTypes.AccAddressFromBech32(req.ACLAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := buildMsg(fromAddr, to, req.Reason)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator(ticketPrefix)
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
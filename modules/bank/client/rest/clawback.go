@@ -0,0 +1,127 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/gov"
+	govrest "github.com/commitHub/commitBlockchain/modules/gov/client/rest"
+)
+
+type ClawbackReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	To       string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
+	PegType  string       `json:"pegType" valid:"required~Enter the PegType,in(asset|fiat)~PegType should be asset or fiat"`
+	PegHash  string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[A-Fa-f0-9]+$)~Invalid PegHash"`
+	Reason   string       `json:"reason" valid:"required~Enter the Reason"`
+	Password string       `json:"password" valid:"required~Enter the Password"`
+	Mode     string       `json:"mode"`
+}
+
+func ClawbackHandlerFunction(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ClawbackReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		to, err := cTypes.AccAddressFromBech32(req.To)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pegHashHex, err := types.GetAssetPegHashHex(req.PegHash)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := client.BuildClawbackMsg(fromAddr, to, req.PegType, pegHashHex, req.Reason)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("CLBK")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
+
+// ClawbackProposalReq : clawback proposal request body
+type ClawbackProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Clawback    bankTypes.Clawback `json:"clawback"`
+	Proposer    cTypes.AccAddress  `json:"proposer"`
+	Deposit     cTypes.Coins       `json:"deposit"`
+}
+
+// ClawbackProposalRESTHandler : clawback proposal REST handler
+func ClawbackProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clawback",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ClawbackProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := bankTypes.NewClawbackProposal(req.Title, req.Description, req.Clawback)
+			msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []cTypes.Msg{msg})
+		},
+	}
+}
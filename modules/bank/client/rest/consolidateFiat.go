@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+)
+
+type ConsolidateFiatReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	PegHashes []string     `json:"pegHashes"`
+	Password  string       `json:"password" valid:"required~Enter the Password"`
+	Mode      string       `json:"mode"`
+}
+
+func ConsolidateFiatRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req ConsolidateFiatReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryACLAccount", fromAddr), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query account. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		var account acl.ACLAccount
+		cliCtx.Codec.MustUnmarshalJSON(res, &account)
+
+		if !account.GetACL().SendFiat {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		var pegHashes []types.PegHash
+		for _, pegHashStr := range req.PegHashes {
+			pegHashHex, err := types.GetFiatPegHashHex(pegHashStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			pegHashes = append(pegHashes, pegHashHex)
+		}
+
+		msg := client.BuildConsolidateFiatMsg(fromAddr, pegHashes)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("COFI")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -17,26 +17,28 @@ import (
 )
 
 type DefineACLReq struct {
-	BaseReq            rest.BaseReq `json:"base_req"`
-	ACLAddress         string       `json:"aclAddress" valid:"required~Enter the aclAddress,matches(^commit[a-z0-9]{39}$)~aclAddress is Invalid"`
-	OrganizationID     string       `json:"organizationID" valid:"required~Enter the organizationID, matches(^[A-Fa-f0-9]+$)~Invalid organizationID,length(2|40)~OrganizationID length should be 2 to 40"`
-	ZoneID             string       `json:"zoneID" valid:"required~Enter the zoneID, matches(^[A-Fa-f0-9]+$)~Invalid zoneID,length(2|40)~ZoneID length should be 2 to 40"`
-	IssueAsset         string       `json:"issueAsset" valid:"required~Enter the issueAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
-	IssueFiat          string       `json:"issueFiat" valid:"required~Enter the issueFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueFiat"`
-	SendAsset          string       `json:"sendAsset" valid:"required~Enter the sendAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid sendAsset"`
-	SendFiat           string       `json:"sendFiat" valid:"required~Enter the sendFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueFiat"`
-	BuyerExecuteOrder  string       `json:"buyerExecuteOrder" valid:"required~Enter the buyerExecuteOrder, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid buyerExecuteOrder"`
-	SellerExecuteOrder string       `json:"sellerExecuteOrder" valid:"required~Enter the issueAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
-	ChangeBuyerBid     string       `json:"changeBuyerBid" valid:"required~Enter the changeBuyerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid changeBuyerBid"`
-	ChangeSellerBid    string       `json:"changeSellerBid" valid:"required~Enter the changeSellerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid changeSellerBid"`
-	ConfirmBuyerBid    string       `json:"confirmBuyerBid" valid:"required~Enter the confirmBuyerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
-	ConfirmSellerBid   string       `json:"confirmSellerBid" valid:"required~Enter the confirmSellerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid confirmSellerBid"`
-	Negotiation        string       `json:"negotiation" valid:"required~Enter the negotiation, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid negotiation"`
-	RedeemAsset        string       `json:"redeemAsset" valid:"required~Enter the redeemAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid redeemAsset"`
-	RedeemFiat         string       `json:"redeemFiat" valid:"required~Enter the redeemFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid redeemFiat"`
-	ReleaseAsset       string       `json:"releaseAsset" valid:"required~Enter the releaseAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid releaseAsset"`
-	Password           string       `json:"password" valid:"required~Enter the password"`
-	Mode               string       `json:"mode"`
+	BaseReq            rest.BaseReq           `json:"base_req"`
+	ACLAddress         string                 `json:"aclAddress" valid:"required~Enter the aclAddress,matches(^commit[a-z0-9]{39}$)~aclAddress is Invalid"`
+	OrganizationID     string                 `json:"organizationID" valid:"required~Enter the organizationID, matches(^[A-Fa-f0-9]+$)~Invalid organizationID,length(2|40)~OrganizationID length should be 2 to 40"`
+	ZoneID             string                 `json:"zoneID" valid:"required~Enter the zoneID, matches(^[A-Fa-f0-9]+$)~Invalid zoneID,length(2|40)~ZoneID length should be 2 to 40"`
+	IssueAsset         string                 `json:"issueAsset" valid:"required~Enter the issueAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
+	IssueFiat          string                 `json:"issueFiat" valid:"required~Enter the issueFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueFiat"`
+	SendAsset          string                 `json:"sendAsset" valid:"required~Enter the sendAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid sendAsset"`
+	SendFiat           string                 `json:"sendFiat" valid:"required~Enter the sendFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueFiat"`
+	BuyerExecuteOrder  string                 `json:"buyerExecuteOrder" valid:"required~Enter the buyerExecuteOrder, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid buyerExecuteOrder"`
+	SellerExecuteOrder string                 `json:"sellerExecuteOrder" valid:"required~Enter the issueAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
+	ChangeBuyerBid     string                 `json:"changeBuyerBid" valid:"required~Enter the changeBuyerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid changeBuyerBid"`
+	ChangeSellerBid    string                 `json:"changeSellerBid" valid:"required~Enter the changeSellerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid changeSellerBid"`
+	ConfirmBuyerBid    string                 `json:"confirmBuyerBid" valid:"required~Enter the confirmBuyerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid issueAsset"`
+	ConfirmSellerBid   string                 `json:"confirmSellerBid" valid:"required~Enter the confirmSellerBid, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid confirmSellerBid"`
+	Negotiation        string                 `json:"negotiation" valid:"required~Enter the negotiation, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid negotiation"`
+	RedeemAsset        string                 `json:"redeemAsset" valid:"required~Enter the redeemAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid redeemAsset"`
+	RedeemFiat         string                 `json:"redeemFiat" valid:"required~Enter the redeemFiat, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid redeemFiat"`
+	ReleaseAsset       string                 `json:"releaseAsset" valid:"required~Enter the releaseAsset, matches(^(true|TRUE|True|false|FALSE|False)*$)~Invalid releaseAsset"`
+	Roles              []string               `json:"roles"`
+	PermissionExpiries []acl.PermissionExpiry `json:"permissionExpiries"`
+	Password           string                 `json:"password" valid:"required~Enter the password"`
+	Mode               string                 `json:"mode"`
 }
 
 func DefineACLHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
@@ -109,10 +111,12 @@ func DefineACLHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafk
 		}
 
 		aclAccount := &acl.BaseACLAccount{
-			Address:        to,
-			ZoneID:         zoneID,
-			OrganizationID: organizationID,
-			ACL:            ACLReq,
+			Address:            to,
+			ZoneID:             zoneID,
+			OrganizationID:     organizationID,
+			ACL:                ACLReq,
+			Roles:              req.Roles,
+			PermissionExpiries: req.PermissionExpiries,
 		}
 
 		msg := bankTypes.BuildMsgDefineACL(fromAddr, to, aclAccount)
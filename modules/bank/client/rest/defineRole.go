@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+type DefineRoleReq struct {
+	BaseReq        rest.BaseReq `json:"base_req"`
+	Name           string       `json:"name" valid:"required~Enter the name,matches(^[A-Za-z0-9_-]+$)~Invalid name"`
+	ZoneID         string       `json:"zoneID" valid:"required~Enter the zoneID, matches(^[A-Fa-f0-9]+$)~Invalid zoneID,length(2|40)~ZoneID length should be 2 to 40"`
+	OrganizationID string       `json:"organizationID" valid:"matches(^[A-Fa-f0-9]+$)~Invalid organizationID,length(2|40)~OrganizationID length should be 2 to 40"`
+	ACL            acl.ACL      `json:"acl"`
+	Password       string       `json:"password" valid:"required~Enter the password"`
+	Mode           string       `json:"mode"`
+}
+
+func DefineRoleHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DefineRoleReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		zoneID, err := acl.GetZoneIDFromString(req.ZoneID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var organizationID acl.OrganizationID
+		if req.OrganizationID != "" {
+			organizationID, err = acl.GetOrganizationIDFromString(req.OrganizationID)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := bankTypes.BuildMsgDefineRole(fromAddr, acl.NewRole(req.Name, zoneID, organizationID, req.ACL))
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("DFRL")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -24,6 +24,7 @@ type IssueFiatReq struct {
 	To                string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
 	TransactionID     string       `json:"transactionID" valid:"required~Enter the TransactionID,  matches(^[A-Za-z0-9]+$)~transactionID is Invalid,length(2|40)~TransactionID length should be 2 to 40"`
 	TransactionAmount int64        `json:"transactionAmount" valid:"required~Enter the TransactionAmount,matches(^[1-9]{1}[0-9]*$)~Invalid TransactionAmount"`
+	Currency          string       `json:"currency" valid:"required~Enter the Currency,matches(^[a-z][a-z0-9]+$)~Currency is Invalid"`
 	Password          string       `json:"password" valid:"required~Enter the Password"`
 	Mode              string       `json:"mode"`
 }
@@ -106,6 +107,7 @@ func IssueFiatHandlerFunction(cliCtx context.CLIContext, kafkaBool bool, kafkaSt
 
 			TransactionID:     req.TransactionID,
 			TransactionAmount: req.TransactionAmount,
+			Currency:          req.Currency,
 		}
 
 		msg := client.BuildIssueFiatMsg(fromAddr, to, &fiatPeg)
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+type RecordKYCReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	To           string       `json:"to" valid:"required~Enter the to Address,matches(^commit[a-z0-9]{39}$)~to Address is Invalid"`
+	Level        int64        `json:"level"`
+	DocumentHash string       `json:"documentHash" valid:"required~Enter the documentHash"`
+	ExpiresAt    int64        `json:"expiresAt"`
+	Password     string       `json:"password" valid:"required~Enter the password"`
+	Mode         string       `json:"mode"`
+}
+
+func RecordKYCHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RecordKYCReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		to, err := cTypes.AccAddressFromBech32(req.To)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := bankTypes.BuildMsgRecordKYC(fromAddr, to, req.Level, req.DocumentHash, req.ExpiresAt)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("RKYC")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -17,11 +17,12 @@ import (
 )
 
 type RedeemFiatReq struct {
-	BaseReq      rest.BaseReq `json:"base_req"`
-	To           string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
-	RedeemAmount int64        `json:"redeemAmount" valid:"required~Enter the Valid Amount,matches(^[1-9]{1}[0-9]*$)~Invalid Amount"`
-	Password     string       `json:"password" valid:"required~Enter the Password"`
-	Mode         string       `json:"mode"`
+	BaseReq       rest.BaseReq `json:"base_req"`
+	To            string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
+	RedeemAmount  int64        `json:"redeemAmount" valid:"required~Enter the Valid Amount,matches(^[1-9]{1}[0-9]*$)~Invalid Amount"`
+	BankReference string       `json:"bankReference" valid:"required~Enter the BankReference"`
+	Password      string       `json:"password" valid:"required~Enter the Password"`
+	Mode          string       `json:"mode"`
 }
 
 func RedeemFiatHandlerFunction(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
@@ -83,7 +84,7 @@ func RedeemFiatHandlerFunction(cliCtx context.CLIContext, kafkaBool bool, kafkaS
 			return
 		}
 
-		msg := client.BuildRedeemFiatMsg(fromAddr, to, req.RedeemAmount)
+		msg := client.BuildRedeemFiatMsg(fromAddr, to, req.RedeemAmount, req.BankReference)
 
 		if kafkaBool == true {
 			ticketID := kafka.TicketIDGenerator("RDFI")
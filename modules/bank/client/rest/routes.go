@@ -15,6 +15,16 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, ka
 	r.HandleFunc("/defineZone", DefineZoneHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/defineOrganization", DefineOrganizationHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/defineACL", DefineACLHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/defineRole", DefineRoleHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/suspendACL", SuspendACLHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/reinstateACL", ReinstateACLHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/revokeACL", RevokeACLHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/rotateZoneAddress", RotateZoneAddressHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/rotateOrganizationAddress", RotateOrganizationAddressHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/defineSubOrganization", DefineSubOrganizationHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/setOrganizationAdmins", SetOrganizationAdminsHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/recordKYC", RecordKYCHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/setTradingLimit", SetTradingLimitHandler(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/issueAsset", IssueAssetHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/redeemAsset", RedeemAssetHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/issueFiat", IssueFiatHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
@@ -22,6 +32,13 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, ka
 	r.HandleFunc("/sendFiat", SendFiatRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/redeemFiat", RedeemFiatHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/releaseAsset", ReleaseAssetHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/clawback", ClawbackHandlerFunction(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/buyerExecuteOrder", BuyerExecuteOrderRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/sellerExecuteOrder", SellerExecuteOrderRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/sellerExecuteTranche", SellerExecuteTrancheRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/refundTrancheOrder", RefundTrancheOrderRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/sendSwapAsset", SendSwapAssetRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/executeSwap", ExecuteSwapRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/sendCoinToOrder", SendCoinToOrderRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/consolidateFiat", ConsolidateFiatRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 }
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/bank/client"
+)
+
+type SellerExecuteTrancheReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	BuyerAddress  string       `json:"buyerAddress" valid:"required~Enter the BuyerAddress,matches(^commit[a-z0-9]{39}$)~BuyerAddress is Invalid"`
+	SellerAddress string       `json:"sellerAddress" valid:"required~Enter the SellerAddress,matches(^commit[a-z0-9]{39}$)~SellerAddress is Invalid"`
+	AWBProofHash  string       `json:"awbProofHash" valid:"required~Mandatory parameter awbProofHash missing,matches(^.*$)~Invalid awbProofHash,length(1|1000)~awbProofHash length should be 1 to 1000"`
+	PegHash       string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[0-9]+$)~Invalid PegHash,length(2|40)~PegHash length between 2-40"`
+	Quantity      int64        `json:"quantity" valid:"required~Enter the Quantity,matches(^[1-9]{1}[0-9]*$)~Invalid Quantity"`
+	Password      string       `json:"password" valid:"required~Enter the Password"`
+	Mode          string       `json:"mode"`
+}
+
+func SellerExecuteTrancheRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SellerExecuteTrancheReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		sellerAddress, err := cTypes.AccAddressFromBech32(req.SellerAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryACLAccount",
+			sellerAddress), nil)
+
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query account. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		var account acl.ACLAccount
+		cliCtx.Codec.MustUnmarshalJSON(res, &account)
+
+		zoneID := account.GetZoneID()
+		if zoneID == nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+		zoneData, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryZone",
+			zoneID), nil)
+
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't parse query result. Result: %s. Error: %s", res, err.Error()))
+			return
+		}
+
+		var zoneAddress cTypes.AccAddress
+		cliCtx.Codec.MustUnmarshalJSON(zoneData, &zoneAddress)
+
+		if zoneAddress.String() != fromAddr.String() && fromAddr.String() != sellerAddress.String() {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		if !account.GetACL().SellerExecuteOrder {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		buyerAddress, err := cTypes.AccAddressFromBech32(req.BuyerAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		pegHashHex, err := types.GetAssetPegHashHex(req.PegHash)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := client.BuildSellerExecuteTrancheMsg(fromAddr, buyerAddress, sellerAddress, pegHashHex, req.AWBProofHash,
+			req.Quantity)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("SETR")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	bankTypes "github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+type SetTradingLimitReq struct {
+	BaseReq             rest.BaseReq `json:"base_req"`
+	OrganizationID      string       `json:"organizationID" valid:"matches(^[A-Fa-f0-9]+$)~Invalid OrganizationID,length(2|40)~OrganizationID length should be 2 to 40"`
+	To                  string       `json:"to" valid:"matches(^commit[a-z0-9]{39}$)~to Address is Invalid"`
+	MaxBid              int64        `json:"maxBid"`
+	MaxDailyFiatOutflow int64        `json:"maxDailyFiatOutflow"`
+	MaxOpenNegotiations int64        `json:"maxOpenNegotiations"`
+	Password            string       `json:"password" valid:"required~Enter the password"`
+	Mode                string       `json:"mode"`
+}
+
+func SetTradingLimitHandler(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetTradingLimitReq
+		cliCtx := cliCtx
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(bankTypes.DefaultCodespace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		var organizationID acl.OrganizationID
+		var to cTypes.AccAddress
+		if req.OrganizationID != "" {
+			organizationID, err = acl.GetOrganizationIDFromString(req.OrganizationID)
+		} else {
+			to, err = cTypes.AccAddressFromBech32(req.To)
+		}
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tradingLimit := acl.NewTradingLimit(req.MaxBid, req.MaxDailyFiatOutflow, req.MaxOpenNegotiations)
+		msg := bankTypes.BuildMsgSetTradingLimit(fromAddr, organizationID, to, tradingLimit)
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("STLT")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
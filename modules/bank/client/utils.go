@@ -32,9 +32,9 @@ func BuildIssueFiatMsg(from cTypes.AccAddress, to cTypes.AccAddress, fiatPeg typ
 	return msg
 }
 
-func BuildRedeemFiatMsg(from cTypes.AccAddress, to cTypes.AccAddress, amount int64) cTypes.Msg {
+func BuildRedeemFiatMsg(from cTypes.AccAddress, to cTypes.AccAddress, amount int64, bankReference string) cTypes.Msg {
 
-	redeemFiat := bankTypes.NewRedeemFiat(from, to, amount)
+	redeemFiat := bankTypes.NewRedeemFiat(from, to, amount, bankReference)
 	msg := bankTypes.NewMsgBankRedeemFiats([]bankTypes.RedeemFiat{redeemFiat})
 	return msg
 }
@@ -67,9 +67,58 @@ func BuildSellerExecuteOrderMsg(from cTypes.AccAddress, buyerAddress cTypes.AccA
 	return msg
 }
 
+func BuildSellerExecuteTrancheMsg(from cTypes.AccAddress, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, awbProofHash string, quantity int64) cTypes.Msg {
+
+	sellerExecuteTranche := bankTypes.NewSellerExecuteTranche(from, buyerAddress, sellerAddress, pegHash, awbProofHash, quantity)
+	msg := bankTypes.NewMsgBankSellerExecuteTranches([]bankTypes.SellerExecuteTranche{sellerExecuteTranche})
+	return msg
+}
+
+func BuildRefundTrancheOrderMsg(from cTypes.AccAddress, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash) cTypes.Msg {
+
+	refundTrancheOrder := bankTypes.NewRefundTrancheOrder(from, buyerAddress, sellerAddress, pegHash)
+	msg := bankTypes.NewMsgBankRefundTrancheOrders([]bankTypes.RefundTrancheOrder{refundTrancheOrder})
+	return msg
+}
+
+func BuildSendSwapAssetMsg(from cTypes.AccAddress, to cTypes.AccAddress, pegHash types.PegHash) cTypes.Msg {
+
+	sendSwapAsset := bankTypes.NewSendSwapAsset(from, to, pegHash)
+	msg := bankTypes.NewMsgBankSendSwapAssets([]bankTypes.SendSwapAsset{sendSwapAsset})
+	return msg
+}
+
+func BuildExecuteSwapMsg(from cTypes.AccAddress, delivererAddress cTypes.AccAddress, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, awbProofHash string) cTypes.Msg {
+
+	executeSwap := bankTypes.NewExecuteSwap(from, delivererAddress, buyerAddress, sellerAddress, pegHash, awbProofHash)
+	msg := bankTypes.NewMsgBankExecuteSwaps([]bankTypes.ExecuteSwap{executeSwap})
+	return msg
+}
+
+func BuildSendCoinToOrderMsg(from cTypes.AccAddress, to cTypes.AccAddress, pegHash types.PegHash, amount cTypes.Coins) cTypes.Msg {
+
+	sendCoinToOrder := bankTypes.NewSendCoinToOrder(from, to, pegHash, amount)
+	msg := bankTypes.NewMsgBankSendCoinsToOrders([]bankTypes.SendCoinToOrder{sendCoinToOrder})
+	return msg
+}
+
 func BuildReleaseAssetMsg(from cTypes.AccAddress, to cTypes.AccAddress, pegHash types.PegHash) cTypes.Msg {
 
 	releaseAsset := bankTypes.NewReleaseAsset(from, to, pegHash)
 	msg := bankTypes.NewMsgBankReleaseAssets([]bankTypes.ReleaseAsset{releaseAsset})
 	return msg
 }
+
+func BuildClawbackMsg(issuer cTypes.AccAddress, from cTypes.AccAddress, pegType string, pegHash types.PegHash, reason string) cTypes.Msg {
+
+	clawback := bankTypes.NewClawback(issuer, from, pegType, pegHash, reason)
+	msg := bankTypes.NewMsgBankClawbacks([]bankTypes.Clawback{clawback})
+	return msg
+}
+
+func BuildConsolidateFiatMsg(from cTypes.AccAddress, pegHashes []types.PegHash) cTypes.Msg {
+
+	consolidateFiat := bankTypes.NewConsolidateFiat(from, pegHashes)
+	msg := bankTypes.NewMsgBankConsolidateFiats([]bankTypes.ConsolidateFiat{consolidateFiat})
+	return msg
+}
@@ -6,25 +6,27 @@ import (
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled      bool `json:"send_enabled" yaml:"send_enabled"`
+	ClawbackGoverned bool `json:"clawback_governed" yaml:"clawback_governed"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, clawbackGoverned bool) GenesisState {
+	return GenesisState{SendEnabled: sendEnabled, ClawbackGoverned: clawbackGoverned}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(true, DefaultClawbackGoverned) }
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetClawbackGoverned(ctx, data.ClawbackGoverned)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetClawbackGoverned(ctx))
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
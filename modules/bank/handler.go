@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/commitHub/commitBlockchain/modules/acl"
 	"github.com/commitHub/commitBlockchain/modules/bank/internal/keeper"
 	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
 )
@@ -14,52 +15,104 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
-		switch msg := msg.(type) {
-		case types.MsgSend:
-			return handleMsgSend(ctx, k, msg)
+		result := handleMsg(ctx, k, msg)
+		if !result.IsOK() {
+			result.Events = acl.RejectionEvents(ctx.EventManager().Events())
+		}
+		return result
+	}
+}
 
-		case types.MsgMultiSend:
-			return handleMsgMultiSend(ctx, k, msg)
+func handleMsg(ctx sdk.Context, k keeper.Keeper, msg sdk.Msg) sdk.Result {
+	switch msg := msg.(type) {
+	case types.MsgSend:
+		return handleMsgSend(ctx, k, msg)
 
-		case types.MsgBankIssueAssets:
-			return handleMsgBankIssueAssets(ctx, k, msg)
+	case types.MsgMultiSend:
+		return handleMsgMultiSend(ctx, k, msg)
 
-		case types.MsgBankIssueFiats:
-			return handleMsgBankIssueFiats(ctx, k, msg)
+	case types.MsgBankIssueAssets:
+		return handleMsgBankIssueAssets(ctx, k, msg)
 
-		case types.MsgBankRedeemAssets:
-			return handleMMsgBankRedeemAssets(ctx, k, msg)
+	case types.MsgBankIssueFiats:
+		return handleMsgBankIssueFiats(ctx, k, msg)
 
-		case types.MsgBankRedeemFiats:
-			return handleMMsgBankRedeemFiats(ctx, k, msg)
+	case types.MsgBankRedeemAssets:
+		return handleMMsgBankRedeemAssets(ctx, k, msg)
 
-		case types.MsgBankSendAssets:
-			return handleMsgBankSendAssets(ctx, k, msg)
+	case types.MsgBankRedeemFiats:
+		return handleMMsgBankRedeemFiats(ctx, k, msg)
 
-		case types.MsgBankSendFiats:
-			return handleMsgBankSendFiats(ctx, k, msg)
+	case types.MsgBankSendAssets:
+		return handleMsgBankSendAssets(ctx, k, msg)
 
-		case types.MsgBankBuyerExecuteOrders:
-			return handleMsgBankBuyerExecuteOrders(ctx, k, msg)
+	case types.MsgBankSendFiats:
+		return handleMsgBankSendFiats(ctx, k, msg)
 
-		case types.MsgBankSellerExecuteOrders:
-			return handleMsgBankSellerExecuteOrders(ctx, k, msg)
+	case types.MsgBankBuyerExecuteOrders:
+		return handleMsgBankBuyerExecuteOrders(ctx, k, msg)
 
-		case types.MsgBankReleaseAssets:
-			return handleMsgBankReleaseAssets(ctx, k, msg)
+	case types.MsgBankSellerExecuteOrders:
+		return handleMsgBankSellerExecuteOrders(ctx, k, msg)
 
-		case types.MsgDefineZones:
-			return handleMsgDefineZones(ctx, k, msg)
+	case types.MsgBankSellerExecuteTranches:
+		return handleMsgBankSellerExecuteTranches(ctx, k, msg)
 
-		case types.MsgDefineOrganizations:
-			return handleMsgDefineOrganizations(ctx, k, msg)
+	case types.MsgBankRefundTrancheOrders:
+		return handleMsgBankRefundTrancheOrders(ctx, k, msg)
 
-		case types.MsgDefineACLs:
-			return handleMsgDefineACLs(ctx, k, msg)
-		default:
-			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
-			return sdk.ErrUnknownRequest(errMsg).Result()
-		}
+	case types.MsgBankSendSwapAssets:
+		return handleMsgBankSendSwapAssets(ctx, k, msg)
+
+	case types.MsgBankExecuteSwaps:
+		return handleMsgBankExecuteSwaps(ctx, k, msg)
+
+	case types.MsgBankSendCoinsToOrders:
+		return handleMsgBankSendCoinsToOrders(ctx, k, msg)
+	case types.MsgBankConsolidateFiats:
+		return handleMsgBankConsolidateFiats(ctx, k, msg)
+
+	case types.MsgBankReleaseAssets:
+		return handleMsgBankReleaseAssets(ctx, k, msg)
+
+	case types.MsgBankClawbacks:
+		return handleMsgBankClawbacks(ctx, k, msg)
+
+	case types.MsgDefineZones:
+		return handleMsgDefineZones(ctx, k, msg)
+
+	case types.MsgDefineOrganizations:
+		return handleMsgDefineOrganizations(ctx, k, msg)
+
+	case types.MsgDefineACLs:
+		return handleMsgDefineACLs(ctx, k, msg)
+
+	case types.MsgDefineRoles:
+		return handleMsgDefineRoles(ctx, k, msg)
+
+	case types.MsgSuspendACLs:
+		return handleMsgSuspendACLs(ctx, k, msg)
+
+	case types.MsgReinstateACLs:
+		return handleMsgReinstateACLs(ctx, k, msg)
+
+	case types.MsgRevokeACLs:
+		return handleMsgRevokeACLs(ctx, k, msg)
+	case types.MsgRotateZoneAddresses:
+		return handleMsgRotateZoneAddresses(ctx, k, msg)
+	case types.MsgRotateOrganizationAddresses:
+		return handleMsgRotateOrganizationAddresses(ctx, k, msg)
+	case types.MsgDefineSubOrganizations:
+		return handleMsgDefineSubOrganizations(ctx, k, msg)
+	case types.MsgSetOrganizationAdmins:
+		return handleMsgSetOrganizationAdmins(ctx, k, msg)
+	case types.MsgRecordKYCs:
+		return handleMsgRecordKYCs(ctx, k, msg)
+	case types.MsgSetTradingLimits:
+		return handleMsgSetTradingLimits(ctx, k, msg)
+	default:
+		errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
+		return sdk.ErrUnknownRequest(errMsg).Result()
 	}
 }
 
@@ -216,6 +269,90 @@ func handleMsgBankSellerExecuteOrders(ctx sdk.Context, k keeper.Keeper, msg type
 	}
 }
 
+func handleMsgBankSellerExecuteTranches(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankSellerExecuteTranches) sdk.Result {
+
+	for _, sellerExecuteTranche := range msg.SellerExecuteTranches {
+		err := k.SellerExecuteTranche(ctx, sellerExecuteTranche)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBankRefundTrancheOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankRefundTrancheOrders) sdk.Result {
+
+	for _, refundTrancheOrder := range msg.RefundTrancheOrders {
+		err := k.RefundTrancheOrder(ctx, refundTrancheOrder)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBankSendSwapAssets(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankSendSwapAssets) sdk.Result {
+
+	for _, sendSwapAsset := range msg.SendSwapAssets {
+		err := k.SendSwapAssetsToOrder(ctx, sendSwapAsset)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBankExecuteSwaps(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankExecuteSwaps) sdk.Result {
+
+	for _, executeSwap := range msg.ExecuteSwaps {
+		err := k.ExecuteSwap(ctx, executeSwap)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBankSendCoinsToOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankSendCoinsToOrders) sdk.Result {
+
+	for _, sendCoinToOrder := range msg.SendCoinsToOrders {
+		err := k.SendCoinsToOrders(ctx, sendCoinToOrder)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBankConsolidateFiats(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankConsolidateFiats) sdk.Result {
+
+	for _, consolidateFiat := range msg.ConsolidateFiats {
+		err := k.ConsolidateFiatsInWallets(ctx, consolidateFiat)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func handleMsgBankReleaseAssets(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankReleaseAssets) sdk.Result {
 
 	for _, releaseAsset := range msg.ReleaseAssets {
@@ -230,6 +367,20 @@ func handleMsgBankReleaseAssets(ctx sdk.Context, k keeper.Keeper, msg types.MsgB
 	}
 }
 
+func handleMsgBankClawbacks(ctx sdk.Context, k keeper.Keeper, msg types.MsgBankClawbacks) sdk.Result {
+
+	for _, clawback := range msg.Clawbacks {
+		err := k.ClawbackPegs(ctx, clawback)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func handleMsgDefineZones(ctx sdk.Context, k keeper.Keeper, msg types.MsgDefineZones) sdk.Result {
 
 	for _, defineZone := range msg.DefineZones {
@@ -256,6 +407,146 @@ func handleMsgDefineOrganizations(ctx sdk.Context, k keeper.Keeper, msg types.Ms
 	}
 }
 
+func handleMsgDefineRoles(ctx sdk.Context, k keeper.Keeper, msg types.MsgDefineRoles) sdk.Result {
+
+	for _, defineRole := range msg.DefineRoles {
+		err := k.DefineRoles(ctx, defineRole)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSuspendACLs(ctx sdk.Context, k keeper.Keeper, msg types.MsgSuspendACLs) sdk.Result {
+
+	for _, suspendACL := range msg.SuspendACLs {
+		err := k.SuspendACLs(ctx, suspendACL)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgReinstateACLs(ctx sdk.Context, k keeper.Keeper, msg types.MsgReinstateACLs) sdk.Result {
+
+	for _, reinstateACL := range msg.ReinstateACLs {
+		err := k.ReinstateACLs(ctx, reinstateACL)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRevokeACLs(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeACLs) sdk.Result {
+
+	for _, revokeACL := range msg.RevokeACLs {
+		err := k.RevokeACLs(ctx, revokeACL)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRotateZoneAddresses(ctx sdk.Context, k keeper.Keeper, msg types.MsgRotateZoneAddresses) sdk.Result {
+
+	for _, rotateZoneAddress := range msg.RotateZoneAddresses {
+		err := k.RotateZoneAddresses(ctx, rotateZoneAddress)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRotateOrganizationAddresses(ctx sdk.Context, k keeper.Keeper, msg types.MsgRotateOrganizationAddresses) sdk.Result {
+
+	for _, rotateOrganizationAddress := range msg.RotateOrganizationAddresses {
+		err := k.RotateOrganizationAddresses(ctx, rotateOrganizationAddress)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgDefineSubOrganizations(ctx sdk.Context, k keeper.Keeper, msg types.MsgDefineSubOrganizations) sdk.Result {
+
+	for _, defineSubOrganization := range msg.DefineSubOrganizations {
+		err := k.DefineSubOrganizations(ctx, defineSubOrganization)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSetOrganizationAdmins(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetOrganizationAdmins) sdk.Result {
+
+	for _, setOrganizationAdmins := range msg.SetOrganizationAdmins {
+		err := k.SetOrganizationAdmins(ctx, setOrganizationAdmins)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRecordKYCs(ctx sdk.Context, k keeper.Keeper, msg types.MsgRecordKYCs) sdk.Result {
+
+	for _, recordKYC := range msg.RecordKYCs {
+		err := k.RecordKYCs(ctx, recordKYC)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSetTradingLimits(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetTradingLimits) sdk.Result {
+
+	for _, setTradingLimit := range msg.SetTradingLimits {
+		err := k.SetTradingLimits(ctx, setTradingLimit)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func handleMsgDefineACLs(ctx sdk.Context, k keeper.Keeper, msg types.MsgDefineACLs) sdk.Result {
 
 	for _, defineACL := range msg.DefineACLs {
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// checkACLAuthority : the genesis account, or the zone or organization of the acl account, returns which of them
+// from is
+func (keeper BaseSendKeeper) checkACLAuthority(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress) (string, sdk.Error) {
+	if keeper.aclKeeper.CheckValidGenesisAddress(ctx, from) {
+		return acl.ACLAuthorityGenesis, nil
+	}
+
+	aclAccount, err := keeper.aclKeeper.GetACLAccount(ctx, to)
+	if err != nil {
+		return "", err
+	}
+	if keeper.aclKeeper.CheckValidZoneAddress(ctx, aclAccount.GetZoneID(), from) {
+		return acl.ACLAuthorityZone, nil
+	}
+	if keeper.aclKeeper.CheckValidOrganizationAddress(ctx, aclAccount.GetZoneID(), aclAccount.GetOrganizationID(), from) {
+		return acl.ACLAuthorityOrganization, nil
+	}
+	return "", sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to change the acl "+
+		"of account %v.", from.String(), to.String()))
+}
+
+func (keeper BaseSendKeeper) SuspendACLs(ctx sdk.Context, suspendACL types.SuspendACL) sdk.Error {
+	authority, err := keeper.checkACLAuthority(ctx, suspendACL.From, suspendACL.To)
+	if err != nil {
+		return err
+	}
+	return keeper.aclKeeper.SuspendACLAccount(ctx, suspendACL.From, authority, suspendACL.To, suspendACL.Reason)
+}
+
+func (keeper BaseSendKeeper) ReinstateACLs(ctx sdk.Context, reinstateACL types.ReinstateACL) sdk.Error {
+	authority, err := keeper.checkACLAuthority(ctx, reinstateACL.From, reinstateACL.To)
+	if err != nil {
+		return err
+	}
+	return keeper.aclKeeper.ReinstateACLAccount(ctx, reinstateACL.From, authority, reinstateACL.To, reinstateACL.Reason)
+}
+
+func (keeper BaseSendKeeper) RevokeACLs(ctx sdk.Context, revokeACL types.RevokeACL) sdk.Error {
+	authority, err := keeper.checkACLAuthority(ctx, revokeACL.From, revokeACL.To)
+	if err != nil {
+		return err
+	}
+	return keeper.aclKeeper.RevokeACLAccount(ctx, revokeACL.From, authority, revokeACL.To, revokeACL.Reason)
+}
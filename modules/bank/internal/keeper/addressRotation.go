@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// RotateZoneAddresses : only the current zone address can move the zone to a new address
+func (keeper BaseSendKeeper) RotateZoneAddresses(ctx sdk.Context, rotateZoneAddress types.RotateZoneAddress) sdk.Error {
+	if !keeper.aclKeeper.CheckValidZoneAddress(ctx, rotateZoneAddress.ZoneID, rotateZoneAddress.From) {
+		return sdk.ErrInternal(fmt.Sprintf("Account %v is not the zone address of zone %v.",
+			rotateZoneAddress.From.String(), rotateZoneAddress.ZoneID.String()))
+	}
+	return keeper.aclKeeper.RotateZoneAddress(ctx, rotateZoneAddress.ZoneID, rotateZoneAddress.NewAddress)
+}
+
+// RotateOrganizationAddresses : the current organization address or its zone can move the organization to a new address,
+// admins of the organization can't
+func (keeper BaseSendKeeper) RotateOrganizationAddresses(ctx sdk.Context, rotateOrganizationAddress types.RotateOrganizationAddress) sdk.Error {
+	organization, err := keeper.aclKeeper.GetOrganization(ctx, rotateOrganizationAddress.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if !organization.Address.Equals(rotateOrganizationAddress.From) &&
+		!keeper.aclKeeper.CheckValidZoneAddress(ctx, organization.ZoneID, rotateOrganizationAddress.From) {
+
+		return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to rotate the address "+
+			"of organization %v.", rotateOrganizationAddress.From.String(), rotateOrganizationAddress.OrganizationID.String()))
+	}
+	return keeper.aclKeeper.RotateOrganizationAddress(ctx, rotateOrganizationAddress.OrganizationID,
+		rotateOrganizationAddress.NewAddress)
+}
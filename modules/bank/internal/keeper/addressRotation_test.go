@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func TestRotateOrganizationAddresses(t *testing.T) {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+
+	zone, organization, admin := sdk.AccAddress([]byte("zone")), sdk.AccAddress([]byte("organization")), sdk.AccAddress([]byte("admin"))
+	zoneID, organizationID := acl.ZoneID([]byte("zone")), acl.OrganizationID([]byte("organization"))
+	require.Nil(t, input.aclKeeper.SetZoneAddress(ctx, zoneID, zone))
+	require.Nil(t, input.aclKeeper.SetOrganization(ctx, organizationID, acl.Organization{Address: organization,
+		ZoneID: zoneID, Admins: []sdk.AccAddress{admin}}))
+
+	// admins of the organization can't rotate its address
+	require.NotNil(t, sk.RotateOrganizationAddresses(ctx, types.NewRotateOrganizationAddress(admin, organizationID,
+		sdk.AccAddress([]byte("rotated")))))
+
+	rotated := sdk.AccAddress([]byte("rotated"))
+	require.Nil(t, sk.RotateOrganizationAddresses(ctx, types.NewRotateOrganizationAddress(organization, organizationID, rotated)))
+	require.Nil(t, sk.RotateOrganizationAddresses(ctx, types.NewRotateOrganizationAddress(zone, organizationID,
+		sdk.AccAddress([]byte("next")))))
+	require.NotNil(t, sk.RotateOrganizationAddresses(ctx, types.NewRotateOrganizationAddress(rotated, organizationID,
+		sdk.AccAddress([]byte("again")))))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/approvals"
+)
+
+// requestApproval : records the action as pending when the zone of the holder has an approval policy, it is executed once approved
+func requestApproval(ctx sdk.Context, keeper BaseSendKeeper, pendingAction approvals.PendingAction) (bool, sdk.Error) {
+	aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, pendingAction.HolderAddress)
+	if err != nil {
+		return false, err
+	}
+	pendingAction.ZoneID = aclAccount.GetZoneID()
+	if !keeper.approvalKeeper.RequireApproval(ctx, pendingAction.ZoneID) {
+		return false, nil
+	}
+
+	_, err = keeper.approvalKeeper.AddPendingAction(ctx, pendingAction)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExecutePendingAction : executes an issuance or redemption approved by the approval policy of its zone
+func (keeper BaseSendKeeper) ExecutePendingAction(ctx sdk.Context, pendingAction approvals.PendingAction) sdk.Error {
+	switch pendingAction.Action {
+	case approvals.ActionIssueAsset:
+		assetPeg := pendingAction.AssetPeg
+		return instantiateAndAssignAsset(ctx, pendingAction.IssuerAddress, pendingAction.HolderAddress, &assetPeg, keeper)
+	case approvals.ActionIssueFiat:
+		fiatPeg := pendingAction.FiatPeg
+		return instantiateAndAssignFiat(ctx, keeper, pendingAction.IssuerAddress, pendingAction.HolderAddress, &fiatPeg)
+	case approvals.ActionRedeemAsset:
+		return instantiateAndRedeemAsset(ctx, keeper, pendingAction.IssuerAddress, pendingAction.HolderAddress, pendingAction.PegHash)
+	case approvals.ActionRedeemFiat:
+		return instantiateAndRedeemFiat(ctx, keeper, pendingAction.IssuerAddress, pendingAction.HolderAddress, pendingAction.Amount,
+			pendingAction.BankReference)
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Pending action %s can't be executed by bank.", pendingAction.Action))
+	}
+}
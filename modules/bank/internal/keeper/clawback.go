@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// GetClawbackGoverned returns whether clawbacks are only executed by clawback proposals
+// nolint: errcheck
+func (keeper BaseSendKeeper) GetClawbackGoverned(ctx sdk.Context) bool {
+	governed := types.DefaultClawbackGoverned
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyClawbackGoverned, &governed)
+	return governed
+}
+
+// SetClawbackGoverned sets whether clawbacks are only executed by clawback proposals
+func (keeper BaseSendKeeper) SetClawbackGoverned(ctx sdk.Context, governed bool) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyClawbackGoverned, &governed)
+}
+
+// ClawbackPegs : the zone that issued a fraudulent peg claws it back, unless clawbacks are governed
+func (keeper BaseSendKeeper) ClawbackPegs(ctx sdk.Context, clawback types.Clawback) sdk.Error {
+	if keeper.GetClawbackGoverned(ctx) {
+		return types.ErrClawbackGoverned(types.DefaultCodespace)
+	}
+	return keeper.ExecuteClawback(ctx, clawback)
+}
+
+// ExecuteClawback : the zone that issued the asset peg, or every share of the fiat peg, returns it from the wallet of
+// the holder to itself and records the clawback with its reason. Only moderated asset pegs have an issuing zone. Pegs
+// escrowed in orders are not clawed back.
+func (keeper BaseSendKeeper) ExecuteClawback(ctx sdk.Context, clawback types.Clawback) sdk.Error {
+	var amount int64
+	var issuerAddress sdk.AccAddress
+	var err sdk.Error
+	switch clawback.PegType {
+	case acl.PegTypeAsset:
+		assetPeg, fromAssetPegWallet := cmTypes.SubtractAssetPegFromWallet(clawback.PegHash, getAssetWallet(ctx, keeper, clawback.FromAddress))
+		if assetPeg == nil {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Asset %v not found.", clawback.PegHash.String()))
+		}
+		if !assetPeg.GetModerated() || len(assetPeg.GetZoneID()) == 0 {
+			return sdk.ErrUnauthorized(fmt.Sprintf("Asset %v was not issued by a zone.", clawback.PegHash.String()))
+		}
+		issuerAddress, err = checkIssuingZone(ctx, keeper, clawback, acl.ZoneID(assetPeg.GetZoneID()))
+		if err != nil {
+			return err
+		}
+		issuerAssetPegWallet := cmTypes.AddAssetPegToWallet(assetPeg, getAssetWallet(ctx, keeper, issuerAddress))
+		if err := setAssetWallet(ctx, keeper, clawback.FromAddress, fromAssetPegWallet); err != nil {
+			return err
+		}
+		if err := setAssetWallet(ctx, keeper, issuerAddress, issuerAssetPegWallet); err != nil {
+			return err
+		}
+	case acl.PegTypeFiat:
+		var clawedFiatPegWallet, fromFiatPegWallet cmTypes.FiatPegWallet
+		for _, fiatPeg := range getFiatWallet(ctx, keeper, clawback.FromAddress) {
+			if fiatPeg.PegHash.String() == clawback.PegHash.String() {
+				clawedFiatPegWallet = append(clawedFiatPegWallet, fiatPeg)
+				amount += fiatPeg.TransactionAmount
+			} else {
+				fromFiatPegWallet = append(fromFiatPegWallet, fiatPeg)
+			}
+		}
+		if len(clawedFiatPegWallet) == 0 {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Fiat %v not found.", clawback.PegHash.String()))
+		}
+		issuerAddress, err = checkIssuingZone(ctx, keeper, clawback, acl.ZoneID(clawedFiatPegWallet[0].ZoneID))
+		if err != nil {
+			return err
+		}
+		issuerFiatPegWallet := cmTypes.AddFiatPegToWallet(getFiatWallet(ctx, keeper, issuerAddress), clawedFiatPegWallet)
+		if err := setFiatWallet(ctx, keeper, clawback.FromAddress, fromFiatPegWallet); err != nil {
+			return err
+		}
+		if err := setFiatWallet(ctx, keeper, issuerAddress, issuerFiatPegWallet); err != nil {
+			return err
+		}
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Peg type %s can't be clawed back.", clawback.PegType))
+	}
+
+	if err := keeper.aclKeeper.RecordClawback(ctx, clawback.FromAddress, issuerAddress, clawback.PegType,
+		clawback.PegHash, amount, clawback.Reason); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClawback,
+		sdk.NewAttribute("holder", clawback.FromAddress.String()),
+		sdk.NewAttribute("issuer", issuerAddress.String()),
+		sdk.NewAttribute(acl.AttributeKeyPegType, clawback.PegType),
+		sdk.NewAttribute(acl.AttributeKeyPegHash, clawback.PegHash.String()),
+		sdk.NewAttribute("amount", strconv.FormatInt(amount, 10)),
+		sdk.NewAttribute(acl.AttributeKeyReason, clawback.Reason),
+	))
+	return nil
+}
+
+// checkIssuingZone : the address of the zone that issued the clawed back peg, failing unless that zone signed the clawback
+func checkIssuingZone(ctx sdk.Context, keeper BaseSendKeeper, clawback types.Clawback, zoneID acl.ZoneID) (sdk.AccAddress, sdk.Error) {
+	zoneAddress, err := keeper.aclKeeper.GetZoneAddress(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if !zoneAddress.Equals(clawback.IssuerAddress) {
+		return nil, sdk.ErrUnauthorized(fmt.Sprintf("Only the zone that issued %v claws it back.", clawback.PegHash.String()))
+	}
+	return zoneAddress, nil
+}
+
+// splitFrozenFiats : the fiat pegs of the wallet of the address that can leave it, and the frozen ones that stay
+func splitFrozenFiats(ctx sdk.Context, keeper BaseSendKeeper, address sdk.AccAddress, fiatPegWallet cmTypes.FiatPegWallet) (
+	availableFiatPegWallet cmTypes.FiatPegWallet, frozenFiatPegWallet cmTypes.FiatPegWallet) {
+
+	for _, fiatPeg := range fiatPegWallet {
+		if _, found := keeper.aclKeeper.GetFreeze(ctx, address, acl.PegTypeFiat, fiatPeg.PegHash); found {
+			frozenFiatPegWallet = append(frozenFiatPegWallet, fiatPeg)
+		} else {
+			availableFiatPegWallet = append(availableFiatPegWallet, fiatPeg)
+		}
+	}
+	return
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+type clawbackInput struct {
+	testInput
+	sk         BaseSendKeeper
+	holder     sdk.AccAddress
+	holderZone sdk.AccAddress
+	issuerZone sdk.AccAddress
+}
+
+// setupClawbackInput : a holder of a zone with a fiat peg issued by another zone, and a fiat peg of its own zone
+func setupClawbackInput(t *testing.T) clawbackInput {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+
+	holder, holderZone, issuerZone := sdk.AccAddress([]byte("holder")), sdk.AccAddress([]byte("holderZone")), sdk.AccAddress([]byte("issuerZone"))
+	require.Nil(t, input.aclKeeper.SetZoneAddress(ctx, acl.ZoneID([]byte("holder")), holderZone))
+	require.Nil(t, input.aclKeeper.SetZoneAddress(ctx, acl.ZoneID([]byte("issuer")), issuerZone))
+	require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{Address: holder, ZoneID: acl.ZoneID([]byte("holder")),
+		ACL: acl.ACL{RedeemFiat: true}, Status: acl.ACLStatusActive}))
+
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, holder))
+	require.Nil(t, setFiatWallet(ctx, sk, holder, cmTypes.FiatPegWallet{
+		{PegHash: cmTypes.PegHash([]byte("fiat")), TransactionID: "FIAT", TransactionAmount: 100, ZoneID: []byte("issuer"), Currency: "usd"},
+		{PegHash: cmTypes.PegHash([]byte("other")), TransactionID: "OTHER", TransactionAmount: 50, ZoneID: []byte("holder"), Currency: "usd"},
+	}))
+	return clawbackInput{input, sk, holder, holderZone, issuerZone}
+}
+
+func TestClawbackReturnsFiatsToTheIssuingZone(t *testing.T) {
+	input := setupClawbackInput(t)
+	ctx := input.ctx
+
+	// the zone of the holder did not issue the fiat
+	require.NotNil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.holderZone, input.holder, acl.PegTypeFiat,
+		cmTypes.PegHash([]byte("fiat")), "fraud")))
+
+	require.Nil(t, input.aclKeeper.FreezePeg(ctx, input.holder, acl.PegTypeFiat, cmTypes.PegHash([]byte("fiat")), "dispute"))
+	require.Nil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.issuerZone, input.holder, acl.PegTypeFiat,
+		cmTypes.PegHash([]byte("fiat")), "fraud")))
+
+	require.Equal(t, int64(50), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.holder)))
+	require.Equal(t, int64(100), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.issuerZone)))
+	require.Empty(t, getFiatWallet(ctx, input.sk, input.holderZone))
+	require.Empty(t, input.aclKeeper.GetFreezes(ctx, input.holder))
+
+	// only the issuing zone claws back, the fiat of the zone of the holder stays out of reach of other zones
+	require.NotNil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.issuerZone, input.holder, acl.PegTypeFiat,
+		cmTypes.PegHash([]byte("other")), "fraud")))
+	require.Nil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.holderZone, input.holder, acl.PegTypeFiat,
+		cmTypes.PegHash([]byte("other")), "fraud")))
+	require.Equal(t, int64(50), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.holderZone)))
+}
+
+func TestClawbackReturnsModeratedAssetsToTheIssuingZone(t *testing.T) {
+	input := setupClawbackInput(t)
+	ctx := input.ctx
+
+	// the issuing zone issues to an account of its own, which trades the asset on to the holder
+	trader := sdk.AccAddress([]byte("trader"))
+	require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{Address: trader, ZoneID: acl.ZoneID([]byte("issuer")),
+		Status: acl.ACLStatusActive}))
+	assetPeg := cmTypes.BaseAssetPeg{AssetType: "sugar", AssetQuantity: 1, AssetPrice: 1, QuantityUnit: "kg", Moderated: true}
+	require.Nil(t, instantiateAndAssignAsset(ctx, input.issuerZone, trader, &assetPeg, input.sk))
+	require.Equal(t, acl.ZoneID([]byte("issuer")).String(), assetPeg.GetZoneID().String())
+	require.Nil(t, setAssetWallet(ctx, input.sk, input.holder, getAssetWallet(ctx, input.sk, trader)))
+	require.Nil(t, setAssetWallet(ctx, input.sk, trader, cmTypes.AssetPegWallet{}))
+
+	require.NotNil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.holderZone, input.holder, acl.PegTypeAsset,
+		assetPeg.GetPegHash(), "fraud")))
+	require.Nil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.issuerZone, input.holder, acl.PegTypeAsset,
+		assetPeg.GetPegHash(), "fraud")))
+
+	require.Empty(t, getAssetWallet(ctx, input.sk, input.holder))
+	require.Empty(t, getAssetWallet(ctx, input.sk, input.holderZone))
+	issuerAssetPegWallet := getAssetWallet(ctx, input.sk, input.issuerZone)
+	require.Len(t, issuerAssetPegWallet, 1)
+	require.Equal(t, assetPeg.GetPegHash(), issuerAssetPegWallet[0].GetPegHash())
+}
+
+func TestClawbackOfAnUnmoderatedAsset(t *testing.T) {
+	input := setupClawbackInput(t)
+	ctx := input.ctx
+
+	require.Nil(t, setAssetWallet(ctx, input.sk, input.holder, cmTypes.AssetPegWallet{
+		{PegHash: cmTypes.PegHash([]byte("asset")), AssetType: "sugar", AssetQuantity: 1, AssetPrice: 1, QuantityUnit: "kg"},
+	}))
+
+	// asset pegs not issued by a zone have no issuer to return to
+	require.NotNil(t, input.sk.ExecuteClawback(ctx, types.NewClawback(input.holderZone, input.holder, acl.PegTypeAsset,
+		cmTypes.PegHash([]byte("asset")), "fraud")))
+	require.Len(t, getAssetWallet(ctx, input.sk, input.holder), 1)
+}
+
+func TestFrozenFiatsAreNotRedeemed(t *testing.T) {
+	input := setupClawbackInput(t)
+	ctx := input.ctx
+
+	require.Nil(t, input.aclKeeper.FreezePeg(ctx, input.holder, acl.PegTypeFiat, cmTypes.PegHash([]byte("fiat")), "dispute"))
+	require.NotNil(t, instantiateAndRedeemFiat(ctx, input.sk, input.holderZone, input.holder, 100, "reference"))
+	require.Nil(t, instantiateAndRedeemFiat(ctx, input.sk, input.holderZone, input.holder, 50, "reference"))
+
+	fiatPegWallet := getFiatWallet(ctx, input.sk, input.holder)
+	require.Len(t, fiatPegWallet, 1)
+	require.Equal(t, cmTypes.PegHash([]byte("fiat")), fiatPegWallet[0].PegHash)
+
+	// a frozen wallet redeems nothing
+	require.Nil(t, input.aclKeeper.FreezePeg(ctx, input.holder, "", nil, "audit"))
+	require.NotNil(t, instantiateAndRedeemFiat(ctx, input.sk, input.holderZone, input.holder, 10, "reference"))
+}
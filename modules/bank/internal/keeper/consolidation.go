@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// ConsolidateFiatsInWallets : merges fiat pegs of a wallet issued by the same zone in the same currency into one fiat peg,
+// keeping the merged peg hashes on it. Frozen fiat pegs are left as they are, and a frozen wallet consolidates nothing.
+func (keeper BaseSendKeeper) ConsolidateFiatsInWallets(ctx sdk.Context, consolidateFiat types.ConsolidateFiat) sdk.Error {
+	_acl, err := keeper.aclKeeper.GetAccountACLDetails(ctx, consolidateFiat.FromAddress)
+	if err != nil {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+	if !_acl.GetACL().SendFiat {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+
+	if err := keeper.aclKeeper.CheckFrozen(ctx, consolidateFiat.FromAddress, acl.PegTypeFiat, consolidateFiat.PegHashes...); err != nil {
+		return err
+	}
+
+	fiatPegWallet, frozenFiatPegWallet := splitFrozenFiats(ctx, keeper, consolidateFiat.FromAddress,
+		getFiatWallet(ctx, keeper, consolidateFiat.FromAddress))
+	consolidatedFiatPegWallet, fiatPegWallet := cmTypes.ConsolidateFiatPegWallet(consolidateFiat.PegHashes, fiatPegWallet)
+	if len(consolidatedFiatPegWallet) == 0 {
+		return sdk.ErrInsufficientCoins("Not enough fiat pegs of the same zone and currency to consolidate.")
+	}
+	fiatPegWallet = cmTypes.AddFiatPegToWallet(fiatPegWallet, frozenFiatPegWallet)
+
+	err = setFiatWallet(ctx, keeper, consolidateFiat.FromAddress, fiatPegWallet)
+	if err != nil {
+		return err
+	}
+
+	for _, fiatPeg := range consolidatedFiatPegWallet {
+		var mergedPegHashes []string
+		for _, mergedPegHash := range fiatPeg.GetMergedPegHashes() {
+			mergedPegHashes = append(mergedPegHashes, mergedPegHash.String())
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeConsolidateFiat,
+			sdk.NewAttribute("sender", consolidateFiat.FromAddress.String()),
+			sdk.NewAttribute("pegHash", fiatPeg.GetPegHash().String()),
+			sdk.NewAttribute("mergedPegHashes", strings.Join(mergedPegHashes, ",")),
+		))
+	}
+
+	return nil
+}
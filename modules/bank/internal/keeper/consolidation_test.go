@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+func TestConsolidateFiatsPerZoneAndCurrency(t *testing.T) {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+
+	holder := sdk.AccAddress([]byte("holder"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, holder))
+	require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{
+		Address: holder,
+		ZoneID:  acl.ZoneID([]byte("zone")),
+		ACL:     acl.ACL{SendFiat: true},
+		Status:  acl.ACLStatusActive,
+	}))
+
+	fiatPeg := func(pegHash string, zoneID string, currency string, amount int64) cmTypes.BaseFiatPeg {
+		return cmTypes.BaseFiatPeg{PegHash: cmTypes.PegHash([]byte(pegHash)), TransactionID: pegHash,
+			TransactionAmount: amount, ZoneID: []byte(zoneID), Currency: currency}
+	}
+	require.Nil(t, setFiatWallet(ctx, sk, holder, cmTypes.FiatPegWallet{
+		fiatPeg("a", "zone", "usd", 10),
+		fiatPeg("b", "zone", "usd", 20),
+		fiatPeg("c", "zone", "eur", 30),
+		fiatPeg("d", "other", "usd", 40),
+	}))
+
+	// pegs of different currencies are not merged
+	require.NotNil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder,
+		[]cmTypes.PegHash{cmTypes.PegHash([]byte("a")), cmTypes.PegHash([]byte("c"))})))
+
+	require.Nil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder, nil)))
+
+	fiatPegWallet := getFiatWallet(ctx, sk, holder)
+	require.Len(t, fiatPegWallet, 3)
+	require.Equal(t, int64(100), cmTypes.GetFiatPegWalletBalance(fiatPegWallet))
+	for _, fiatPeg := range fiatPegWallet {
+		if fiatPeg.PegHash.String() == cmTypes.PegHash([]byte("a")).String() {
+			require.Equal(t, int64(30), fiatPeg.TransactionAmount)
+			require.Equal(t, []cmTypes.PegHash{cmTypes.PegHash([]byte("b"))}, fiatPeg.MergedPegHashes)
+			require.Equal(t, "usd", fiatPeg.Currency)
+		}
+	}
+
+	// nothing is left to merge
+	require.NotNil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder, nil)))
+}
+
+func TestConsolidateFiatsLeavesFrozenPegs(t *testing.T) {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+
+	holder := sdk.AccAddress([]byte("holder"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, holder))
+	require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{
+		Address: holder,
+		ZoneID:  acl.ZoneID([]byte("zone")),
+		ACL:     acl.ACL{SendFiat: true},
+		Status:  acl.ACLStatusActive,
+	}))
+
+	fiatPeg := func(pegHash string, amount int64) cmTypes.BaseFiatPeg {
+		return cmTypes.BaseFiatPeg{PegHash: cmTypes.PegHash([]byte(pegHash)), TransactionID: pegHash,
+			TransactionAmount: amount, ZoneID: []byte("zone"), Currency: "usd"}
+	}
+	require.Nil(t, setFiatWallet(ctx, sk, holder, cmTypes.FiatPegWallet{fiatPeg("a", 10), fiatPeg("b", 20), fiatPeg("c", 30)}))
+	require.Nil(t, input.aclKeeper.FreezePeg(ctx, holder, acl.PegTypeFiat, cmTypes.PegHash([]byte("b")), "dispute"))
+
+	// a frozen peg can't be named for consolidation
+	require.NotNil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder,
+		[]cmTypes.PegHash{cmTypes.PegHash([]byte("a")), cmTypes.PegHash([]byte("b"))})))
+
+	require.Nil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder, nil)))
+
+	fiatPegWallet := getFiatWallet(ctx, sk, holder)
+	require.Len(t, fiatPegWallet, 2)
+	require.Equal(t, int64(60), cmTypes.GetFiatPegWalletBalance(fiatPegWallet))
+	for _, fiatPeg := range fiatPegWallet {
+		switch fiatPeg.PegHash.String() {
+		case cmTypes.PegHash([]byte("a")).String():
+			require.Equal(t, int64(40), fiatPeg.TransactionAmount)
+			require.Equal(t, []cmTypes.PegHash{cmTypes.PegHash([]byte("c"))}, fiatPeg.MergedPegHashes)
+		case cmTypes.PegHash([]byte("b")).String():
+			require.Equal(t, int64(20), fiatPeg.TransactionAmount)
+			require.Empty(t, fiatPeg.MergedPegHashes)
+		default:
+			t.Fatalf("unexpected fiat peg %v", fiatPeg.PegHash.String())
+		}
+	}
+
+	// a frozen wallet consolidates nothing
+	require.Nil(t, setFiatWallet(ctx, sk, holder, cmTypes.FiatPegWallet{fiatPeg("d", 10), fiatPeg("e", 20)}))
+	require.Nil(t, input.aclKeeper.FreezePeg(ctx, holder, "", nil, "audit"))
+	require.NotNil(t, sk.ConsolidateFiatsInWallets(ctx, types.NewConsolidateFiat(holder, nil)))
+	require.Len(t, getFiatWallet(ctx, sk, holder), 2)
+}
@@ -2,16 +2,26 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
 	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	cmTypes "github.com/commitHub/commitBlockchain/types"
 )
 
 // register bank invariants
-func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper) {
+func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper, rk types.ReserveKeeper,
+	escrowKeepers []types.PegEscrowKeeper) {
+
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
 		NonnegativeBalanceInvariant(ak))
+	ir.RegisterRoute(types.ModuleName, "fiat-conservation",
+		FiatConservationInvariant(ak, rk, escrowKeepers))
+	ir.RegisterRoute(types.ModuleName, "unique-asset-pegs",
+		UniqueAssetPegInvariant(ak, escrowKeepers))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -36,3 +46,74 @@ func NonnegativeBalanceInvariant(ak types.AccountKeeper) sdk.Invariant {
 			fmt.Sprintf("amount of negative accounts found %d\n%s", count, msg), broken)
 	}
 }
+
+// FiatConservationInvariant checks that the fiat issued minus redeemed by all zones is held in wallets and escrows,
+// per currency
+func FiatConservationInvariant(ak types.AccountKeeper, rk types.ReserveKeeper, escrowKeepers []types.PegEscrowKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		held := sdk.Coins{}
+
+		ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+			held = held.Add(cmTypes.GetFiatPegWalletCoins(acc.GetFiatPegWallet()))
+			return false
+		})
+		for _, escrowKeeper := range escrowKeepers {
+			held = held.Add(cmTypes.GetFiatPegWalletCoins(escrowKeeper.GetEscrowedFiatPegWallet(ctx)))
+		}
+
+		outstanding := rk.GetTotalOutstandingFiat(ctx)
+		difference, _ := outstanding.SafeSub(held)
+		broken := !difference.Empty()
+
+		return sdk.FormatInvariant(types.ModuleName, "fiat-conservation",
+			fmt.Sprintf("\tfiat issued minus redeemed: %s\n\tfiat held in wallets and escrows: %s\n",
+				outstanding.String(), held.String()), broken)
+	}
+}
+
+// UniqueAssetPegInvariant checks that every asset peg is held by exactly one wallet or escrow, and that every asset peg
+// hash handed out at issuance is still held. Asset pegs are never burned or merged, redeemed ones go back to the issuer.
+func UniqueAssetPegInvariant(ak types.AccountKeeper, escrowKeepers []types.PegEscrowKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		holders := make(map[string][]string)
+		addHolder := func(assetPegWallet cmTypes.AssetPegWallet, holder string) {
+			for _, assetPeg := range assetPegWallet {
+				holders[assetPeg.GetPegHash().String()] = append(holders[assetPeg.GetPegHash().String()], holder)
+			}
+		}
+
+		ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+			addHolder(acc.GetAssetPegWallet(), acc.GetAddress().String())
+			return false
+		})
+		for i, escrowKeeper := range escrowKeepers {
+			addHolder(escrowKeeper.GetEscrowedAssetPegWallet(ctx), fmt.Sprintf("escrow %d", i))
+		}
+
+		var pegHashes []string
+		for pegHash, pegHolders := range holders {
+			if len(pegHolders) > 1 {
+				pegHashes = append(pegHashes, pegHash)
+			}
+		}
+		sort.Strings(pegHashes)
+
+		var msg string
+		for _, pegHash := range pegHashes {
+			msg += fmt.Sprintf("\tasset peg %s is held by %v\n", pegHash, holders[pegHash])
+		}
+
+		var unheld int
+		for i := 0; i < ak.GetAssetPegHashCount(ctx); i++ {
+			pegHash := cmTypes.PegHash(strconv.Itoa(i))
+			if _, found := holders[pegHash.String()]; !found {
+				unheld++
+				msg += fmt.Sprintf("\tasset peg %s is not held\n", pegHash.String())
+			}
+		}
+		broken := len(pegHashes) != 0 || unheld != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "unique-asset-pegs",
+			fmt.Sprintf("amount of asset pegs held more than once %d, not held %d\n%s", len(pegHashes), unheld, msg), broken)
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+func TestUniqueAssetPegInvariant(t *testing.T) {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+	invariant := UniqueAssetPegInvariant(input.ak, nil)
+
+	issuer, holder := sdk.AccAddress([]byte("issuer")), sdk.AccAddress([]byte("holder"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, holder))
+	for i := 0; i < 2; i++ {
+		assetPeg := cmTypes.NewBaseAssetPegWithPegHash(nil)
+		require.Nil(t, instantiateAndAssignAsset(ctx, issuer, holder, &assetPeg, sk))
+	}
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// an issued asset peg that left every wallet
+	assetPegWallet := getAssetWallet(ctx, sk, holder)
+	require.Nil(t, setAssetWallet(ctx, sk, holder, assetPegWallet[1:]))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "not held 1")
+
+	// an asset peg held twice
+	require.Nil(t, setAssetWallet(ctx, sk, holder, assetPegWallet))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, issuer))
+	require.Nil(t, setAssetWallet(ctx, sk, issuer, assetPegWallet[:1]))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "held more than once 1")
+}
@@ -11,10 +11,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/approvals"
 	"github.com/commitHub/commitBlockchain/modules/auth/exported"
 	"github.com/commitHub/commitBlockchain/modules/negotiation"
 	"github.com/commitHub/commitBlockchain/modules/orders"
+	"github.com/commitHub/commitBlockchain/modules/payouts"
 	"github.com/commitHub/commitBlockchain/modules/reputation"
+	"github.com/commitHub/commitBlockchain/modules/reserves"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
 
 	"github.com/commitHub/commitBlockchain/modules/params"
 
@@ -44,16 +48,26 @@ type BaseKeeper struct {
 
 // NewBaseKeeper returns a new BaseKeeper
 func NewBaseKeeper(ak types.AccountKeeper, nk negotiation.Keeper, aclK acl.Keeper, orderKeeper orders.Keeper,
-	rk reputation.Keeper, paramSpace params.Subspace, codespace sdk.CodespaceType) BaseKeeper {
+	rk reputation.Keeper, payoutKeeper payouts.Keeper, approvalKeeper approvals.Keeper,
+	reserveKeeper reserves.Keeper, tradeFeeKeeper tradeFees.Keeper, paramSpace params.Subspace, codespace sdk.CodespaceType) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, nk, aclK, orderKeeper, rk, ps, codespace),
+		BaseSendKeeper: NewBaseSendKeeper(ak, nk, aclK, orderKeeper, rk, payoutKeeper, approvalKeeper, reserveKeeper, tradeFeeKeeper, ps, codespace),
 		ak:             ak,
 		paramSpace:     ps,
 	}
 }
 
+// SetSupplyKeeper : the supply keeper depends on the bank keeper, so it is set once both are created
+func (keeper *BaseKeeper) SetSupplyKeeper(supplyKeeper types.SupplyKeeper) *BaseKeeper {
+	if keeper.supplyKeeper != nil {
+		panic("cannot set bank supply keeper twice")
+	}
+	keeper.supplyKeeper = supplyKeeper
+	return keeper
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins.
@@ -156,6 +170,9 @@ type SendKeeper interface {
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
 
+	GetClawbackGoverned(ctx sdk.Context) bool
+	SetClawbackGoverned(ctx sdk.Context, governed bool)
+
 	IssueAssetsToWallets(ctx sdk.Context, issueAsset types.IssueAsset) sdk.Error
 	IssueFiatsToWallets(ctx sdk.Context, issueFiat types.IssueFiat) sdk.Error
 
@@ -168,10 +185,35 @@ type SendKeeper interface {
 	BuyerExecuteTradeOrder(ctx sdk.Context, buyerExecuteOrder types.BuyerExecuteOrder) (sdk.Error, []cmTypes.FiatPegWallet)
 	SellerExecuteTradeOrder(ctx sdk.Context, sellerExecuteOrder types.SellerExecuteOrder) (sdk.Error, []cmTypes.AssetPegWallet)
 
+	SellerExecuteTranche(ctx sdk.Context, sellerExecuteTranche types.SellerExecuteTranche) sdk.Error
+	RefundTrancheOrder(ctx sdk.Context, refundTrancheOrder types.RefundTrancheOrder) sdk.Error
+
+	SendSwapAssetsToOrder(ctx sdk.Context, sendSwapAsset types.SendSwapAsset) sdk.Error
+	ExecuteSwap(ctx sdk.Context, executeSwap types.ExecuteSwap) sdk.Error
+
+	SendCoinsToOrders(ctx sdk.Context, sendCoinToOrder types.SendCoinToOrder) sdk.Error
+
+	ConsolidateFiatsInWallets(ctx sdk.Context, consolidateFiat types.ConsolidateFiat) sdk.Error
+
+	ExecutePendingAction(ctx sdk.Context, pendingAction approvals.PendingAction) sdk.Error
+
 	ReleaseLockedAssets(ctx sdk.Context, releaseAsset types.ReleaseAsset) sdk.Error
 	DefineZones(ctx sdk.Context, defineZone types.DefineZone) sdk.Error
 	DefineOrganizations(ctx sdk.Context, defineOrganization types.DefineOrganization) sdk.Error
 	DefineACLs(ctx sdk.Context, defineACL types.DefineACL) sdk.Error
+	DefineRoles(ctx sdk.Context, defineRole types.DefineRole) sdk.Error
+	SuspendACLs(ctx sdk.Context, suspendACL types.SuspendACL) sdk.Error
+	ReinstateACLs(ctx sdk.Context, reinstateACL types.ReinstateACL) sdk.Error
+	RevokeACLs(ctx sdk.Context, revokeACL types.RevokeACL) sdk.Error
+	RotateZoneAddresses(ctx sdk.Context, rotateZoneAddress types.RotateZoneAddress) sdk.Error
+	RotateOrganizationAddresses(ctx sdk.Context, rotateOrganizationAddress types.RotateOrganizationAddress) sdk.Error
+	DefineSubOrganizations(ctx sdk.Context, defineSubOrganization types.DefineSubOrganization) sdk.Error
+	SetOrganizationAdmins(ctx sdk.Context, setOrganizationAdmins types.SetOrganizationAdmins) sdk.Error
+	RecordKYCs(ctx sdk.Context, recordKYC types.RecordKYC) sdk.Error
+	SetTradingLimits(ctx sdk.Context, setTradingLimit types.SetTradingLimit) sdk.Error
+
+	ClawbackPegs(ctx sdk.Context, clawback types.Clawback) sdk.Error
+	ExecuteClawback(ctx sdk.Context, clawback types.Clawback) sdk.Error
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -186,12 +228,18 @@ type BaseSendKeeper struct {
 	aclKeeper        acl.Keeper
 	orderKeeper      orders.Keeper
 	reputationKeeper reputation.Keeper
+	payoutKeeper     payouts.Keeper
+	approvalKeeper   approvals.Keeper
+	reserveKeeper    reserves.Keeper
+	tradeFeeKeeper   tradeFees.Keeper
+	supplyKeeper     types.SupplyKeeper
 	paramSpace       params.Subspace
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
 func NewBaseSendKeeper(ak types.AccountKeeper, nk negotiation.Keeper, aclK acl.Keeper, orderKeeper orders.Keeper,
-	rk reputation.Keeper, paramSpace params.Subspace, codespace sdk.CodespaceType) BaseSendKeeper {
+	rk reputation.Keeper, payoutKeeper payouts.Keeper, approvalKeeper approvals.Keeper,
+	reserveKeeper reserves.Keeper, tradeFeeKeeper tradeFees.Keeper, paramSpace params.Subspace, codespace sdk.CodespaceType) BaseSendKeeper {
 
 	return BaseSendKeeper{
 		BaseViewKeeper:   NewBaseViewKeeper(ak, codespace),
@@ -200,6 +248,10 @@ func NewBaseSendKeeper(ak types.AccountKeeper, nk negotiation.Keeper, aclK acl.K
 		nk:               nk,
 		orderKeeper:      orderKeeper,
 		reputationKeeper: rk,
+		payoutKeeper:     payoutKeeper,
+		approvalKeeper:   approvalKeeper,
+		reserveKeeper:    reserveKeeper,
+		tradeFeeKeeper:   tradeFeeKeeper,
 		aclKeeper:        aclK,
 	}
 }
@@ -484,6 +536,18 @@ func (keeper BaseSendKeeper) IssueAssetsToWallets(ctx sdk.Context, issueAsset ty
 	if !_acl.IssueAsset {
 		return sdk.ErrInternal(fmt.Sprintf("Assets cant be issued to account %v.", issueAsset.ToAddress.String()))
 	}
+	if moderated {
+		approvalRequested, err := requestApproval(ctx, keeper, approvals.PendingAction{
+			Action:        approvals.ActionIssueAsset,
+			MakerAddress:  issueAsset.IssuerAddress,
+			IssuerAddress: issueAsset.IssuerAddress,
+			HolderAddress: issueAsset.ToAddress,
+			AssetPeg:      cmTypes.ToBaseAssetPeg(issueAsset.AssetPeg),
+		})
+		if err != nil || approvalRequested {
+			return err
+		}
+	}
 	err = instantiateAndAssignAsset(ctx, issueAsset.IssuerAddress, issueAsset.ToAddress, issueAsset.AssetPeg, keeper)
 	if err != nil {
 		return nil
@@ -494,6 +558,14 @@ func (keeper BaseSendKeeper) IssueAssetsToWallets(ctx sdk.Context, issueAsset ty
 }
 
 func instantiateAndAssignAsset(ctx sdk.Context, issuerAddress sdk.AccAddress, toAddress sdk.AccAddress, assetPeg cmTypes.AssetPeg, keeper BaseSendKeeper) sdk.Error {
+	if assetPeg.GetModerated() {
+		aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, toAddress)
+		if err != nil {
+			return err
+		}
+		_ = assetPeg.SetZoneID(aclAccount.GetZoneID())
+	}
+
 	pegHash, _ := cmTypes.GetAssetPegHashHex(fmt.Sprintf("%x", strconv.Itoa(keeper.ak.GetNextAssetPegHash(ctx))))
 	_ = assetPeg.SetPegHash(pegHash)
 	_ = assetPeg.SetLocked(assetPeg.GetModerated())
@@ -520,6 +592,16 @@ func (keeper BaseSendKeeper) IssueFiatsToWallets(ctx sdk.Context, issueFiat type
 	if !_acl.IssueFiat {
 		return sdk.ErrInternal(fmt.Sprintf("Fiats can't be issued to account %v.", issueFiat.ToAddress.String()))
 	}
+	approvalRequested, err := requestApproval(ctx, keeper, approvals.PendingAction{
+		Action:        approvals.ActionIssueFiat,
+		MakerAddress:  issueFiat.IssuerAddress,
+		IssuerAddress: issueFiat.IssuerAddress,
+		HolderAddress: issueFiat.ToAddress,
+		FiatPeg:       cmTypes.ToBaseFiatPeg(issueFiat.FiatPeg),
+	})
+	if err != nil || approvalRequested {
+		return err
+	}
 
 	err = instantiateAndAssignFiat(ctx, keeper, issueFiat.IssuerAddress, issueFiat.ToAddress, issueFiat.FiatPeg)
 	if err != nil {
@@ -531,8 +613,18 @@ func (keeper BaseSendKeeper) IssueFiatsToWallets(ctx sdk.Context, issueFiat type
 func instantiateAndAssignFiat(ctx sdk.Context, keeper BaseSendKeeper, issuerAddress sdk.AccAddress,
 	toAddress sdk.AccAddress, fiatPeg cmTypes.FiatPeg) sdk.Error {
 
+	aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, toAddress)
+	if err != nil {
+		return err
+	}
+	err = keeper.reserveKeeper.AddIssuedFiat(ctx, aclAccount.GetZoneID(), sdk.NewInt64Coin(fiatPeg.GetCurrency(), fiatPeg.GetTransactionAmount()))
+	if err != nil {
+		return err
+	}
+
 	pegHash, _ := cmTypes.GetFiatPegHashHex(fmt.Sprintf("%x", strconv.Itoa(keeper.ak.GetNextFiatPegHash(ctx))))
 	_ = fiatPeg.SetPegHash(pegHash)
+	_ = fiatPeg.SetZoneID(aclAccount.GetZoneID())
 	receiverFiatPegWallet := getFiatWallet(ctx, keeper, toAddress)
 	receiverFiatPegWallet = cmTypes.AddFiatPegToWallet(receiverFiatPegWallet, []cmTypes.BaseFiatPeg{cmTypes.ToBaseFiatPeg(fiatPeg)})
 
@@ -556,6 +648,16 @@ func (keeper BaseSendKeeper) RedeemAssetsFromWallets(ctx sdk.Context, redeemAsse
 	if !_acl.RedeemAsset {
 		return sdk.ErrInternal(fmt.Sprintf("Assets can't be redeemed from account %v.", redeemAsset.RedeemerAddress.String()))
 	}
+	approvalRequested, err := requestApproval(ctx, keeper, approvals.PendingAction{
+		Action:        approvals.ActionRedeemAsset,
+		MakerAddress:  redeemAsset.RedeemerAddress,
+		IssuerAddress: redeemAsset.IssuerAddress,
+		HolderAddress: redeemAsset.RedeemerAddress,
+		PegHash:       redeemAsset.PegHash,
+	})
+	if err != nil || approvalRequested {
+		return err
+	}
 	err = instantiateAndRedeemAsset(ctx, keeper, redeemAsset.IssuerAddress, redeemAsset.RedeemerAddress, redeemAsset.PegHash)
 	if err != nil {
 		return err
@@ -577,6 +679,9 @@ func instantiateAndRedeemAsset(ctx sdk.Context, keeper BaseSendKeeper, issuerAdd
 	if i == length {
 		return sdk.ErrInternal("No Assets With Given PegHash Found!") // Codespace and CodeType needs to be defined
 	}
+	if err := keeper.aclKeeper.CheckFrozen(ctx, redeemerAddress, acl.PegTypeAsset, pegHash); err != nil {
+		return err
+	}
 	assetPeg, redeemerPegHashWallet = cmTypes.SubtractAssetPegFromWallet(pegHash, redeemerPegHashWallet)
 	unSetAssetPeg := cmTypes.NewBaseAssetPegWithPegHash(assetPeg.GetPegHash())
 	issuerPegHashWallet = cmTypes.AddAssetPegToWallet(&unSetAssetPeg, issuerPegHashWallet)
@@ -602,35 +707,68 @@ func (keeper BaseSendKeeper) RedeemFiatsFromWallets(ctx sdk.Context, redeemFiat
 	if !_acl.RedeemFiat {
 		return sdk.ErrInternal(fmt.Sprintf("Fiats can't be redeemed from account %v.", redeemFiat.RedeemerAddress.String()))
 	}
-	err = instantiateAndRedeemFiat(ctx, keeper, redeemFiat.IssuerAddress, redeemFiat.RedeemerAddress, redeemFiat.Amount)
+	approvalRequested, err := requestApproval(ctx, keeper, approvals.PendingAction{
+		Action:        approvals.ActionRedeemFiat,
+		MakerAddress:  redeemFiat.RedeemerAddress,
+		IssuerAddress: redeemFiat.IssuerAddress,
+		HolderAddress: redeemFiat.RedeemerAddress,
+		Amount:        redeemFiat.Amount,
+		BankReference: redeemFiat.BankReference,
+	})
+	if err != nil || approvalRequested {
+		return err
+	}
+	err = instantiateAndRedeemFiat(ctx, keeper, redeemFiat.IssuerAddress, redeemFiat.RedeemerAddress, redeemFiat.Amount,
+		redeemFiat.BankReference)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// instantiateAndRedeemFiat : takes the redeemed fiat pegs out of the wallet into a pending payout the zone confirms or rejects
 func instantiateAndRedeemFiat(ctx sdk.Context, keeper BaseSendKeeper, issuerAddress sdk.AccAddress,
-	redeemerAddress sdk.AccAddress, amount int64) sdk.Error {
+	redeemerAddress sdk.AccAddress, amount int64, bankReference string) sdk.Error {
 
-	fromOldFiatWallet := getFiatWallet(ctx, keeper, redeemerAddress)
+	aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, redeemerAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.aclKeeper.CheckFrozen(ctx, redeemerAddress, acl.PegTypeFiat); err != nil {
+		return err
+	}
 
-	emptiedFiatPegWallet, redeemerFiatPegWallet := cmTypes.RedeemAmountFromWallet(amount, fromOldFiatWallet)
-	if len(redeemerFiatPegWallet) == 0 && len(emptiedFiatPegWallet) == 0 {
+	fromOldFiatWallet, frozenFiatPegWallet := splitFrozenFiats(ctx, keeper, redeemerAddress, getFiatWallet(ctx, keeper, redeemerAddress))
+
+	redeemedFiatPegWallet, redeemerFiatPegWallet := cmTypes.SubtractAmountFromWallet(amount, fromOldFiatWallet)
+	if len(redeemedFiatPegWallet) == 0 {
 		return sdk.ErrInsufficientCoins(fmt.Sprintf("Redeemed amount higher than the account balance"))
 	}
+	redeemerFiatPegWallet = cmTypes.AddFiatPegToWallet(redeemerFiatPegWallet, frozenFiatPegWallet)
 
-	err := setFiatWallet(ctx, keeper, redeemerAddress, redeemerFiatPegWallet)
+	err = setFiatWallet(ctx, keeper, redeemerAddress, redeemerFiatPegWallet)
 	if err != nil {
 		return err
 	}
 
+	payout := keeper.payoutKeeper.AddPayout(ctx, aclAccount.GetZoneID(), redeemerAddress, amount, bankReference, redeemedFiatPegWallet)
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeRedeemFiat,
 		sdk.NewAttribute("redeemer", redeemerAddress.String()),
+		sdk.NewAttribute("payoutID", strconv.FormatUint(payout.PayoutID, 10)),
 	))
 	return nil
 }
 
 func (keeper BaseSendKeeper) SendAssetsToWallets(ctx sdk.Context, sendAsset types.SendAsset) sdk.Error {
+	if err := keeper.checkKYC(ctx, sendAsset.FromAddress, sendAsset.ToAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sendAsset.FromAddress, sendAsset.ToAddress); err != nil {
+		return err
+	}
 	aclStore, err := keeper.aclKeeper.GetAccountACLDetails(ctx, sendAsset.FromAddress)
 	if err != nil {
 		return sdk.ErrInternal("Unauthorized transaction")
@@ -661,6 +799,9 @@ func sendAssetToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.Ac
 	if _negotiation.GetSellerSignature() == nil || _negotiation.GetBuyerSignature() == nil {
 		return sdk.ErrInternal("Signatures are not present")
 	}
+	if negotiation.IsBasket(_negotiation) {
+		return sendBasketAssetsToOrder(ctx, keeper, fromAddress, toAddress, _negotiation)
+	}
 
 	fromOldAssetWallet := getAssetWallet(ctx, keeper, fromAddress)
 	sentAsset, fromNewAssetPegWallet := cmTypes.SubtractAssetPegFromWallet(pegHash, fromOldAssetWallet)
@@ -670,10 +811,16 @@ func sendAssetToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.Ac
 	if sentAsset.GetLocked() {
 		return sdk.ErrInsufficientCoins("Asset locked.")
 	}
+	if err := keeper.aclKeeper.CheckFrozen(ctx, fromAddress, acl.PegTypeAsset, pegHash); err != nil {
+		return err
+	}
 	err = keeper.orderKeeper.SendAssetsToOrder(ctx, fromAddress, toAddress, sentAsset)
 	if err == nil {
 		err = setAssetWallet(ctx, keeper, fromAddress, fromNewAssetPegWallet)
 	}
+	if err == nil {
+		err = chargeTradeFee(ctx, keeper, tradeFees.MsgTypeSendAsset, fromAddress, sentAsset.GetAssetType(), _negotiation)
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeSendAsset,
@@ -685,8 +832,59 @@ func sendAssetToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.Ac
 	return err
 }
 
+// sendBasketAssetsToOrder : escrow every asset peg of the basket or none of them
+func sendBasketAssetsToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.AccAddress, toAddress sdk.AccAddress,
+	_negotiation negotiation.Negotiation) sdk.Error {
+
+	fromAssetWallet := getAssetWallet(ctx, keeper, fromAddress)
+	var sentAssetPegWallet cmTypes.AssetPegWallet
+	for _, pegHash := range _negotiation.GetPegHashes() {
+		var sentAsset cmTypes.AssetPeg
+		sentAsset, fromAssetWallet = cmTypes.SubtractAssetPegFromWallet(pegHash, fromAssetWallet)
+		if sentAsset == nil {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Asset %v not found.", pegHash.String()))
+		}
+		if sentAsset.GetLocked() {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Asset %v locked.", pegHash.String()))
+		}
+		if err := keeper.aclKeeper.CheckFrozen(ctx, fromAddress, acl.PegTypeAsset, pegHash); err != nil {
+			return err
+		}
+		if len(sentAssetPegWallet) != 0 && sentAsset.GetModerated() != sentAssetPegWallet[0].GetModerated() {
+			return sdk.ErrUnknownRequest("Basket cannot mix moderated and unmoderated assets.")
+		}
+		sentAssetPegWallet = append(sentAssetPegWallet, cmTypes.ToBaseAssetPeg(sentAsset))
+	}
+
+	err := keeper.orderKeeper.SendBasketAssetsToOrder(ctx, fromAddress, toAddress, _negotiation.GetPegHash(), sentAssetPegWallet)
+	if err != nil {
+		return err
+	}
+	err = setAssetWallet(ctx, keeper, fromAddress, fromAssetWallet)
+	if err == nil {
+		err = chargeTradeFee(ctx, keeper, tradeFees.MsgTypeSendAsset, fromAddress, sentAssetPegWallet[0].GetAssetType(), _negotiation)
+	}
+
+	for _, sentAsset := range sentAssetPegWallet {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSendAsset,
+			sdk.NewAttribute("recipient", toAddress.String()),
+			sdk.NewAttribute("sender", fromAddress.String()),
+			sdk.NewAttribute("asset", sentAsset.GetPegHash().String()),
+		))
+	}
+
+	return err
+}
+
 func (keeper BaseSendKeeper) SendFiatsToWallets(ctx sdk.Context, sendFiat types.SendFiat) sdk.Error {
 
+	if err := keeper.checkKYC(ctx, sendFiat.FromAddress, sendFiat.ToAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sendFiat.FromAddress, sendFiat.ToAddress); err != nil {
+		return err
+	}
 	_acl, err := keeper.aclKeeper.GetAccountACLDetails(ctx, sendFiat.FromAddress)
 	if err != nil {
 		return sdk.ErrInternal("Unauthorized transaction")
@@ -695,6 +893,9 @@ func (keeper BaseSendKeeper) SendFiatsToWallets(ctx sdk.Context, sendFiat types.
 	if !account.SendFiat {
 		return sdk.ErrInternal("Unauthorized transaction")
 	}
+	if err := keeper.aclKeeper.RecordFiatOutflow(ctx, sendFiat.FromAddress, sendFiat.Amount); err != nil {
+		return err
+	}
 	err = sendFiatToOrder(ctx, keeper, sendFiat.FromAddress, sendFiat.ToAddress, sendFiat.PegHash, sendFiat.Amount)
 	if err != nil {
 		return err
@@ -718,12 +919,20 @@ func sendFiatToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.Acc
 	if _negotiation.GetSellerSignature() == nil || _negotiation.GetBuyerSignature() == nil {
 		return sdk.ErrInternal("Signatures are not present")
 	}
+	if negotiation.IsCoinSettled(_negotiation) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Negotiation is settled in %s.", _negotiation.GetSettlementDenom()))
+	}
 
-	fromOldFiatWallet := getFiatWallet(ctx, keeper, fromAddress)
+	if err := keeper.aclKeeper.CheckFrozen(ctx, fromAddress, acl.PegTypeFiat); err != nil {
+		return err
+	}
+
+	fromOldFiatWallet, frozenFiatPegWallet := splitFrozenFiats(ctx, keeper, fromAddress, getFiatWallet(ctx, keeper, fromAddress))
 	sentFiatPegWallet, oldFiatPegWallet := cmTypes.SubtractAmountFromWallet(amount, fromOldFiatWallet)
 	if len(sentFiatPegWallet) == 0 && len(oldFiatPegWallet) == 0 {
 		return sdk.ErrInsufficientCoins(fmt.Sprintf("Insufficient funds"))
 	}
+	oldFiatPegWallet = cmTypes.AddFiatPegToWallet(oldFiatPegWallet, frozenFiatPegWallet)
 
 	err = keeper.orderKeeper.SendFiatsToOrder(ctx, fromAddress, toAddress, pegHash, sentFiatPegWallet)
 	if err == nil {
@@ -746,6 +955,21 @@ func (keeper BaseSendKeeper) BuyerExecuteTradeOrder(ctx sdk.Context, buyerExecut
 	var _acl acl.ACL
 	var err sdk.Error
 
+	if err := keeper.checkKYC(ctx, buyerExecuteOrder.BuyerAddress, buyerExecuteOrder.SellerAddress); err != nil {
+		return err, fiatPegWallets
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, buyerExecuteOrder.BuyerAddress, buyerExecuteOrder.SellerAddress); err != nil {
+		return err, fiatPegWallets
+	}
+
+	if len(keeper.orderKeeper.GetOrderTranches(ctx, buyerExecuteOrder.BuyerAddress, buyerExecuteOrder.SellerAddress,
+		buyerExecuteOrder.PegHash)) != 0 {
+		return sdk.ErrUnknownRequest("Order is delivered in tranches, it is executed by the last tranche."), fiatPegWallets
+	}
+	if isSwapOrder(ctx, keeper, buyerExecuteOrder.BuyerAddress, buyerExecuteOrder.SellerAddress, buyerExecuteOrder.PegHash) {
+		return sdk.ErrUnknownRequest("Swap orders are executed with executeSwap."), fiatPegWallets
+	}
+
 	_, assetWallet, _, _, _ := keeper.orderKeeper.GetOrderDetails(ctx, buyerExecuteOrder.BuyerAddress,
 		buyerExecuteOrder.SellerAddress, buyerExecuteOrder.PegHash)
 
@@ -837,8 +1061,8 @@ func privateExchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediator
 		keeper.reputationKeeper.SetSellerExecuteOrderPositiveTx(ctx, sellerAddress)
 		keeper.reputationKeeper.SetBuyerExecuteOrderPositiveTx(ctx, buyerAddress)
 
-		buyerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPegWallet[0], buyerAssetWallet)
-		assetPegWallet = keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPegWallet[0])
+		buyerAssetWallet = addAssetPegsToWallet(assetPegWallet, buyerAssetWallet)
+		assetPegWallet = keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
 
 		_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
 	}
@@ -854,8 +1078,15 @@ func privateExchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediator
 			keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
 		}
 		if len(assetPegWallet) != 0 {
-			sellerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPegWallet[0], sellerAssetWallet)
-			keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPegWallet[0])
+			sellerAssetWallet = addAssetPegsToWallet(assetPegWallet, sellerAssetWallet)
+			keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
+		}
+		err = refundOrderCoins(ctx, keeper, buyerAddress, sellerAddress, pegHash)
+		if err != nil {
+			return err, nil, nil
+		}
+		if reverseOrder {
+			keeper.nk.ReleaseNegotiation(ctx, _negotiation)
 		}
 
 		_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
@@ -893,14 +1124,17 @@ func exchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress
 
 	var reverseOrder bool
 	var oldFiatPegWallet cmTypes.FiatPegWallet
-	if len(fiatPegWallet) == 0 || _negotiation.GetBid() > cmTypes.GetFiatPegWalletBalance(fiatPegWallet) {
+	coinSettled := negotiation.IsCoinSettled(_negotiation)
+	payment := getOrderPayment(fiatPegWallet, keeper.orderKeeper.GetOrderCoins(ctx, buyerAddress, sellerAddress, pegHash),
+		_negotiation)
+	if payment == 0 || _negotiation.GetBid() > payment {
 		if _negotiation.GetTime() < ctx.BlockHeight() {
 			return sdk.ErrInsufficientCoins("Fiat tokens not found!"), fiatPegWallet, assetPegWallet
 		}
 		reverseOrder = true
 		keeper.reputationKeeper.SetBuyerExecuteOrderNegativeTx(ctx, buyerAddress)
 	}
-	if len(assetPegWallet) != 1 || assetPegWallet[0].GetPegHash().String() != pegHash.String() {
+	if !containsNegotiationAssets(assetPegWallet, _negotiation) {
 		if _negotiation.GetTime() < ctx.BlockHeight() {
 			return sdk.ErrInsufficientCoins("Asset token not found!"), fiatPegWallet, assetPegWallet
 		}
@@ -915,10 +1149,11 @@ func exchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress
 		reverseOrder = true
 	}
 
-	if _negotiation.GetBid() < cmTypes.GetFiatPegWalletBalance(fiatPegWallet) {
+	if !coinSettled && _negotiation.GetBid() < cmTypes.GetFiatPegWalletBalance(fiatPegWallet) {
 		fiatPegWallet, oldFiatPegWallet = cmTypes.SubtractAmountFromWallet(_negotiation.GetBid(), fiatPegWallet)
 	}
 	var executed bool
+	var tradeAssetType string
 	if !reverseOrder {
 		sellerFiatWallet := getFiatWallet(ctx, keeper, sellerAddress)
 		buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
@@ -940,16 +1175,30 @@ func exchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress
 			keeper.reputationKeeper.SetSellerExecuteOrderPositiveTx(ctx, sellerAddress)
 			keeper.reputationKeeper.SetBuyerExecuteOrderPositiveTx(ctx, buyerAddress)
 
-			buyerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPegWallet[0], buyerAssetWallet)
+			tradeAssetType = assetPegWallet[0].GetAssetType()
+			buyerAssetWallet = addAssetPegsToWallet(assetPegWallet, buyerAssetWallet)
 			sellerFiatWallet = cmTypes.AddFiatPegToWallet(sellerFiatWallet, fiatPegWallet)
 
 			fiatPegWallet = keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
-			assetPegWallet = keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPegWallet[0])
+			assetPegWallet = keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
+			if coinSettled {
+				bidCoins := sdk.NewCoins(sdk.NewInt64Coin(_negotiation.GetSettlementDenom(), _negotiation.GetBid()))
+				err = sendCoinsFromOrder(ctx, keeper, sellerAddress, buyerAddress, sellerAddress, pegHash, bidCoins)
+				if err != nil {
+					return err, nil, nil
+				}
+			}
 		}
 
 		_ = setFiatWallet(ctx, keeper, sellerAddress, sellerFiatWallet)
 		_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
 
+		if executed {
+			err = chargeTradeFee(ctx, keeper, tradeFees.MsgTypeExecuteOrder, sellerAddress, tradeAssetType, _negotiation)
+			if err != nil {
+				return err, nil, nil
+			}
+		}
 	}
 
 	if executed == true || reverseOrder == true {
@@ -964,8 +1213,15 @@ func exchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress
 			keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
 		}
 		if len(assetPegWallet) != 0 {
-			sellerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPegWallet[0], sellerAssetWallet)
-			keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPegWallet[0])
+			sellerAssetWallet = addAssetPegsToWallet(assetPegWallet, sellerAssetWallet)
+			keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
+		}
+		err = refundOrderCoins(ctx, keeper, buyerAddress, sellerAddress, pegHash)
+		if err != nil {
+			return err, nil, nil
+		}
+		if reverseOrder {
+			keeper.nk.ReleaseNegotiation(ctx, _negotiation)
 		}
 
 		_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
@@ -985,6 +1241,32 @@ func exchangeOrderTokens(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress
 	return nil, fiatPegWallet, assetPegWallet
 }
 
+// containsNegotiationAssets : true if the order holds exactly the asset pegs traded by the negotiation
+func containsNegotiationAssets(assetPegWallet cmTypes.AssetPegWallet, _negotiation negotiation.Negotiation) bool {
+	return containsPegHashes(assetPegWallet, negotiation.GetNegotiationPegHashes(_negotiation))
+}
+
+// containsPegHashes : true if the wallet holds exactly the given asset pegs
+func containsPegHashes(assetPegWallet cmTypes.AssetPegWallet, pegHashes []cmTypes.PegHash) bool {
+	if len(assetPegWallet) != len(pegHashes) {
+		return false
+	}
+	for _, pegHash := range pegHashes {
+		i := assetPegWallet.SearchAssetPeg(pegHash)
+		if i == len(assetPegWallet) || assetPegWallet[i].GetPegHash().String() != pegHash.String() {
+			return false
+		}
+	}
+	return true
+}
+
+func addAssetPegsToWallet(assetPegWallet cmTypes.AssetPegWallet, toAssetPegWallet cmTypes.AssetPegWallet) cmTypes.AssetPegWallet {
+	for i := range assetPegWallet {
+		toAssetPegWallet = cmTypes.AddAssetPegToWallet(&assetPegWallet[i], toAssetPegWallet)
+	}
+	return toAssetPegWallet
+}
+
 func (keeper BaseSendKeeper) SellerExecuteTradeOrder(ctx sdk.Context, sellerExecuteOrder types.SellerExecuteOrder) (
 	sdk.Error, []cmTypes.AssetPegWallet) {
 
@@ -993,6 +1275,21 @@ func (keeper BaseSendKeeper) SellerExecuteTradeOrder(ctx sdk.Context, sellerExec
 	var err sdk.Error
 	var assetPegWallet cmTypes.AssetPegWallet
 
+	if err := keeper.checkKYC(ctx, sellerExecuteOrder.BuyerAddress, sellerExecuteOrder.SellerAddress); err != nil {
+		return err, assetPegWallets
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sellerExecuteOrder.BuyerAddress, sellerExecuteOrder.SellerAddress); err != nil {
+		return err, assetPegWallets
+	}
+
+	if len(keeper.orderKeeper.GetOrderTranches(ctx, sellerExecuteOrder.BuyerAddress, sellerExecuteOrder.SellerAddress,
+		sellerExecuteOrder.PegHash)) != 0 {
+		return sdk.ErrUnknownRequest("Order is delivered in tranches, it is executed by the last tranche."), assetPegWallets
+	}
+	if isSwapOrder(ctx, keeper, sellerExecuteOrder.BuyerAddress, sellerExecuteOrder.SellerAddress, sellerExecuteOrder.PegHash) {
+		return sdk.ErrUnknownRequest("Swap orders are executed with executeSwap."), assetPegWallets
+	}
+
 	_, assetWallet, _, _, _ := keeper.orderKeeper.GetOrderDetails(ctx, sellerExecuteOrder.BuyerAddress,
 		sellerExecuteOrder.SellerAddress, sellerExecuteOrder.PegHash)
 
@@ -1123,10 +1420,38 @@ func (keeper BaseSendKeeper) DefineACLs(ctx sdk.Context, defineACL types.DefineA
 				return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to define acl "+
 					"for account %v.", defineACL.From.String(), defineACL.To.String()))
 			}
+			err := keeper.aclKeeper.CheckOrganizationACL(ctx, defineACL.ACLAccount.GetOrganizationID(),
+				keeper.aclKeeper.GetEffectiveACL(ctx, defineACL.ACLAccount))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := keeper.aclKeeper.DefineACLAccount(ctx, defineACL.From, defineACL.To, defineACL.ACLAccount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (keeper BaseSendKeeper) DefineRoles(ctx sdk.Context, defineRole types.DefineRole) sdk.Error {
+	role := defineRole.Role
+	if !keeper.aclKeeper.CheckValidGenesisAddress(ctx, defineRole.From) {
+		if !keeper.aclKeeper.CheckValidZoneAddress(ctx, role.ZoneID, defineRole.From) {
+			if len(role.OrganizationID) == 0 || !keeper.aclKeeper.CheckValidOrganizationAddress(ctx, role.ZoneID,
+				role.OrganizationID, defineRole.From) {
+
+				return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to define role %v.",
+					defineRole.From.String(), role.Name))
+			}
+			if err := keeper.aclKeeper.CheckOrganizationACL(ctx, role.OrganizationID, role.ACL); err != nil {
+				return err
+			}
 		}
 	}
 
-	err := keeper.aclKeeper.DefineACLAccount(ctx, defineACL.To, defineACL.ACLAccount)
+	err := keeper.aclKeeper.DefineRole(ctx, role)
 	if err != nil {
 		return err
 	}
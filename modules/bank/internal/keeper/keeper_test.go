@@ -13,21 +13,38 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/commitHub/commitBlockchain/codec"
 
 	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
 
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/approvals"
 	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/orders"
+	"github.com/commitHub/commitBlockchain/modules/params"
+	"github.com/commitHub/commitBlockchain/modules/payouts"
+	"github.com/commitHub/commitBlockchain/modules/reputation"
+	"github.com/commitHub/commitBlockchain/modules/reserves"
+	"github.com/commitHub/commitBlockchain/modules/supply"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
 )
 
 type testInput struct {
-	cdc *codec.Codec
-	ctx sdk.Context
-	k   Keeper
-	ak  auth.AccountKeeper
-	pk  params.Keeper
+	cdc            *codec.Codec
+	ctx            sdk.Context
+	k              Keeper
+	ak             auth.AccountKeeper
+	pk             params.Keeper
+	aclKeeper      acl.Keeper
+	nk             negotiation.Keeper
+	orderKeeper    orders.Keeper
+	rk             reputation.Keeper
+	payoutKeeper   payouts.Keeper
+	approvalKeeper approvals.Keeper
+	reserveKeeper  reserves.Keeper
+	tradeFeeKeeper tradeFees.Keeper
 }
 
 func setupTestInput() testInput {
@@ -35,16 +52,33 @@ func setupTestInput() testInput {
 
 	cdc := codec.New()
 	auth.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	negotiation.RegisterCodec(cdc)
+	orders.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	keyACL := sdk.NewKVStoreKey(acl.ModuleName)
+	keyNegotiation := sdk.NewKVStoreKey(negotiation.ModuleName)
+	keyOrder := sdk.NewKVStoreKey(orders.ModuleName)
+	keyReputation := sdk.NewKVStoreKey(reputation.ModuleName)
+	keyPayouts := sdk.NewKVStoreKey(payouts.ModuleName)
+	keyApprovals := sdk.NewKVStoreKey(approvals.ModuleName)
+	keyReserves := sdk.NewKVStoreKey(reserves.ModuleName)
+	keyTradeFees := sdk.NewKVStoreKey(tradeFees.ModuleName)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	for _, key := range []*sdk.KVStoreKey{keyACL, keyNegotiation, keyOrder, keyReputation, keyPayouts, keyApprovals,
+		keyReserves, keyTradeFees, keySupply} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
 	ms.LoadLatestVersion()
 
 	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
@@ -56,10 +90,27 @@ func setupTestInput() testInput {
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	bankKeeper := NewBaseKeeper(ak, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace)
+	aclKeeper := acl.NewKeeper(keyACL, ak, cdc)
+	nk := negotiation.NewKeeper(keyNegotiation, ak, aclKeeper, cdc)
+	orderKeeper := orders.NewKeeper(keyOrder, cdc, nk, aclKeeper, ak)
+	rk := reputation.NewKeeper(cdc, keyReputation, orderKeeper)
+	reserveKeeper := reserves.NewKeeper(keyReserves, cdc, aclKeeper)
+	payoutKeeper := payouts.NewKeeper(keyPayouts, cdc, ak, aclKeeper, reserveKeeper)
+	tradeFeeKeeper := tradeFees.NewKeeper(keyTradeFees, cdc, pk.Subspace(tradeFees.DefaultParamspace), aclKeeper)
+	tradeFeeKeeper.SetParams(ctx, tradeFees.DefaultParams())
+	approvalKeeper := approvals.NewKeeper(keyApprovals, cdc, aclKeeper)
+
+	bankKeeper := NewBaseKeeper(ak, nk, aclKeeper, orderKeeper, rk, payoutKeeper, approvalKeeper, reserveKeeper,
+		tradeFeeKeeper, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace)
 	bankKeeper.SetSendEnabled(ctx, true)
-
-	return testInput{cdc: cdc, ctx: ctx, k: bankKeeper, ak: ak, pk: pk}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, ak, bankKeeper, supply.DefaultCodespace,
+		map[string][]string{orders.ModuleName: nil, tradeFees.ModuleName: nil})
+	bankKeeper = *bankKeeper.SetSupplyKeeper(supplyKeeper)
+	approvalKeeper = *approvalKeeper.SetBankKeeper(bankKeeper)
+
+	return testInput{cdc: cdc, ctx: ctx, k: bankKeeper, ak: ak, pk: pk, aclKeeper: aclKeeper, nk: nk,
+		orderKeeper: orderKeeper, rk: rk, payoutKeeper: payoutKeeper, approvalKeeper: approvalKeeper,
+		reserveKeeper: reserveKeeper, tradeFeeKeeper: tradeFeeKeeper}
 }
 
 func TestKeeper(t *testing.T) {
@@ -144,7 +195,8 @@ func TestSendKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
 	paramSpace := input.pk.Subspace("newspace")
-	sendKeeper := NewBaseSendKeeper(input.ak, paramSpace, types.DefaultCodespace)
+	sendKeeper := NewBaseSendKeeper(input.ak, input.nk, input.aclKeeper, input.orderKeeper, input.rk, input.payoutKeeper,
+		input.approvalKeeper, input.reserveKeeper, input.tradeFeeKeeper, paramSpace, types.DefaultCodespace)
 	input.k.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
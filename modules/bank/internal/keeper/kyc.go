@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// checkKYC : fails when the kyc of any of the trading accounts expired
+func (keeper BaseSendKeeper) checkKYC(ctx sdk.Context, addresses ...sdk.AccAddress) sdk.Error {
+	for _, address := range addresses {
+		if err := keeper.aclKeeper.CheckKYC(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordKYCs : only the zone of the acl account can record its kyc
+func (keeper BaseSendKeeper) RecordKYCs(ctx sdk.Context, recordKYC types.RecordKYC) sdk.Error {
+	aclAccount, err := keeper.aclKeeper.GetACLAccount(ctx, recordKYC.To)
+	if err != nil {
+		return err
+	}
+	if !keeper.aclKeeper.CheckValidZoneAddress(ctx, aclAccount.GetZoneID(), recordKYC.From) {
+		return sdk.ErrInternal(fmt.Sprintf("Account %v is not the zone of account %v. Kyc can only be recorded "+
+			"by the zone of the account.", recordKYC.From.String(), recordKYC.To.String()))
+	}
+	return keeper.aclKeeper.RecordKYC(ctx, aclAccount.GetZoneID(), recordKYC.To, recordKYC.Level, recordKYC.DocumentHash,
+		recordKYC.ExpiresAt)
+}
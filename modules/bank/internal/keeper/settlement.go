@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/orders"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// SendCoinsToOrders : the buyer escrows coins of the negotiation settlement denomination into the order
+func (keeper BaseSendKeeper) SendCoinsToOrders(ctx sdk.Context, sendCoin types.SendCoinToOrder) sdk.Error {
+	if err := keeper.checkKYC(ctx, sendCoin.FromAddress, sendCoin.ToAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sendCoin.FromAddress, sendCoin.ToAddress); err != nil {
+		return err
+	}
+	_acl, err := keeper.aclKeeper.GetAccountACLDetails(ctx, sendCoin.FromAddress)
+	if err != nil {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+	if !_acl.GetACL().SendFiat {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+	err = sendCoinToOrder(ctx, keeper, sendCoin.FromAddress, sendCoin.ToAddress, sendCoin.PegHash,
+		sendCoin.Amount)
+	if err != nil {
+		return err
+	}
+
+	keeper.reputationKeeper.SetSendFiatsPositiveTx(ctx, sendCoin.FromAddress)
+	return nil
+}
+
+func sendCoinToOrder(ctx sdk.Context, keeper BaseSendKeeper, fromAddress sdk.AccAddress, toAddress sdk.AccAddress,
+	pegHash cmTypes.PegHash, amount sdk.Coins) sdk.Error {
+
+	_negotiation, err := keeper.nk.GetNegotiationDetails(ctx, fromAddress, toAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	_time := _negotiation.GetTime() + _negotiation.GetBuyerBlockHeight()
+	if ctx.BlockHeight() > _time {
+		return sdk.ErrInvalidSequence("Negotiation time expired.")
+	}
+	if _negotiation.GetSellerSignature() == nil || _negotiation.GetBuyerSignature() == nil {
+		return sdk.ErrInternal("Signatures are not present")
+	}
+	if !negotiation.IsCoinSettled(_negotiation) {
+		return sdk.ErrUnknownRequest("Negotiation is settled in fiat pegs.")
+	}
+	if amount.AmountOf(_negotiation.GetSettlementDenom()).IsZero() || len(amount) != 1 {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Negotiation is settled in %s.", _negotiation.GetSettlementDenom()))
+	}
+
+	err = keeper.SendCoins(ctx, fromAddress, getOrderEscrowAddress(ctx, keeper), amount)
+	if err != nil {
+		return err
+	}
+	err = keeper.orderKeeper.SendCoinsToOrder(ctx, fromAddress, toAddress, pegHash, amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendCoinToOrder,
+		sdk.NewAttribute("recipient", toAddress.String()),
+		sdk.NewAttribute("sender", fromAddress.String()),
+		sdk.NewAttribute("amount", amount.String()),
+	))
+
+	return nil
+}
+
+// getOrderEscrowAddress : address of the orders module account holding escrowed coins
+func getOrderEscrowAddress(ctx sdk.Context, keeper BaseSendKeeper) sdk.AccAddress {
+	return keeper.supplyKeeper.GetModuleAccount(ctx, orders.ModuleName).GetAddress()
+}
+
+// sendCoinsFromOrder : pay escrowed coins of the order out of the orders module account
+func sendCoinsFromOrder(ctx sdk.Context, keeper BaseSendKeeper, toAddress sdk.AccAddress, buyerAddress sdk.AccAddress,
+	sellerAddress sdk.AccAddress, pegHash cmTypes.PegHash, coins sdk.Coins) sdk.Error {
+
+	if coins.Empty() {
+		return nil
+	}
+	err := keeper.SendCoins(ctx, getOrderEscrowAddress(ctx, keeper), toAddress, coins)
+	if err != nil {
+		return err
+	}
+	keeper.orderKeeper.SendCoinsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, coins)
+	return nil
+}
+
+// refundOrderCoins : return every coin still escrowed in the order to the buyer
+func refundOrderCoins(ctx sdk.Context, keeper BaseSendKeeper, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress,
+	pegHash cmTypes.PegHash) sdk.Error {
+
+	coins := keeper.orderKeeper.GetOrderCoins(ctx, buyerAddress, sellerAddress, pegHash)
+	return sendCoinsFromOrder(ctx, keeper, buyerAddress, buyerAddress, sellerAddress, pegHash, coins)
+}
+
+// getOrderPayment : amount the buyer escrowed in the settlement currency of the negotiation
+func getOrderPayment(fiatPegWallet cmTypes.FiatPegWallet, coins sdk.Coins, _negotiation negotiation.Negotiation) int64 {
+	if negotiation.IsCoinSettled(_negotiation) {
+		return coins.AmountOf(_negotiation.GetSettlementDenom()).Int64()
+	}
+	return cmTypes.GetFiatPegWalletBalance(fiatPegWallet)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// DefineSubOrganizations : the zone, or the address or an admin of the parent organization or its parents, can define
+// a sub organization, whose acl the parent bounds
+func (keeper BaseSendKeeper) DefineSubOrganizations(ctx sdk.Context, defineSubOrganization types.DefineSubOrganization) sdk.Error {
+	parent, err := keeper.aclKeeper.GetOrganization(ctx, defineSubOrganization.ParentID)
+	if err != nil {
+		return err
+	}
+	if !keeper.aclKeeper.CheckValidZoneAddress(ctx, parent.ZoneID, defineSubOrganization.From) {
+		if !keeper.aclKeeper.CheckValidOrganizationAddress(ctx, parent.ZoneID, defineSubOrganization.ParentID,
+			defineSubOrganization.From) {
+
+			return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to define sub organizations "+
+				"of organization %v.", defineSubOrganization.From.String(), defineSubOrganization.ParentID.String()))
+		}
+	}
+	return keeper.aclKeeper.DefineSubOrganization(ctx, defineSubOrganization.To, defineSubOrganization.OrganizationID,
+		defineSubOrganization.ParentID, defineSubOrganization.ACL)
+}
+
+// SetOrganizationAdmins : the zone, the organization address, or the address or an admin of a parent organization can
+// set the admins, admins cannot set admins of their own organization
+func (keeper BaseSendKeeper) SetOrganizationAdmins(ctx sdk.Context, setOrganizationAdmins types.SetOrganizationAdmins) sdk.Error {
+	organization, err := keeper.aclKeeper.GetOrganization(ctx, setOrganizationAdmins.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if !keeper.aclKeeper.CheckValidZoneAddress(ctx, organization.ZoneID, setOrganizationAdmins.From) &&
+		!organization.Address.Equals(setOrganizationAdmins.From) &&
+		(len(organization.ParentID) == 0 || !keeper.aclKeeper.CheckValidOrganizationAddress(ctx, organization.ZoneID,
+			organization.ParentID, setOrganizationAdmins.From)) {
+
+		return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to set the admins of organization %v.",
+			setOrganizationAdmins.From.String(), setOrganizationAdmins.OrganizationID.String()))
+	}
+	return keeper.aclKeeper.SetOrganizationAdmins(ctx, setOrganizationAdmins.OrganizationID, setOrganizationAdmins.Admins)
+}
@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// SendSwapAssetsToOrder : the buyer of a swap escrows every asset peg it swaps, or none of them
+func (keeper BaseSendKeeper) SendSwapAssetsToOrder(ctx sdk.Context, sendSwapAsset types.SendSwapAsset) sdk.Error {
+	if err := keeper.checkKYC(ctx, sendSwapAsset.FromAddress, sendSwapAsset.ToAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sendSwapAsset.FromAddress, sendSwapAsset.ToAddress); err != nil {
+		return err
+	}
+	aclStore, err := keeper.aclKeeper.GetAccountACLDetails(ctx, sendSwapAsset.FromAddress)
+	if err != nil {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+	if !aclStore.GetACL().SendAsset {
+		return sdk.ErrInternal("Unauthorized transaction")
+	}
+
+	buyerAddress := sendSwapAsset.FromAddress
+	sellerAddress := sendSwapAsset.ToAddress
+
+	_negotiation, err := keeper.nk.GetNegotiationDetails(ctx, buyerAddress, sellerAddress, sendSwapAsset.PegHash)
+	if err != nil {
+		return err
+	}
+	if !negotiation.IsSwap(_negotiation) {
+		return sdk.ErrUnknownRequest("Negotiation is not a swap.")
+	}
+	if ctx.BlockHeight() > _negotiation.GetTime()+_negotiation.GetBuyerBlockHeight() {
+		return sdk.ErrInvalidSequence("Negotiation time expired.")
+	}
+	if _negotiation.GetSellerSignature() == nil || _negotiation.GetBuyerSignature() == nil {
+		return sdk.ErrInternal("Signatures are not present")
+	}
+
+	buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
+	var sentAssetPegWallet cmTypes.AssetPegWallet
+	for _, pegHash := range _negotiation.GetSwapPegHashes() {
+		var sentAsset cmTypes.AssetPeg
+		sentAsset, buyerAssetWallet = cmTypes.SubtractAssetPegFromWallet(pegHash, buyerAssetWallet)
+		if sentAsset == nil {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Asset %v not found.", pegHash.String()))
+		}
+		if sentAsset.GetLocked() {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("Asset %v locked.", pegHash.String()))
+		}
+		if err := keeper.aclKeeper.CheckFrozen(ctx, buyerAddress, acl.PegTypeAsset, pegHash); err != nil {
+			return err
+		}
+		if len(sentAssetPegWallet) != 0 && sentAsset.GetModerated() != sentAssetPegWallet[0].GetModerated() {
+			return sdk.ErrUnknownRequest("Swap cannot mix moderated and unmoderated assets.")
+		}
+		sentAssetPegWallet = append(sentAssetPegWallet, cmTypes.ToBaseAssetPeg(sentAsset))
+	}
+
+	err = keeper.orderKeeper.SendSwapAssetsToOrder(ctx, buyerAddress, sellerAddress, sendSwapAsset.PegHash, sentAssetPegWallet)
+	if err != nil {
+		return err
+	}
+	err = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
+	if err != nil {
+		return err
+	}
+
+	for _, sentAsset := range sentAssetPegWallet {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSendSwapAsset,
+			sdk.NewAttribute("recipient", sellerAddress.String()),
+			sdk.NewAttribute("sender", buyerAddress.String()),
+			sdk.NewAttribute("asset", sentAsset.GetPegHash().String()),
+		))
+	}
+
+	keeper.reputationKeeper.SetSendAssetsPositiveTx(ctx, buyerAddress)
+	return nil
+}
+
+// ExecuteSwap : record the awb proof of a party of a swap order. Once both parties delivered, the asset pegs and
+// the fiat balancing payment are exchanged together. An expired swap is reversed.
+func (keeper BaseSendKeeper) ExecuteSwap(ctx sdk.Context, executeSwap types.ExecuteSwap) sdk.Error {
+	if err := keeper.checkKYC(ctx, executeSwap.BuyerAddress, executeSwap.SellerAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, executeSwap.BuyerAddress, executeSwap.SellerAddress); err != nil {
+		return err
+	}
+	buyerAddress := executeSwap.BuyerAddress
+	sellerAddress := executeSwap.SellerAddress
+	pegHash := executeSwap.PegHash
+
+	err, assetPegWallet, fiatPegWallet, _, orderAWBProofHash := keeper.orderKeeper.GetOrderDetails(ctx, buyerAddress,
+		sellerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	swapAssetPegWallet, swapAWBProofHash := keeper.orderKeeper.GetOrderSwapDetails(ctx, buyerAddress, sellerAddress, pegHash)
+
+	_negotiation, err := keeper.nk.GetNegotiationDetails(ctx, buyerAddress, sellerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	if !negotiation.IsSwap(_negotiation) {
+		return sdk.ErrUnknownRequest("Negotiation is not a swap.")
+	}
+
+	delivererAssetPegWallet := assetPegWallet
+	if executeSwap.DelivererAddress.Equals(buyerAddress) {
+		delivererAssetPegWallet = swapAssetPegWallet
+	}
+	if len(delivererAssetPegWallet) == 0 {
+		return sdk.ErrInsufficientCoins("Asset token not found!")
+	}
+	err = checkSellerExecuteOrderACL(ctx, keeper, executeSwap.MediatorAddress, executeSwap.DelivererAddress,
+		delivererAssetPegWallet[0])
+	if err != nil {
+		return err
+	}
+
+	sellerDelivered := containsNegotiationAssets(assetPegWallet, _negotiation)
+	buyerDelivered := containsPegHashes(swapAssetPegWallet, _negotiation.GetSwapPegHashes())
+
+	_time := ctx.BlockHeight()
+	if _time > _negotiation.GetTime()+_negotiation.GetBuyerBlockHeight() ||
+		_time > _negotiation.GetTime()+_negotiation.GetSellerBlockHeight() {
+
+		if !sellerDelivered || orderAWBProofHash == "" {
+			keeper.reputationKeeper.SetSellerExecuteOrderNegativeTx(ctx, sellerAddress)
+		}
+		if !buyerDelivered || swapAWBProofHash == "" || cmTypes.GetFiatPegWalletBalance(fiatPegWallet) < _negotiation.GetBid() {
+			keeper.reputationKeeper.SetBuyerExecuteOrderNegativeTx(ctx, buyerAddress)
+		}
+		reverseSwapOrder(ctx, keeper, buyerAddress, sellerAddress, pegHash, assetPegWallet, swapAssetPegWallet, fiatPegWallet)
+		emitExecuteSwapEvent(ctx, buyerAddress, sellerAddress, _negotiation, false, true)
+		return nil
+	}
+
+	if executeSwap.DelivererAddress.Equals(sellerAddress) {
+		if !sellerDelivered {
+			return sdk.ErrInsufficientCoins("Asset token not found!")
+		}
+		if orderAWBProofHash == "" {
+			orderAWBProofHash = executeSwap.AWBProofHash
+			keeper.orderKeeper.SetOrderAWBProofHash(ctx, buyerAddress, sellerAddress, pegHash, orderAWBProofHash)
+		}
+	} else {
+		if !buyerDelivered {
+			return sdk.ErrInsufficientCoins("Asset token not found!")
+		}
+		if swapAWBProofHash == "" {
+			swapAWBProofHash = executeSwap.AWBProofHash
+			keeper.orderKeeper.SetOrderSwapAWBProofHash(ctx, buyerAddress, sellerAddress, pegHash, swapAWBProofHash)
+		}
+	}
+
+	executed := sellerDelivered && buyerDelivered && orderAWBProofHash != "" && swapAWBProofHash != "" &&
+		cmTypes.GetFiatPegWalletBalance(fiatPegWallet) >= _negotiation.GetBid()
+	if executed {
+		keeper.reputationKeeper.SetSellerExecuteOrderPositiveTx(ctx, sellerAddress)
+		keeper.reputationKeeper.SetBuyerExecuteOrderPositiveTx(ctx, buyerAddress)
+
+		buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
+		buyerAssetWallet = addAssetPegsToWallet(assetPegWallet, buyerAssetWallet)
+		keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
+		_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
+
+		sellerAssetWallet := getAssetWallet(ctx, keeper, sellerAddress)
+		sellerAssetWallet = addAssetPegsToWallet(swapAssetPegWallet, sellerAssetWallet)
+		keeper.orderKeeper.SendSwapAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, swapAssetPegWallet)
+		_ = setAssetWallet(ctx, keeper, sellerAddress, sellerAssetWallet)
+
+		if _negotiation.GetBid() > 0 {
+			sentFiatPegWallet, _ := cmTypes.SubtractAmountFromWallet(_negotiation.GetBid(), fiatPegWallet)
+			fiatPegWallet = keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, sentFiatPegWallet)
+
+			sellerFiatWallet := getFiatWallet(ctx, keeper, sellerAddress)
+			sellerFiatWallet = cmTypes.AddFiatPegToWallet(sellerFiatWallet, sentFiatPegWallet)
+			_ = setFiatWallet(ctx, keeper, sellerAddress, sellerFiatWallet)
+		}
+		if len(fiatPegWallet) != 0 {
+			buyerFiatWallet := getFiatWallet(ctx, keeper, buyerAddress)
+			buyerFiatWallet = cmTypes.AddFiatPegToWallet(buyerFiatWallet, fiatPegWallet)
+			keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
+			_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
+		}
+	}
+
+	emitExecuteSwapEvent(ctx, buyerAddress, sellerAddress, _negotiation, executed, false)
+	return nil
+}
+
+// reverseSwapOrder : return everything escrowed in a swap order to the party that escrowed it
+func reverseSwapOrder(ctx sdk.Context, keeper BaseSendKeeper, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress,
+	pegHash cmTypes.PegHash, assetPegWallet cmTypes.AssetPegWallet, swapAssetPegWallet cmTypes.AssetPegWallet,
+	fiatPegWallet cmTypes.FiatPegWallet) {
+
+	if len(assetPegWallet) != 0 {
+		sellerAssetWallet := getAssetWallet(ctx, keeper, sellerAddress)
+		sellerAssetWallet = addAssetPegsToWallet(assetPegWallet, sellerAssetWallet)
+		keeper.orderKeeper.SendAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, assetPegWallet)
+		_ = setAssetWallet(ctx, keeper, sellerAddress, sellerAssetWallet)
+	}
+	if len(swapAssetPegWallet) != 0 {
+		buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
+		buyerAssetWallet = addAssetPegsToWallet(swapAssetPegWallet, buyerAssetWallet)
+		keeper.orderKeeper.SendSwapAssetsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, swapAssetPegWallet)
+		_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
+	}
+	if len(fiatPegWallet) != 0 {
+		buyerFiatWallet := getFiatWallet(ctx, keeper, buyerAddress)
+		buyerFiatWallet = cmTypes.AddFiatPegToWallet(buyerFiatWallet, fiatPegWallet)
+		keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
+		_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
+	}
+}
+
+func emitExecuteSwapEvent(ctx sdk.Context, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress,
+	_negotiation negotiation.Negotiation, executed bool, reversed bool) {
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteSwap,
+		sdk.NewAttribute("buyer", buyerAddress.String()),
+		sdk.NewAttribute("seller", sellerAddress.String()),
+		sdk.NewAttribute("assetPegHash", _negotiation.GetPegHash().String()),
+		sdk.NewAttribute("executed", strconv.FormatBool(executed)),
+		sdk.NewAttribute("balancingPayment", strconv.FormatInt(_negotiation.GetBid(), 10)),
+		sdk.NewAttribute("reversed", strconv.FormatBool(reversed)),
+	))
+}
+
+func isSwapOrder(ctx sdk.Context, keeper BaseSendKeeper, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress,
+	pegHash cmTypes.PegHash) bool {
+
+	_negotiation, err := keeper.nk.GetNegotiationDetails(ctx, buyerAddress, sellerAddress, pegHash)
+	return err == nil && negotiation.IsSwap(_negotiation)
+}
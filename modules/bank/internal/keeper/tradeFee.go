@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// getTradeFeeCollectorAddress : the tradeFees module account holds the community share of trade fees, its end blocker
+// moves the coins to the community pool
+func getTradeFeeCollectorAddress(ctx sdk.Context, keeper BaseSendKeeper) sdk.AccAddress {
+	return keeper.supplyKeeper.GetModuleAccount(ctx, tradeFees.ModuleName).GetAddress()
+}
+
+// chargeTradeFee : charge the payer the fee of its zone on a trade at the negotiated price, in the settlement coins of
+// coin settled negotiations and in fiat pegs otherwise. The fee is split between the zone, the organization of the payer
+// and the community pool. The community pool only holds coins, so the community share of fiat fees goes to the zone,
+// which can redeem it.
+// Accounts outside of any zone are not charged.
+func chargeTradeFee(ctx sdk.Context, keeper BaseSendKeeper, msgType string, payerAddress sdk.AccAddress, assetType string,
+	_negotiation negotiation.Negotiation) sdk.Error {
+
+	return chargeTradeFeeOnPart(ctx, keeper, msgType, payerAddress, assetType, _negotiation, 0, _negotiation.GetBid())
+}
+
+// chargeTradeFeeOnPart : charge the payer the part of the trade fee on the bid settled from settledAmount to
+// settledAmount+partAmount, so the parts charged on a bid settled piecewise add up to the fee on the whole bid
+func chargeTradeFeeOnPart(ctx sdk.Context, keeper BaseSendKeeper, msgType string, payerAddress sdk.AccAddress,
+	assetType string, _negotiation negotiation.Negotiation, settledAmount int64, partAmount int64) sdk.Error {
+
+	aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, payerAddress)
+	if err != nil {
+		return nil
+	}
+	tradeFee, found := keeper.tradeFeeKeeper.GetApplicableTradeFee(ctx, aclAccount.GetZoneID(), msgType, assetType)
+	if !found {
+		return nil
+	}
+	bid, fee := _negotiation.GetBid(), tradeFee.GetAmount(_negotiation.GetBid())
+	feeUpTo := func(settled int64) int64 {
+		if settled >= bid {
+			return fee
+		}
+		return sdk.NewInt(fee).MulRaw(settled).QuoRaw(bid).Int64()
+	}
+	amount := fee
+	if settledAmount > 0 || partAmount < bid {
+		amount = feeUpTo(settledAmount+partAmount) - feeUpTo(settledAmount)
+	}
+	if amount <= 0 {
+		return nil
+	}
+
+	zoneAddress, err := keeper.aclKeeper.GetZoneAddress(ctx, aclAccount.GetZoneID())
+	if err != nil {
+		return err
+	}
+	organization, err := keeper.aclKeeper.GetOrganization(ctx, aclAccount.GetOrganizationID())
+	if err != nil {
+		return err
+	}
+	zoneAmount, organizationAmount, communityAmount := tradeFee.Split(amount)
+
+	if negotiation.IsCoinSettled(_negotiation) {
+		denom := _negotiation.GetSettlementDenom()
+		shares := []struct {
+			address sdk.AccAddress
+			amount  int64
+		}{
+			{zoneAddress, zoneAmount},
+			{organization.Address, organizationAmount},
+			{getTradeFeeCollectorAddress(ctx, keeper), communityAmount},
+		}
+		for _, share := range shares {
+			if share.amount == 0 {
+				continue
+			}
+			err = keeper.SendCoins(ctx, payerAddress, share.address, sdk.NewCoins(sdk.NewInt64Coin(denom, share.amount)))
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		zoneAmount, communityAmount = zoneAmount+communityAmount, 0
+		payerFiatWallet, frozenFiatPegWallet := splitFrozenFiats(ctx, keeper, payerAddress, getFiatWallet(ctx, keeper, payerAddress))
+		if cmTypes.GetFiatPegWalletBalance(payerFiatWallet) < amount {
+			return sdk.ErrInsufficientCoins("Fiat tokens not enough to pay the trade fee.")
+		}
+		feeFiatPegWallet, payerFiatWallet := cmTypes.SubtractAmountFromWallet(amount, payerFiatWallet)
+		payerFiatWallet = cmTypes.AddFiatPegToWallet(payerFiatWallet, frozenFiatPegWallet)
+		if organizationAmount != 0 {
+			var organizationFiatPegWallet cmTypes.FiatPegWallet
+			organizationFiatPegWallet, feeFiatPegWallet = cmTypes.SubtractAmountFromWallet(organizationAmount, feeFiatPegWallet)
+			organizationFiatWallet := cmTypes.AddFiatPegToWallet(getFiatWallet(ctx, keeper, organization.Address), organizationFiatPegWallet)
+			if err = setFiatWallet(ctx, keeper, organization.Address, organizationFiatWallet); err != nil {
+				return err
+			}
+		}
+		if len(feeFiatPegWallet) != 0 {
+			zoneFiatWallet := cmTypes.AddFiatPegToWallet(getFiatWallet(ctx, keeper, zoneAddress), feeFiatPegWallet)
+			if err = setFiatWallet(ctx, keeper, zoneAddress, zoneFiatWallet); err != nil {
+				return err
+			}
+		}
+		if err = setFiatWallet(ctx, keeper, payerAddress, payerFiatWallet); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChargeTradeFee,
+		sdk.NewAttribute("payer", payerAddress.String()),
+		sdk.NewAttribute("msgType", msgType),
+		sdk.NewAttribute("assetType", assetType),
+		sdk.NewAttribute("amount", strconv.FormatInt(amount, 10)),
+		sdk.NewAttribute("zoneAmount", strconv.FormatInt(zoneAmount, 10)),
+		sdk.NewAttribute("organizationAmount", strconv.FormatInt(organizationAmount, 10)),
+		sdk.NewAttribute("communityAmount", strconv.FormatInt(communityAmount, 10)),
+	))
+	return nil
+}
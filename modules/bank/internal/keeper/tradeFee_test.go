@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/supply"
+	supplyExported "github.com/commitHub/commitBlockchain/modules/supply/exported"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+type tradeFeeInput struct {
+	testInput
+	sk           BaseSendKeeper
+	payer        sdk.AccAddress
+	zone         sdk.AccAddress
+	organization sdk.AccAddress
+	collector    sdk.AccAddress
+}
+
+// setupTradeFeeInput : a payer of a zone charged 10% of the bid on executed orders, half to the zone, 30% to its
+// organization and the rest to the community pool
+func setupTradeFeeInput(t *testing.T) tradeFeeInput {
+	input := setupTestInput()
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+	ctx := input.ctx
+
+	payer, zone, organization := sdk.AccAddress([]byte("payer")), sdk.AccAddress([]byte("zone")), sdk.AccAddress([]byte("organization"))
+	zoneID, organizationID := acl.ZoneID([]byte("zone")), acl.OrganizationID([]byte("organization"))
+	require.Nil(t, input.aclKeeper.SetZoneAddress(ctx, zoneID, zone))
+	require.Nil(t, input.aclKeeper.SetOrganization(ctx, organizationID, acl.NewOrganization(organization, zoneID)))
+	require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{
+		Address:        payer,
+		ZoneID:         zoneID,
+		OrganizationID: organizationID,
+		Status:         acl.ACLStatusActive,
+	}))
+	input.tradeFeeKeeper.SetTradeFee(ctx, tradeFees.NewTradeFee(zoneID, tradeFees.MsgTypeExecuteOrder, "",
+		sdk.NewDecWithPrec(1, 1), 0, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1)))
+
+	return tradeFeeInput{input, sk, payer, zone, organization, supply.NewModuleAddress(tradeFees.ModuleName)}
+}
+
+func TestChargeTradeFeeInCoins(t *testing.T) {
+	input := setupTradeFeeInput(t)
+	ctx := input.ctx
+
+	payerAccount := input.ak.NewAccountWithAddress(ctx, input.payer)
+	require.Nil(t, payerAccount.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	input.ak.SetAccount(ctx, payerAccount)
+
+	_negotiation := negotiation.NewNegotiation(input.payer, sdk.AccAddress([]byte("seller")), cmTypes.PegHash([]byte("asset")))
+	_ = _negotiation.SetBid(1000)
+	_ = _negotiation.SetSettlementDenom("stake")
+	require.Nil(t, chargeTradeFee(ctx, input.sk, tradeFees.MsgTypeExecuteOrder, input.payer, "sugar", _negotiation))
+
+	require.True(t, input.sk.GetCoins(ctx, input.payer).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 900))))
+	require.True(t, input.sk.GetCoins(ctx, input.zone).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	require.True(t, input.sk.GetCoins(ctx, input.organization).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.True(t, input.sk.GetCoins(ctx, input.collector).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+
+	// the collector is the tradeFees module account of the supply keeper
+	_, isModuleAccount := input.ak.GetAccount(ctx, input.collector).(supplyExported.ModuleAccountI)
+	require.True(t, isModuleAccount)
+}
+
+func TestChargeTradeFeeInFiats(t *testing.T) {
+	input := setupTradeFeeInput(t)
+	ctx := input.ctx
+
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, input.payer))
+	require.Nil(t, setFiatWallet(ctx, input.sk, input.payer, cmTypes.FiatPegWallet{cmTypes.BaseFiatPeg{
+		PegHash: cmTypes.PegHash([]byte("fiat")), TransactionID: "FIAT", TransactionAmount: 1000,
+		ZoneID: []byte("zone"), Currency: "usd"}}))
+
+	_negotiation := negotiation.NewNegotiation(input.payer, sdk.AccAddress([]byte("seller")), cmTypes.PegHash([]byte("asset")))
+	_ = _negotiation.SetBid(1000)
+	require.Nil(t, chargeTradeFee(ctx, input.sk, tradeFees.MsgTypeExecuteOrder, input.payer, "sugar", _negotiation))
+
+	require.Equal(t, int64(900), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.payer)))
+	require.Equal(t, int64(30), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.organization)))
+
+	// the community pool only holds coins, the community share of fiat fees goes to the zone which can redeem it
+	require.Equal(t, int64(70), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.zone)))
+	require.Empty(t, getFiatWallet(ctx, input.sk, input.collector))
+}
+
+func TestChargeTradeFeeWithoutEnoughFiats(t *testing.T) {
+	input := setupTradeFeeInput(t)
+	ctx := input.ctx
+
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, input.payer))
+	require.Nil(t, setFiatWallet(ctx, input.sk, input.payer, cmTypes.FiatPegWallet{cmTypes.BaseFiatPeg{
+		PegHash: cmTypes.PegHash([]byte("fiat")), TransactionID: "FIAT", TransactionAmount: 50}}))
+
+	_negotiation := negotiation.NewNegotiation(input.payer, sdk.AccAddress([]byte("seller")), cmTypes.PegHash([]byte("asset")))
+	_ = _negotiation.SetBid(1000)
+	require.NotNil(t, chargeTradeFee(ctx, input.sk, tradeFees.MsgTypeExecuteOrder, input.payer, "sugar", _negotiation))
+	require.Equal(t, int64(50), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.payer)))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// SetTradingLimits : the zone, or the address or an admin of the organization or its parents, can limit the trading of
+// the organization, and the genesis account, or the zone or organization of the acl account, that of the account
+func (keeper BaseSendKeeper) SetTradingLimits(ctx sdk.Context, setTradingLimit types.SetTradingLimit) sdk.Error {
+	if len(setTradingLimit.OrganizationID) == 0 {
+		if _, err := keeper.checkACLAuthority(ctx, setTradingLimit.From, setTradingLimit.To); err != nil {
+			return err
+		}
+		return keeper.aclKeeper.LimitAccountTrading(ctx, setTradingLimit.To, setTradingLimit.TradingLimit)
+	}
+
+	organization, err := keeper.aclKeeper.GetOrganization(ctx, setTradingLimit.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if !keeper.aclKeeper.CheckValidZoneAddress(ctx, organization.ZoneID, setTradingLimit.From) {
+		if !keeper.aclKeeper.CheckValidOrganizationAddress(ctx, organization.ZoneID, setTradingLimit.OrganizationID,
+			setTradingLimit.From) {
+
+			return sdk.ErrInternal(fmt.Sprintf("Account %v does not have access to limit the trading of "+
+				"organization %v.", setTradingLimit.From.String(), setTradingLimit.OrganizationID.String()))
+		}
+	}
+	return keeper.aclKeeper.LimitOrganizationTrading(ctx, setTradingLimit.OrganizationID, setTradingLimit.TradingLimit)
+}
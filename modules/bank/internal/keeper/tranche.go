@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/orders"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+// SellerExecuteTranche : deliver one consignment of the order against its awb proof, release the pro-rata fiat
+// to the seller and charge it the same part of the execute order trade fee. The last consignment executes the order.
+func (keeper BaseSendKeeper) SellerExecuteTranche(ctx sdk.Context, sellerExecuteTranche types.SellerExecuteTranche) sdk.Error {
+	if err := keeper.checkKYC(ctx, sellerExecuteTranche.BuyerAddress, sellerExecuteTranche.SellerAddress); err != nil {
+		return err
+	}
+	if err := keeper.aclKeeper.CheckCounterparty(ctx, sellerExecuteTranche.BuyerAddress, sellerExecuteTranche.SellerAddress); err != nil {
+		return err
+	}
+	buyerAddress := sellerExecuteTranche.BuyerAddress
+	sellerAddress := sellerExecuteTranche.SellerAddress
+	pegHash := sellerExecuteTranche.PegHash
+
+	err, assetPegWallet, fiatPegWallet, _, orderAWBProofHash := keeper.orderKeeper.GetOrderDetails(ctx, buyerAddress,
+		sellerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	if orderAWBProofHash != "" {
+		return sdk.ErrUnknownRequest("Order is already executed.")
+	}
+
+	i := assetPegWallet.SearchAssetPeg(pegHash)
+	if i == len(assetPegWallet) || assetPegWallet[i].GetPegHash().String() != pegHash.String() {
+		return sdk.ErrInsufficientCoins("Asset token not found!")
+	}
+	assetPeg := assetPegWallet[i]
+
+	err = checkSellerExecuteOrderACL(ctx, keeper, sellerExecuteTranche.MediatorAddress, sellerAddress, assetPeg)
+	if err != nil {
+		return err
+	}
+
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	_negotiation, err := keeper.nk.GetNegotiation(ctx, negotiationID)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight() > _negotiation.GetTime()+_negotiation.GetSellerBlockHeight() {
+		return sdk.ErrInvalidSequence("Negotiation time expired.")
+	}
+	if negotiation.IsBasket(_negotiation) || negotiation.IsSwap(_negotiation) {
+		return sdk.ErrUnknownRequest("Basket and swap orders cannot be delivered in tranches.")
+	}
+	if negotiation.IsCoinSettled(_negotiation) {
+		return sdk.ErrUnknownRequest("Orders settled in coins cannot be delivered in tranches.")
+	}
+
+	tranches := keeper.orderKeeper.GetOrderTranches(ctx, buyerAddress, sellerAddress, pegHash)
+	if orders.HasTranche(tranches, sellerExecuteTranche.AWBProofHash) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Tranche with awb proof %v is already delivered.",
+			sellerExecuteTranche.AWBProofHash))
+	}
+
+	deliveredQuantity := orders.GetDeliveredQuantity(tranches) + sellerExecuteTranche.Quantity
+	if deliveredQuantity > assetPeg.GetAssetQuantity() {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Tranche quantity exceeds the undelivered quantity %v.",
+			assetPeg.GetAssetQuantity()-orders.GetDeliveredQuantity(tranches)))
+	}
+
+	releasedFiatAmount := orders.GetReleasedFiatAmount(tranches)
+	if cmTypes.GetFiatPegWalletBalance(fiatPegWallet) < _negotiation.GetBid()-releasedFiatAmount {
+		return sdk.ErrInsufficientCoins("Fiat tokens not found!")
+	}
+
+	executed := deliveredQuantity == assetPeg.GetAssetQuantity()
+	fiatAmount := sdk.NewInt(_negotiation.GetBid()).MulRaw(sellerExecuteTranche.Quantity).
+		QuoRaw(assetPeg.GetAssetQuantity()).Int64()
+	if executed {
+		fiatAmount = _negotiation.GetBid() - releasedFiatAmount
+	}
+
+	if fiatAmount > 0 {
+		releasedFiatPegWallet, remainingFiatPegWallet := cmTypes.SubtractAmountFromWallet(fiatAmount, fiatPegWallet)
+		keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
+		_ = keeper.orderKeeper.SendFiatsToOrder(ctx, buyerAddress, sellerAddress, pegHash, remainingFiatPegWallet)
+		fiatPegWallet = remainingFiatPegWallet
+
+		sellerFiatWallet := getFiatWallet(ctx, keeper, sellerAddress)
+		sellerFiatWallet = cmTypes.AddFiatPegToWallet(sellerFiatWallet, releasedFiatPegWallet)
+		_ = setFiatWallet(ctx, keeper, sellerAddress, sellerFiatWallet)
+
+		err = chargeTradeFeeOnPart(ctx, keeper, tradeFees.MsgTypeExecuteOrder, sellerAddress, assetPeg.GetAssetType(),
+			_negotiation, releasedFiatAmount, fiatAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	keeper.orderKeeper.AddOrderTranche(ctx, buyerAddress, sellerAddress, pegHash,
+		orders.NewTranche(sellerExecuteTranche.AWBProofHash, sellerExecuteTranche.Quantity, fiatAmount, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteTranche,
+		sdk.NewAttribute("buyer", buyerAddress.String()),
+		sdk.NewAttribute("seller", sellerAddress.String()),
+		sdk.NewAttribute("assetPegHash", pegHash.String()),
+		sdk.NewAttribute("awbProofHash", sellerExecuteTranche.AWBProofHash),
+		sdk.NewAttribute("quantity", strconv.FormatInt(sellerExecuteTranche.Quantity, 10)),
+		sdk.NewAttribute("fiatAmount", strconv.FormatInt(fiatAmount, 10)),
+	))
+
+	if !executed {
+		return nil
+	}
+
+	keeper.orderKeeper.SetOrderAWBProofHash(ctx, buyerAddress, sellerAddress, pegHash, sellerExecuteTranche.AWBProofHash)
+	keeper.reputationKeeper.SetSellerExecuteOrderPositiveTx(ctx, sellerAddress)
+	keeper.reputationKeeper.SetBuyerExecuteOrderPositiveTx(ctx, buyerAddress)
+
+	buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
+	buyerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPeg, buyerAssetWallet)
+	keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPeg)
+	_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
+
+	if len(fiatPegWallet) != 0 {
+		buyerFiatWallet := getFiatWallet(ctx, keeper, buyerAddress)
+		buyerFiatWallet = cmTypes.AddFiatPegToWallet(buyerFiatWallet, fiatPegWallet)
+		keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
+		_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteOrder,
+		sdk.NewAttribute("buyer", buyerAddress.String()),
+		sdk.NewAttribute("seller", sellerAddress.String()),
+		sdk.NewAttribute("assetPegHash", pegHash.String()),
+		sdk.NewAttribute("executed", strconv.FormatBool(true)),
+		sdk.NewAttribute("assetPrice", strconv.FormatInt(_negotiation.GetBid(), 10)),
+		sdk.NewAttribute("reversed", strconv.FormatBool(false)),
+	))
+
+	return nil
+}
+
+// RefundTrancheOrder : once the negotiation expired, refund the fiat left in a partially delivered order to the
+// buyer, give the buyer a new asset peg of the delivered quantity and return the undelivered quantity of the asset
+// to the seller under its peg hash
+func (keeper BaseSendKeeper) RefundTrancheOrder(ctx sdk.Context, refundTrancheOrder types.RefundTrancheOrder) sdk.Error {
+	buyerAddress := refundTrancheOrder.BuyerAddress
+	sellerAddress := refundTrancheOrder.SellerAddress
+	pegHash := refundTrancheOrder.PegHash
+
+	if !refundTrancheOrder.FromAddress.Equals(buyerAddress) && !refundTrancheOrder.FromAddress.Equals(sellerAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("Account %v is not a party of the order.",
+			refundTrancheOrder.FromAddress.String()))
+	}
+
+	err, assetPegWallet, fiatPegWallet, _, orderAWBProofHash := keeper.orderKeeper.GetOrderDetails(ctx, buyerAddress,
+		sellerAddress, pegHash)
+	if err != nil {
+		return err
+	}
+	if orderAWBProofHash != "" {
+		return sdk.ErrUnknownRequest("Order is already executed.")
+	}
+
+	tranches := keeper.orderKeeper.GetOrderTranches(ctx, buyerAddress, sellerAddress, pegHash)
+	if len(tranches) == 0 {
+		return sdk.ErrUnknownRequest("Order has no delivered tranches.")
+	}
+
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	_negotiation, err := keeper.nk.GetNegotiation(ctx, negotiationID)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight() <= _negotiation.GetTime()+_negotiation.GetSellerBlockHeight() {
+		return sdk.ErrUnknownRequest("Negotiation time not expired.")
+	}
+
+	keeper.reputationKeeper.SetSellerExecuteOrderNegativeTx(ctx, sellerAddress)
+
+	if len(fiatPegWallet) != 0 {
+		buyerFiatWallet := getFiatWallet(ctx, keeper, buyerAddress)
+		buyerFiatWallet = cmTypes.AddFiatPegToWallet(buyerFiatWallet, fiatPegWallet)
+		keeper.orderKeeper.SendFiatsFromOrder(ctx, buyerAddress, sellerAddress, pegHash, fiatPegWallet)
+		_ = setFiatWallet(ctx, keeper, buyerAddress, buyerFiatWallet)
+	}
+
+	var deliveredPegHash cmTypes.PegHash
+	i := assetPegWallet.SearchAssetPeg(pegHash)
+	if i < len(assetPegWallet) && assetPegWallet[i].GetPegHash().String() == pegHash.String() {
+		assetPeg := assetPegWallet[i]
+		keeper.orderKeeper.SendAssetFromOrder(ctx, buyerAddress, sellerAddress, &assetPeg)
+
+		deliveredAssetPeg := assetPeg
+		deliveredPegHash, _ = cmTypes.GetAssetPegHashHex(fmt.Sprintf("%x", strconv.Itoa(keeper.ak.GetNextAssetPegHash(ctx))))
+		_ = deliveredAssetPeg.SetPegHash(deliveredPegHash)
+		_ = deliveredAssetPeg.SetAssetQuantity(orders.GetDeliveredQuantity(tranches))
+		buyerAssetWallet := getAssetWallet(ctx, keeper, buyerAddress)
+		buyerAssetWallet = cmTypes.AddAssetPegToWallet(&deliveredAssetPeg, buyerAssetWallet)
+		_ = setAssetWallet(ctx, keeper, buyerAddress, buyerAssetWallet)
+
+		_ = assetPeg.SetAssetQuantity(assetPeg.GetAssetQuantity() - orders.GetDeliveredQuantity(tranches))
+		sellerAssetWallet := getAssetWallet(ctx, keeper, sellerAddress)
+		sellerAssetWallet = cmTypes.AddAssetPegToWallet(&assetPeg, sellerAssetWallet)
+		_ = setAssetWallet(ctx, keeper, sellerAddress, sellerAssetWallet)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundTrancheOrder,
+		sdk.NewAttribute("buyer", buyerAddress.String()),
+		sdk.NewAttribute("seller", sellerAddress.String()),
+		sdk.NewAttribute("assetPegHash", pegHash.String()),
+		sdk.NewAttribute("deliveredQuantity", strconv.FormatInt(orders.GetDeliveredQuantity(tranches), 10)),
+		sdk.NewAttribute("deliveredAssetPegHash", deliveredPegHash.String()),
+		sdk.NewAttribute("refundedFiatAmount", strconv.FormatInt(cmTypes.GetFiatPegWalletBalance(fiatPegWallet), 10)),
+	))
+
+	return nil
+}
+
+func checkSellerExecuteOrderACL(ctx sdk.Context, keeper BaseSendKeeper, mediatorAddress sdk.AccAddress,
+	sellerAddress sdk.AccAddress, assetPeg cmTypes.BaseAssetPeg) sdk.Error {
+
+	var _acl acl.ACL
+	if !assetPeg.GetModerated() {
+		aclAccount, err := keeper.aclKeeper.GetAccountACLDetails(ctx, sellerAddress)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(mediatorAddress, sellerAddress) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("Trade cannot be executed for account %v. Access Denied.",
+				mediatorAddress.String()))
+		}
+		_acl = aclAccount.GetACL()
+	} else {
+		var err sdk.Error
+		_acl, err = keeper.aclKeeper.CheckZoneAndGetACL(ctx, mediatorAddress, sellerAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !_acl.SellerExecuteOrder {
+		return sdk.ErrInternal(fmt.Sprintf("Trade cannot be executed for account %v. Access Denied.",
+			sellerAddress.String()))
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees"
+
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+)
+
+type trancheInput struct {
+	testInput
+	sk      BaseSendKeeper
+	buyer   sdk.AccAddress
+	seller  sdk.AccAddress
+	pegHash cmTypes.PegHash
+}
+
+// setupTrancheInput : an order of 10 units of an asset against a bid of 1000, with a negotiation expiring at height 11
+func setupTrancheInput(t *testing.T, bid int64) trancheInput {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	sk := input.k.(BaseKeeper).BaseSendKeeper
+
+	buyer, seller := sdk.AccAddress([]byte("buyer")), sdk.AccAddress([]byte("seller"))
+	for _, address := range []sdk.AccAddress{buyer, seller} {
+		input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, address))
+		require.Nil(t, input.aclKeeper.SetACLAccount(ctx, &acl.BaseACLAccount{
+			Address:        address,
+			ZoneID:         acl.ZoneID([]byte("zone")),
+			OrganizationID: acl.OrganizationID([]byte("organization")),
+			ACL:            acl.ACL{SellerExecuteOrder: true, BuyerExecuteOrder: true},
+			Status:         acl.ACLStatusActive,
+		}))
+	}
+
+	pegHash := cmTypes.PegHash([]byte("asset"))
+	assetPeg := cmTypes.BaseAssetPeg{PegHash: pegHash, DocumentHash: "document", AssetType: "sugar", AssetQuantity: 10,
+		AssetPrice: 100, QuantityUnit: "tonnes", OwnerAddress: seller}
+	require.Nil(t, input.orderKeeper.SendAssetsToOrder(ctx, seller, buyer, &assetPeg))
+	fiatPeg := cmTypes.BaseFiatPeg{PegHash: cmTypes.PegHash([]byte("fiat")), TransactionID: "FIAT", TransactionAmount: bid}
+	require.Nil(t, input.orderKeeper.SendFiatsToOrder(ctx, buyer, seller, pegHash, cmTypes.FiatPegWallet{fiatPeg}))
+
+	_negotiation := negotiation.NewNegotiation(buyer, seller, pegHash)
+	_ = _negotiation.SetBid(bid)
+	_ = _negotiation.SetTime(10)
+	_ = _negotiation.SetSellerBlockHeight(1)
+	input.nk.SetNegotiation(ctx, _negotiation)
+
+	return trancheInput{input, sk, buyer, seller, pegHash}
+}
+
+func TestSellerExecuteTranches(t *testing.T) {
+	input := setupTrancheInput(t, 1000)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB1", 3)))
+	require.Equal(t, int64(300), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+
+	require.NotNil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB1", 3)))
+	require.NotNil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB2", 8)))
+
+	// the last tranche executes the order and releases the rest of the bid
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB2", 7)))
+	require.Equal(t, int64(1000), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+
+	buyerAssetWallet := getAssetWallet(ctx, input.sk, input.buyer)
+	require.Len(t, buyerAssetWallet, 1)
+	require.Equal(t, int64(10), buyerAssetWallet[0].GetAssetQuantity())
+}
+
+func TestSellerExecuteTranchesChargeTheTradeFeePerTranche(t *testing.T) {
+	input := setupTrancheInput(t, 1000)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	// a fee of 10% of the bid plus 1, a third of it to the zone and the rest to the organization
+	zone, organization := sdk.AccAddress([]byte("zone")), sdk.AccAddress([]byte("organization"))
+	require.Nil(t, input.aclKeeper.SetZoneAddress(ctx, acl.ZoneID([]byte("zone")), zone))
+	require.Nil(t, input.aclKeeper.SetOrganization(ctx, acl.OrganizationID([]byte("organization")),
+		acl.NewOrganization(organization, acl.ZoneID([]byte("zone")))))
+	input.tradeFeeKeeper.SetTradeFee(ctx, tradeFees.NewTradeFee(acl.ZoneID([]byte("zone")), tradeFees.MsgTypeExecuteOrder, "",
+		sdk.NewDecWithPrec(1, 1), 1, sdk.ZeroDec(), sdk.OneDec()))
+
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB1", 3)))
+	require.Equal(t, int64(300-30), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+	require.Equal(t, int64(30), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, organization)))
+
+	// the tranches add up to the fee on the whole bid
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB2", 7)))
+	require.Equal(t, int64(1000-101), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+	require.Equal(t, int64(101), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, organization)))
+	require.Empty(t, getFiatWallet(ctx, input.sk, zone))
+}
+
+func TestSellerExecuteTrancheDoesNotOverflow(t *testing.T) {
+	input := setupTrancheInput(t, 1<<62)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB1", 5)))
+	require.Equal(t, int64(1<<61), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+}
+
+func TestRefundTrancheOrder(t *testing.T) {
+	input := setupTrancheInput(t, 1000)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	require.Nil(t, input.sk.SellerExecuteTranche(ctx, types.NewSellerExecuteTranche(input.seller, input.buyer,
+		input.seller, input.pegHash, "AWB1", 4)))
+	require.NotNil(t, input.sk.RefundTrancheOrder(ctx, types.NewRefundTrancheOrder(input.buyer, input.buyer,
+		input.seller, input.pegHash)))
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NotNil(t, input.sk.RefundTrancheOrder(ctx, types.NewRefundTrancheOrder(sdk.AccAddress([]byte("other")),
+		input.buyer, input.seller, input.pegHash)))
+	require.Nil(t, input.sk.RefundTrancheOrder(ctx, types.NewRefundTrancheOrder(input.buyer, input.buyer,
+		input.seller, input.pegHash)))
+
+	require.Equal(t, int64(600), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.buyer)))
+	require.Equal(t, int64(400), cmTypes.GetFiatPegWalletBalance(getFiatWallet(ctx, input.sk, input.seller)))
+
+	// the buyer holds a new peg of the delivered quantity, the seller keeps the peg hash with the rest
+	buyerAssetWallet := getAssetWallet(ctx, input.sk, input.buyer)
+	require.Len(t, buyerAssetWallet, 1)
+	require.Equal(t, int64(4), buyerAssetWallet[0].GetAssetQuantity())
+	require.NotEqual(t, input.pegHash.String(), buyerAssetWallet[0].GetPegHash().String())
+	require.Equal(t, "sugar", buyerAssetWallet[0].GetAssetType())
+
+	sellerAssetWallet := getAssetWallet(ctx, input.sk, input.seller)
+	require.Len(t, sellerAssetWallet, 1)
+	require.Equal(t, int64(6), sellerAssetWallet[0].GetAssetQuantity())
+	require.Equal(t, input.pegHash.String(), sellerAssetWallet[0].GetPegHash().String())
+
+	_, assetPegWallet, fiatPegWallet, _, _ := input.orderKeeper.GetOrderDetails(ctx, input.buyer, input.seller, input.pegHash)
+	require.Empty(t, assetPegWallet)
+	require.Empty(t, fiatPegWallet)
+}
@@ -16,6 +16,16 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(DefineZone{}, "commit-blockchain/DefineZone", nil)
 	cdc.RegisterConcrete(MsgDefineOrganizations{}, "commit-blockchain/MsgDefineOrganizations", nil)
 	cdc.RegisterConcrete(MsgDefineACLs{}, "commit-blockchain/MsgDefineACLs", nil)
+	cdc.RegisterConcrete(MsgDefineRoles{}, "commit-blockchain/MsgDefineRoles", nil)
+	cdc.RegisterConcrete(MsgSuspendACLs{}, "commit-blockchain/MsgSuspendACLs", nil)
+	cdc.RegisterConcrete(MsgReinstateACLs{}, "commit-blockchain/MsgReinstateACLs", nil)
+	cdc.RegisterConcrete(MsgRevokeACLs{}, "commit-blockchain/MsgRevokeACLs", nil)
+	cdc.RegisterConcrete(MsgRotateZoneAddresses{}, "commit-blockchain/MsgRotateZoneAddresses", nil)
+	cdc.RegisterConcrete(MsgRotateOrganizationAddresses{}, "commit-blockchain/MsgRotateOrganizationAddresses", nil)
+	cdc.RegisterConcrete(MsgDefineSubOrganizations{}, "commit-blockchain/MsgDefineSubOrganizations", nil)
+	cdc.RegisterConcrete(MsgSetOrganizationAdmins{}, "commit-blockchain/MsgSetOrganizationAdmins", nil)
+	cdc.RegisterConcrete(MsgRecordKYCs{}, "commit-blockchain/MsgRecordKYCs", nil)
+	cdc.RegisterConcrete(MsgSetTradingLimits{}, "commit-blockchain/MsgSetTradingLimits", nil)
 	cdc.RegisterConcrete(MsgBankIssueAssets{}, "cosmos-sdk/MsgBankIssueAssets", nil)
 	cdc.RegisterConcrete(MsgBankReleaseAssets{}, "commit-blockchain/MsgBankReleaseAssets", nil)
 	cdc.RegisterConcrete(MsgBankRedeemAssets{}, "cosmos-sdk/MsgBankRedeemAssets", nil)
@@ -25,6 +35,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBankSendFiats{}, "cosmos-sdk/MsgBankSendFiats", nil)
 	cdc.RegisterConcrete(MsgBankSellerExecuteOrders{}, "cosmos-sdk/MsgBankSellerExecuteOrders", nil)
 	cdc.RegisterConcrete(MsgBankBuyerExecuteOrders{}, "cosmos-sdk/MsgBankBuyerExecuteOrders", nil)
+	cdc.RegisterConcrete(MsgBankSellerExecuteTranches{}, "commit-blockchain/MsgBankSellerExecuteTranches", nil)
+	cdc.RegisterConcrete(MsgBankRefundTrancheOrders{}, "commit-blockchain/MsgBankRefundTrancheOrders", nil)
+	cdc.RegisterConcrete(MsgBankSendSwapAssets{}, "commit-blockchain/MsgBankSendSwapAssets", nil)
+	cdc.RegisterConcrete(MsgBankExecuteSwaps{}, "commit-blockchain/MsgBankExecuteSwaps", nil)
+	cdc.RegisterConcrete(MsgBankSendCoinsToOrders{}, "commit-blockchain/MsgBankSendCoinsToOrders", nil)
+	cdc.RegisterConcrete(MsgBankConsolidateFiats{}, "commit-blockchain/MsgBankConsolidateFiats", nil)
+	cdc.RegisterConcrete(MsgBankClawbacks{}, "commit-blockchain/MsgBankClawbacks", nil)
+	cdc.RegisterConcrete(ClawbackProposal{}, "commit-blockchain/ClawbackProposal", nil)
 	cdc.RegisterInterface((*acl.ACLAccount)(nil), nil)
 	cdc.RegisterConcrete(&acl.BaseACLAccount{}, "commit-blockchain/AclAccount", nil)
 	cdc.RegisterInterface((*types.AssetPeg)(nil), nil)
@@ -11,6 +11,7 @@ const (
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
 	CodeNegativeAmount       sdk.CodeType = 103
+	CodeClawbackGoverned     sdk.CodeType = 104
 )
 
 // ErrNoInputs is an error
@@ -39,3 +40,8 @@ func ErrNegativeAmount(codeSpace sdk.CodespaceType, msg string) sdk.Error {
 	}
 	return sdk.NewError(codeSpace, CodeNegativeAmount, "Amount should not be zero")
 }
+
+// ErrClawbackGoverned is an error
+func ErrClawbackGoverned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeClawbackGoverned, "clawbacks are governed, they are executed by a clawback proposal")
+}
@@ -12,6 +12,15 @@ var (
 	EventTypeExecuteOrder = "executeOrder"
 	EventTypeReleaseAsset = "releaseAsset"
 
+	EventTypeExecuteTranche     = "executeTranche"
+	EventTypeRefundTrancheOrder = "refundTrancheOrder"
+	EventTypeSendSwapAsset      = "sendSwapAsset"
+	EventTypeExecuteSwap        = "executeSwap"
+	EventTypeSendCoinToOrder    = "sendCoinToOrder"
+	EventTypeConsolidateFiat    = "consolidateFiat"
+	EventTypeChargeTradeFee     = "chargeTradeFee"
+	EventTypeClawback           = "clawback"
+
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
 
@@ -4,18 +4,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	supplyExported "github.com/commitHub/commitBlockchain/modules/supply/exported"
+	cmTypes "github.com/commitHub/commitBlockchain/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
 // creating a x/bank keeper.
 type AccountKeeper interface {
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) exported.Account
+	NewAccount(ctx sdk.Context, acc exported.Account) exported.Account
 
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
 	GetAllAccounts(ctx sdk.Context) []exported.Account
 	SetAccount(ctx sdk.Context, acc exported.Account)
 
 	GetNextAssetPegHash(ctx sdk.Context) int
+	GetAssetPegHashCount(ctx sdk.Context) int
 	GetNextFiatPegHash(ctx sdk.Context) int
 
 	IterateAccounts(ctx sdk.Context, process func(exported.Account) bool)
@@ -24,3 +28,19 @@ type AccountKeeper interface {
 type ReputationKeeper interface {
 	SetSendAssetsPositiveTx(ctx sdk.Context)
 }
+
+// ReserveKeeper : keeper counting the fiat issued and redeemed by zones
+type ReserveKeeper interface {
+	GetTotalOutstandingFiat(ctx sdk.Context) sdk.Coins
+}
+
+// PegEscrowKeeper : keeper of a module holding fiat and asset pegs outside of account wallets
+type PegEscrowKeeper interface {
+	GetEscrowedFiatPegWallet(ctx sdk.Context) cmTypes.FiatPegWallet
+	GetEscrowedAssetPegWallet(ctx sdk.Context) cmTypes.AssetPegWallet
+}
+
+// SupplyKeeper : keeper of the module accounts holding escrowed order coins and collected trade fees
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyExported.ModuleAccountI
+}
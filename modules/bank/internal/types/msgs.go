@@ -457,6 +457,8 @@ func (in IssueFiat) ValidateBasic() sdk.Error {
 		return ErrNegativeAmount(DefaultCodespace, "Transaction amount should be grater than 0.")
 	} else if in.FiatPeg.GetTransactionID() == "" {
 		return sdk.ErrUnknownRequest("Transaction should not be empty")
+	} else if !(sdk.Coin{Denom: in.FiatPeg.GetCurrency(), Amount: sdk.ZeroInt()}).IsValid() {
+		return sdk.ErrInvalidCoins("Currency should be a valid denom")
 	}
 	return nil
 }
@@ -535,11 +537,12 @@ type RedeemFiat struct {
 	RedeemerAddress sdk.AccAddress `json:"redeemerAddress"`
 	IssuerAddress   sdk.AccAddress `json:"issuerAddress"`
 	Amount          int64          `json:"amount"`
+	BankReference   string         `json:"bankReference"`
 }
 
 // NewRedeemFiat : initializer
-func NewRedeemFiat(redeemerAddress sdk.AccAddress, issuerAddress sdk.AccAddress, amount int64) RedeemFiat {
-	return RedeemFiat{redeemerAddress, issuerAddress, amount}
+func NewRedeemFiat(redeemerAddress sdk.AccAddress, issuerAddress sdk.AccAddress, amount int64, bankReference string) RedeemFiat {
+	return RedeemFiat{redeemerAddress, issuerAddress, amount, bankReference}
 }
 
 // GetSignBytes : get bytes to sign
@@ -548,10 +551,12 @@ func (in RedeemFiat) GetSignBytes() []byte {
 		RedeemerAddress string `json:"redeemerAddress"`
 		IssuerAddress   string `json:"issuerAddress"`
 		Amount          int64  `json:"amount"`
+		BankReference   string `json:"bankReference"`
 	}{
 		RedeemerAddress: in.RedeemerAddress.String(),
 		IssuerAddress:   in.IssuerAddress.String(),
 		Amount:          in.Amount,
+		BankReference:   in.BankReference,
 	})
 	if err != nil {
 		panic(err)
@@ -565,6 +570,8 @@ func (in RedeemFiat) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(in.RedeemerAddress.String())
 	} else if in.Amount <= 0 {
 		return sdk.ErrUnknownRequest("Amount should be Positive")
+	} else if len(in.BankReference) == 0 {
+		return sdk.ErrUnknownRequest("BankReference should not be empty")
 	}
 	return nil
 }
@@ -1095,30 +1102,1932 @@ func (msg MsgBankSellerExecuteOrders) GetSigners() []sdk.AccAddress {
 
 // #####MsgBankSellerExecuteOrders
 
+// *****SellerExecuteTranche
+
+// SellerExecuteTranche - transaction input
+type SellerExecuteTranche struct {
+	MediatorAddress sdk.AccAddress `json:"mediatorAddress"`
+	BuyerAddress    sdk.AccAddress `json:"buyerAddress"`
+	SellerAddress   sdk.AccAddress `json:"sellerAddress"`
+	PegHash         types.PegHash  `json:"pegHash"`
+	AWBProofHash    string         `json:"awbProofHash"`
+	Quantity        int64          `json:"quantity"`
+}
+
+// NewSellerExecuteTranche : initializer
+func NewSellerExecuteTranche(mediatorAddress sdk.AccAddress, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress, pegHash types.PegHash, awbProofHash string, quantity int64) SellerExecuteTranche {
+	return SellerExecuteTranche{mediatorAddress, buyerAddress, sellerAddress, pegHash, awbProofHash, quantity}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SellerExecuteTranche) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		MediatorAddress string `json:"mediatorAddress"`
+		BuyerAddress    string `json:"buyerAddress"`
+		SellerAddress   string `json:"sellerAddress"`
+		PegHash         string `json:"pegHash"`
+		AWBProofHash    string `json:"awbProofHash"`
+		Quantity        int64  `json:"quantity"`
+	}{
+		MediatorAddress: in.MediatorAddress.String(),
+		BuyerAddress:    in.BuyerAddress.String(),
+		SellerAddress:   in.SellerAddress.String(),
+		PegHash:         in.PegHash.String(),
+		AWBProofHash:    in.AWBProofHash,
+		Quantity:        in.Quantity,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in SellerExecuteTranche) ValidateBasic() sdk.Error {
+	if len(in.MediatorAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.MediatorAddress.String())
+	} else if len(in.SellerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.SellerAddress.String())
+	} else if len(in.BuyerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.BuyerAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	} else if in.AWBProofHash == "" {
+		return sdk.ErrUnknownRequest("AWBProofHash is Empty")
+	} else if in.Quantity <= 0 {
+		return ErrNegativeAmount(DefaultCodespace, "Quantity should be positive")
+	}
+	return nil
+}
+
+// #####SellerExecuteTranche
+
+// *****MsgBankSellerExecuteTranches
+
+// MsgBankSellerExecuteTranches : deliver tranches of split shipment orders
+type MsgBankSellerExecuteTranches struct {
+	SellerExecuteTranches []SellerExecuteTranche `json:"sellerExecuteTranches"`
+}
+
+// NewMsgBankSellerExecuteTranches : initilizer
+func NewMsgBankSellerExecuteTranches(sellerExecuteTranches []SellerExecuteTranche) MsgBankSellerExecuteTranches {
+	return MsgBankSellerExecuteTranches{sellerExecuteTranches}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankSellerExecuteTranches{}
+
+// Type : implements msg
+func (msg MsgBankSellerExecuteTranches) Type() string { return "bank" }
+
+func (msg MsgBankSellerExecuteTranches) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankSellerExecuteTranches) ValidateBasic() sdk.Error {
+	if len(msg.SellerExecuteTranches) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.SellerExecuteTranches {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankSellerExecuteTranches) GetSignBytes() []byte {
+	var sellerExecuteTranches []json.RawMessage
+	for _, sellerExecuteTranche := range msg.SellerExecuteTranches {
+		sellerExecuteTranches = append(sellerExecuteTranches, sellerExecuteTranche.GetSignBytes())
+	}
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SellerExecuteTranches []json.RawMessage `json:"sellerExecuteTranches"`
+	}{
+		SellerExecuteTranches: sellerExecuteTranches,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankSellerExecuteTranches) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SellerExecuteTranches))
+	for i, in := range msg.SellerExecuteTranches {
+		addrs[i] = in.MediatorAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankSellerExecuteTranches
+
+// *****RefundTrancheOrder
+
+// RefundTrancheOrder - transaction input
+type RefundTrancheOrder struct {
+	FromAddress   sdk.AccAddress `json:"fromAddress"`
+	BuyerAddress  sdk.AccAddress `json:"buyerAddress"`
+	SellerAddress sdk.AccAddress `json:"sellerAddress"`
+	PegHash       types.PegHash  `json:"pegHash"`
+}
+
+// NewRefundTrancheOrder : initializer
+func NewRefundTrancheOrder(fromAddress sdk.AccAddress, buyerAddress sdk.AccAddress, sellerAddress sdk.AccAddress, pegHash types.PegHash) RefundTrancheOrder {
+	return RefundTrancheOrder{fromAddress, buyerAddress, sellerAddress, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RefundTrancheOrder) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress   string `json:"fromAddress"`
+		BuyerAddress  string `json:"buyerAddress"`
+		SellerAddress string `json:"sellerAddress"`
+		PegHash       string `json:"pegHash"`
+	}{
+		FromAddress:   in.FromAddress.String(),
+		BuyerAddress:  in.BuyerAddress.String(),
+		SellerAddress: in.SellerAddress.String(),
+		PegHash:       in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RefundTrancheOrder) ValidateBasic() sdk.Error {
+	if len(in.FromAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.SellerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.SellerAddress.String())
+	} else if len(in.BuyerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.BuyerAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	}
+	return nil
+}
+
+// #####RefundTrancheOrder
+
+// *****MsgBankRefundTrancheOrders
+
+// MsgBankRefundTrancheOrders : refund the undelivered part of expired split shipment orders
+type MsgBankRefundTrancheOrders struct {
+	RefundTrancheOrders []RefundTrancheOrder `json:"refundTrancheOrders"`
+}
+
+// NewMsgBankRefundTrancheOrders : initilizer
+func NewMsgBankRefundTrancheOrders(refundTrancheOrders []RefundTrancheOrder) MsgBankRefundTrancheOrders {
+	return MsgBankRefundTrancheOrders{refundTrancheOrders}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankRefundTrancheOrders{}
+
+// Type : implements msg
+func (msg MsgBankRefundTrancheOrders) Type() string { return "bank" }
+
+func (msg MsgBankRefundTrancheOrders) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankRefundTrancheOrders) ValidateBasic() sdk.Error {
+	if len(msg.RefundTrancheOrders) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.RefundTrancheOrders {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankRefundTrancheOrders) GetSignBytes() []byte {
+	var refundTrancheOrders []json.RawMessage
+	for _, refundTrancheOrder := range msg.RefundTrancheOrders {
+		refundTrancheOrders = append(refundTrancheOrders, refundTrancheOrder.GetSignBytes())
+	}
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RefundTrancheOrders []json.RawMessage `json:"refundTrancheOrders"`
+	}{
+		RefundTrancheOrders: refundTrancheOrders,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankRefundTrancheOrders) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.RefundTrancheOrders))
+	for i, in := range msg.RefundTrancheOrders {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankRefundTrancheOrders
+
+// *****SendSwapAsset
+
+// SendSwapAsset - escrow the asset pegs the buyer swaps into the order
+type SendSwapAsset struct {
+	FromAddress sdk.AccAddress `json:"fromAddress"`
+	ToAddress   sdk.AccAddress `json:"toAddress"`
+	PegHash     types.PegHash  `json:"pegHash"`
+}
+
+// NewSendSwapAsset : initializer
+func NewSendSwapAsset(fromAddress sdk.AccAddress, toAddress sdk.AccAddress, pegHash types.PegHash) SendSwapAsset {
+	return SendSwapAsset{fromAddress, toAddress, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SendSwapAsset) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		ToAddress   string `json:"toAddress"`
+		PegHash     string `json:"pegHash"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		ToAddress:   in.ToAddress.String(),
+		PegHash:     in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in SendSwapAsset) ValidateBasic() sdk.Error {
+	if len(in.FromAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.ToAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.ToAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is empty")
+	}
+	return nil
+}
+
+// #####SendSwapAsset
+
+// *****MsgBankSendSwapAssets
+
+// MsgBankSendSwapAssets : escrow swapped asset pegs of the buyer
+type MsgBankSendSwapAssets struct {
+	SendSwapAssets []SendSwapAsset `json:"sendSwapAssets"`
+}
+
+// NewMsgBankSendSwapAssets : initilizer
+func NewMsgBankSendSwapAssets(sendSwapAssets []SendSwapAsset) MsgBankSendSwapAssets {
+	return MsgBankSendSwapAssets{sendSwapAssets}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankSendSwapAssets{}
+
+// Type : implements msg
+func (msg MsgBankSendSwapAssets) Type() string { return "bank" }
+
+func (msg MsgBankSendSwapAssets) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankSendSwapAssets) ValidateBasic() sdk.Error {
+	if len(msg.SendSwapAssets) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.SendSwapAssets {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankSendSwapAssets) GetSignBytes() []byte {
+	var sendSwapAssets []json.RawMessage
+	for _, sendSwapAsset := range msg.SendSwapAssets {
+		sendSwapAssets = append(sendSwapAssets, sendSwapAsset.GetSignBytes())
+	}
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SendSwapAssets []json.RawMessage `json:"sendSwapAssets"`
+	}{
+		SendSwapAssets: sendSwapAssets,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankSendSwapAssets) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SendSwapAssets))
+	for i, in := range msg.SendSwapAssets {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankSendSwapAssets
+
+// *****ExecuteSwap
+
+// ExecuteSwap - a party of a swap order proves the delivery of its asset pegs
+type ExecuteSwap struct {
+	MediatorAddress  sdk.AccAddress `json:"mediatorAddress"`
+	DelivererAddress sdk.AccAddress `json:"delivererAddress"`
+	BuyerAddress     sdk.AccAddress `json:"buyerAddress"`
+	SellerAddress    sdk.AccAddress `json:"sellerAddress"`
+	PegHash          types.PegHash  `json:"pegHash"`
+	AWBProofHash     string         `json:"awbProofHash"`
+}
+
+// NewExecuteSwap : initializer
+func NewExecuteSwap(mediatorAddress sdk.AccAddress, delivererAddress sdk.AccAddress, buyerAddress sdk.AccAddress,
+	sellerAddress sdk.AccAddress, pegHash types.PegHash, awbProofHash string) ExecuteSwap {
+	return ExecuteSwap{mediatorAddress, delivererAddress, buyerAddress, sellerAddress, pegHash, awbProofHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ExecuteSwap) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		MediatorAddress  string `json:"mediatorAddress"`
+		DelivererAddress string `json:"delivererAddress"`
+		BuyerAddress     string `json:"buyerAddress"`
+		SellerAddress    string `json:"sellerAddress"`
+		PegHash          string `json:"pegHash"`
+		AWBProofHash     string `json:"awbProofHash"`
+	}{
+		MediatorAddress:  in.MediatorAddress.String(),
+		DelivererAddress: in.DelivererAddress.String(),
+		BuyerAddress:     in.BuyerAddress.String(),
+		SellerAddress:    in.SellerAddress.String(),
+		PegHash:          in.PegHash.String(),
+		AWBProofHash:     in.AWBProofHash,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ExecuteSwap) ValidateBasic() sdk.Error {
+	if len(in.MediatorAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.MediatorAddress.String())
+	} else if len(in.DelivererAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.DelivererAddress.String())
+	} else if len(in.SellerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.SellerAddress.String())
+	} else if len(in.BuyerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.BuyerAddress.String())
+	} else if !in.DelivererAddress.Equals(in.BuyerAddress) && !in.DelivererAddress.Equals(in.SellerAddress) {
+		return sdk.ErrUnknownRequest("Deliverer should be the buyer or the seller")
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	} else if in.AWBProofHash == "" {
+		return sdk.ErrUnknownRequest("AWBProofHash is Empty")
+	}
+	return nil
+}
+
+// #####ExecuteSwap
+
+// *****MsgBankExecuteSwaps
+
+// MsgBankExecuteSwaps : execute asset for asset swap orders
+type MsgBankExecuteSwaps struct {
+	ExecuteSwaps []ExecuteSwap `json:"executeSwaps"`
+}
+
+// NewMsgBankExecuteSwaps : initilizer
+func NewMsgBankExecuteSwaps(executeSwaps []ExecuteSwap) MsgBankExecuteSwaps {
+	return MsgBankExecuteSwaps{executeSwaps}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankExecuteSwaps{}
+
+// Type : implements msg
+func (msg MsgBankExecuteSwaps) Type() string { return "bank" }
+
+func (msg MsgBankExecuteSwaps) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankExecuteSwaps) ValidateBasic() sdk.Error {
+	if len(msg.ExecuteSwaps) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.ExecuteSwaps {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankExecuteSwaps) GetSignBytes() []byte {
+	var executeSwaps []json.RawMessage
+	for _, executeSwap := range msg.ExecuteSwaps {
+		executeSwaps = append(executeSwaps, executeSwap.GetSignBytes())
+	}
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ExecuteSwaps []json.RawMessage `json:"executeSwaps"`
+	}{
+		ExecuteSwaps: executeSwaps,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankExecuteSwaps) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.ExecuteSwaps))
+	for i, in := range msg.ExecuteSwaps {
+		addrs[i] = in.MediatorAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankExecuteSwaps
+
+// *****SendCoinToOrder
+
+// SendCoinToOrder - escrow settlement coins into the order
+type SendCoinToOrder struct {
+	FromAddress sdk.AccAddress `json:"fromAddress"`
+	ToAddress   sdk.AccAddress `json:"toAddress"`
+	PegHash     types.PegHash  `json:"pegHash"`
+	Amount      sdk.Coins      `json:"amount"`
+}
+
+// NewSendCoinToOrder : initializer
+func NewSendCoinToOrder(fromAddress sdk.AccAddress, toAddress sdk.AccAddress, pegHash types.PegHash, amount sdk.Coins) SendCoinToOrder {
+	return SendCoinToOrder{fromAddress, toAddress, pegHash, amount}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SendCoinToOrder) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		ToAddress   string `json:"toAddress"`
+		PegHash     string `json:"pegHash"`
+		Amount      string `json:"amount"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		ToAddress:   in.ToAddress.String(),
+		PegHash:     in.PegHash.String(),
+		Amount:      in.Amount.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in SendCoinToOrder) ValidateBasic() sdk.Error {
+	if len(in.FromAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.ToAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.ToAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	} else if !in.Amount.IsValid() || len(in.Amount) != 1 {
+		return sdk.ErrInvalidCoins("Amount should be a positive amount of the settlement denomination")
+	}
+	return nil
+}
+
+// #####SendCoinToOrder
+
+// *****MsgBankSendCoinsToOrders
+
+// MsgBankSendCoinsToOrders : escrow settlement coins into orders
+type MsgBankSendCoinsToOrders struct {
+	SendCoinsToOrders []SendCoinToOrder `json:"sendCoinsToOrders"`
+}
+
+// NewMsgBankSendCoinsToOrders : initilizer
+func NewMsgBankSendCoinsToOrders(sendCoinsToOrders []SendCoinToOrder) MsgBankSendCoinsToOrders {
+	return MsgBankSendCoinsToOrders{sendCoinsToOrders}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankSendCoinsToOrders{}
+
+// Type : implements msg
+func (msg MsgBankSendCoinsToOrders) Type() string { return "bank" }
+
+func (msg MsgBankSendCoinsToOrders) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankSendCoinsToOrders) ValidateBasic() sdk.Error {
+	if len(msg.SendCoinsToOrders) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.SendCoinsToOrders {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankSendCoinsToOrders) GetSignBytes() []byte {
+	var sendCoinsToOrders []json.RawMessage
+	for _, sendCoinToOrder := range msg.SendCoinsToOrders {
+		sendCoinsToOrders = append(sendCoinsToOrders, sendCoinToOrder.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SendCoinsToOrders []json.RawMessage `json:"sendCoinsToOrders"`
+	}{
+		SendCoinsToOrders: sendCoinsToOrders,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankSendCoinsToOrders) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SendCoinsToOrders))
+	for i, in := range msg.SendCoinsToOrders {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankSendCoinsToOrders
+
+// *****ConsolidateFiat
+
+// ConsolidateFiat - merge fiat pegs of a wallet issued by the same zone in the same currency into one fiat peg
+type ConsolidateFiat struct {
+	FromAddress sdk.AccAddress  `json:"fromAddress"`
+	PegHashes   []types.PegHash `json:"pegHashes"`
+}
+
+// NewConsolidateFiat : initializer
+func NewConsolidateFiat(fromAddress sdk.AccAddress, pegHashes []types.PegHash) ConsolidateFiat {
+	return ConsolidateFiat{fromAddress, pegHashes}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ConsolidateFiat) GetSignBytes() []byte {
+	var pegHashes []string
+	for _, pegHash := range in.PegHashes {
+		pegHashes = append(pegHashes, pegHash.String())
+	}
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string   `json:"fromAddress"`
+		PegHashes   []string `json:"pegHashes"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		PegHashes:   pegHashes,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ConsolidateFiat) ValidateBasic() sdk.Error {
+	if len(in.FromAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.PegHashes) == 1 {
+		return sdk.ErrUnknownRequest("At least two PegHashes should be consolidated")
+	}
+	return nil
+}
+
+// #####ConsolidateFiat
+
+// *****MsgBankConsolidateFiats
+
+// MsgBankConsolidateFiats : merge fiat pegs of wallets
+type MsgBankConsolidateFiats struct {
+	ConsolidateFiats []ConsolidateFiat `json:"consolidateFiats"`
+}
+
+// NewMsgBankConsolidateFiats : initilizer
+func NewMsgBankConsolidateFiats(consolidateFiats []ConsolidateFiat) MsgBankConsolidateFiats {
+	return MsgBankConsolidateFiats{consolidateFiats}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankConsolidateFiats{}
+
+// Type : implements msg
+func (msg MsgBankConsolidateFiats) Type() string { return "bank" }
+
+func (msg MsgBankConsolidateFiats) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankConsolidateFiats) ValidateBasic() sdk.Error {
+	if len(msg.ConsolidateFiats) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.ConsolidateFiats {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankConsolidateFiats) GetSignBytes() []byte {
+	var consolidateFiats []json.RawMessage
+	for _, consolidateFiat := range msg.ConsolidateFiats {
+		consolidateFiats = append(consolidateFiats, consolidateFiat.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ConsolidateFiats []json.RawMessage `json:"consolidateFiats"`
+	}{
+		ConsolidateFiats: consolidateFiats,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankConsolidateFiats) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.ConsolidateFiats))
+	for i, in := range msg.ConsolidateFiats {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankConsolidateFiats
+
 // *****ReleaseAsset
 
-// ReleaseAsset - transaction input
-type ReleaseAsset struct {
-	ZoneAddress  sdk.AccAddress `json:"zoneAddress"`
-	OwnerAddress sdk.AccAddress `json:"ownerAddress"`
-	PegHash      types.PegHash  `json:"pegHash"`
+// ReleaseAsset - transaction input
+type ReleaseAsset struct {
+	ZoneAddress  sdk.AccAddress `json:"zoneAddress"`
+	OwnerAddress sdk.AccAddress `json:"ownerAddress"`
+	PegHash      types.PegHash  `json:"pegHash"`
+}
+
+// NewReleaseAsset : initializer
+func NewReleaseAsset(zoneAddress sdk.AccAddress, ownerAddress sdk.AccAddress, pegHash types.PegHash) ReleaseAsset {
+	return ReleaseAsset{zoneAddress, ownerAddress, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ReleaseAsset) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		ZoneAddress  string `json:"zoneAddress"`
+		OwnerAddress string `json:"ownerAddress"`
+		PegHash      string `json:"pegHash"`
+	}{
+		ZoneAddress:  in.ZoneAddress.String(),
+		OwnerAddress: in.OwnerAddress.String(),
+		PegHash:      in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ReleaseAsset) ValidateBasic() sdk.Error {
+	if len(in.OwnerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.OwnerAddress.String())
+	} else if len(in.ZoneAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.ZoneAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	}
+	return nil
+}
+
+// #####ReleaseAsset
+
+// *****MsgBankReleaseAssets
+
+// MsgBankReleaseAssets : high level release of asset module
+type MsgBankReleaseAssets struct {
+	ReleaseAssets []ReleaseAsset `json:"releseAssets"`
+}
+
+// NewMsgBankReleaseAssets : initilizer
+func NewMsgBankReleaseAssets(releseAsset []ReleaseAsset) MsgBankReleaseAssets {
+	return MsgBankReleaseAssets{releseAsset}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankReleaseAssets{}
+
+// Type : implements msg
+func (msg MsgBankReleaseAssets) Type() string { return "bank" }
+
+func (msg MsgBankReleaseAssets) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankReleaseAssets) ValidateBasic() sdk.Error {
+	if len(msg.ReleaseAssets) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.ReleaseAssets {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankReleaseAssets) GetSignBytes() []byte {
+	var releaseAssets []json.RawMessage
+	for _, releaseAsset := range msg.ReleaseAssets {
+		releaseAssets = append(releaseAssets, releaseAsset.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ReleaseAssets []json.RawMessage `json:"releaseAssets"`
+	}{
+		ReleaseAssets: releaseAssets,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankReleaseAssets) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.ReleaseAssets))
+	for i, in := range msg.ReleaseAssets {
+		addrs[i] = in.ZoneAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankReleaseAssets
+
+// *****Clawback
+
+// Clawback - transaction input, IssuerAddress, the zone of FromAddress, returns a fraudulent asset peg, or every share of
+// a fiat peg, from its wallet to the issuer of the peg
+type Clawback struct {
+	IssuerAddress sdk.AccAddress `json:"issuerAddress"`
+	FromAddress   sdk.AccAddress `json:"fromAddress"`
+	PegType       string         `json:"pegType"`
+	PegHash       types.PegHash  `json:"pegHash"`
+	Reason        string         `json:"reason"`
+}
+
+// NewClawback : initializer
+func NewClawback(issuerAddress sdk.AccAddress, fromAddress sdk.AccAddress, pegType string, pegHash types.PegHash, reason string) Clawback {
+	return Clawback{issuerAddress, fromAddress, pegType, pegHash, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in Clawback) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		IssuerAddress string `json:"issuerAddress"`
+		FromAddress   string `json:"fromAddress"`
+		PegType       string `json:"pegType"`
+		PegHash       string `json:"pegHash"`
+		Reason        string `json:"reason"`
+	}{
+		IssuerAddress: in.IssuerAddress.String(),
+		FromAddress:   in.FromAddress.String(),
+		PegType:       in.PegType,
+		PegHash:       in.PegHash.String(),
+		Reason:        in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in Clawback) ValidateBasic() sdk.Error {
+	if len(in.IssuerAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.IssuerAddress.String())
+	} else if len(in.FromAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.PegHash) == 0 {
+		return sdk.ErrUnknownRequest("PegHash is Empty")
+	} else if err := acl.ValidatePeg(in.PegType, in.PegHash); err != nil {
+		return err
+	} else if len(in.Reason) == 0 {
+		return sdk.ErrUnknownRequest("Reason is Empty")
+	}
+	return nil
+}
+
+// #####Clawback
+
+// *****MsgBankClawbacks
+
+// MsgBankClawbacks : returns fraudulent pegs to their issuer
+type MsgBankClawbacks struct {
+	Clawbacks []Clawback `json:"clawbacks"`
+}
+
+// NewMsgBankClawbacks : initilizer
+func NewMsgBankClawbacks(clawbacks []Clawback) MsgBankClawbacks {
+	return MsgBankClawbacks{clawbacks}
+}
+
+// ***** Implementing sdk.Msg
+
+var _ sdk.Msg = MsgBankClawbacks{}
+
+// Type : implements msg
+func (msg MsgBankClawbacks) Type() string { return "bank" }
+
+func (msg MsgBankClawbacks) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBankClawbacks) ValidateBasic() sdk.Error {
+	if len(msg.Clawbacks) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.Clawbacks {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBankClawbacks) GetSignBytes() []byte {
+	var clawbacks []json.RawMessage
+	for _, clawback := range msg.Clawbacks {
+		clawbacks = append(clawbacks, clawback.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		Clawbacks []json.RawMessage `json:"clawbacks"`
+	}{
+		Clawbacks: clawbacks,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBankClawbacks) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.Clawbacks))
+	for i, in := range msg.Clawbacks {
+		addrs[i] = in.IssuerAddress
+	}
+	return addrs
+}
+
+// ##### Implement sdk.Msg
+
+// #####MsgBankClawbacks
+
+// DefineZone : singular define zone message
+// *****ACL
+type DefineZone struct {
+	From   sdk.AccAddress `json:"from"`
+	To     sdk.AccAddress `json:"to"`
+	ZoneID acl.ZoneID     `json:"zoneID"`
+}
+
+// NewDefineZone : new define zone struct
+func NewDefineZone(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID) DefineZone {
+	return DefineZone{from, to, zoneID}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DefineZone) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		ZoneID string `json:"zoneID"`
+	}{
+		From:   in.From.String(),
+		To:     in.To.String(),
+		ZoneID: in.ZoneID.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in DefineZone) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.ZoneID) == 0 {
+		return sdk.ErrInvalidAddress(in.ZoneID.String())
+	}
+	return nil
+}
+
+// MsgDefineZones : message define zones
+type MsgDefineZones struct {
+	DefineZones []DefineZone `json:"defineZones"`
+}
+
+// NewMsgDefineZones : new message define zones
+func NewMsgDefineZones(defineZones []DefineZone) MsgDefineZones {
+	return MsgDefineZones{defineZones}
+}
+
+var _ sdk.Msg = MsgDefineZones{}
+
+// Type : implements msg
+func (msg MsgDefineZones) Type() string { return "bank" }
+
+func (msg MsgDefineZones) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDefineZones) ValidateBasic() sdk.Error {
+	if len(msg.DefineZones) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.DefineZones {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDefineZones) GetSignBytes() []byte {
+	var defineZones []json.RawMessage
+	for _, defineZone := range msg.DefineZones {
+		defineZones = append(defineZones, defineZone.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DefineZones []json.RawMessage `json:"defineZones"`
+	}{
+		DefineZones: defineZones,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDefineZones) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.DefineZones))
+	for i, in := range msg.DefineZones {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgDefineZones : build define zones message
+func BuildMsgDefineZones(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID, msgs []DefineZone) []DefineZone {
+	defineZone := NewDefineZone(from, to, zoneID)
+	msgs = append(msgs, defineZone)
+	return msgs
+}
+
+// BuildMsgDefineZoneWithDefineZones : build define zones message
+func BuildMsgDefineZoneWithDefineZones(msgs []DefineZone) sdk.Msg {
+	return NewMsgDefineZones(msgs)
+}
+
+// BuildMsgDefineZone : build define zones message
+func BuildMsgDefineZone(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID) sdk.Msg {
+	defineZone := NewDefineZone(from, to, zoneID)
+	return NewMsgDefineZones([]DefineZone{defineZone})
+}
+
+// DefineOrganization : singular define organization message
+type DefineOrganization struct {
+	From           sdk.AccAddress     `json:"from"`
+	To             sdk.AccAddress     `json:"to"`
+	OrganizationID acl.OrganizationID `json:"organizationID"`
+	ZoneID         acl.ZoneID         `json:"zoneID"`
+}
+
+// NewDefineOrganization : new define organization struct
+func NewDefineOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID) DefineOrganization {
+	return DefineOrganization{from, to, organizationID, zoneID}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DefineOrganization) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From           string `json:"from"`
+		To             string `json:"to"`
+		OrganizationID string `json:"organizationID"`
+		ZoneID         string `json:"zoneID"`
+	}{
+		From:           in.From.String(),
+		To:             in.To.String(),
+		OrganizationID: in.OrganizationID.String(),
+		ZoneID:         in.ZoneID.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in DefineOrganization) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.OrganizationID) == 0 {
+		return sdk.ErrInvalidAddress(in.OrganizationID.String())
+	} else if len(in.ZoneID) == 0 {
+		return sdk.ErrInvalidAddress(in.ZoneID.String())
+	}
+	return nil
+}
+
+// MsgDefineOrganizations : message define organizations
+type MsgDefineOrganizations struct {
+	DefineOrganizations []DefineOrganization `json:"defineOrganizations"`
+}
+
+// NewMsgDefineOrganizations : new message define organizations
+func NewMsgDefineOrganizations(defineOrganizations []DefineOrganization) MsgDefineOrganizations {
+	return MsgDefineOrganizations{defineOrganizations}
+}
+
+var _ sdk.Msg = MsgDefineOrganizations{}
+
+// Type : implements msg
+func (msg MsgDefineOrganizations) Type() string { return "bank" }
+
+func (msg MsgDefineOrganizations) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDefineOrganizations) ValidateBasic() sdk.Error {
+	if len(msg.DefineOrganizations) == 0 {
+		return ErrNoInputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.DefineOrganizations {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDefineOrganizations) GetSignBytes() []byte {
+	var defineOrganizations []json.RawMessage
+	for _, defineOrganization := range msg.DefineOrganizations {
+		defineOrganizations = append(defineOrganizations, defineOrganization.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DefineOrganizations []json.RawMessage `json:"defineOrganizations"`
+	}{
+		DefineOrganizations: defineOrganizations,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDefineOrganizations) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.DefineOrganizations))
+	for i, in := range msg.DefineOrganizations {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgDefineOrganizations : build define organization message
+func BuildMsgDefineOrganizations(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID, msgs []DefineOrganization) []DefineOrganization {
+	defineOrganization := NewDefineOrganization(from, to, organizationID, zoneID)
+	msgs = append(msgs, defineOrganization)
+	return msgs
+}
+
+// BuildMsgDefineOrganizationWithMsgs : build define organization message
+func BuildMsgDefineOrganizationWithMsgs(msgs []DefineOrganization) sdk.Msg {
+	return NewMsgDefineOrganizations(msgs)
+}
+
+// BuildMsgDefineOrganization : build define organization message
+func BuildMsgDefineOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID) sdk.Msg {
+	defineOrganization := NewDefineOrganization(from, to, organizationID, zoneID)
+	return NewMsgDefineOrganizations([]DefineOrganization{defineOrganization})
+}
+
+// DefineACL : indular define acl message
+type DefineACL struct {
+	From       sdk.AccAddress `json:"from"`
+	To         sdk.AccAddress `json:"to"`
+	ACLAccount acl.ACLAccount `json:"aclAccount"`
+}
+
+// NewDefineACL : new define acl struct
+func NewDefineACL(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount) DefineACL {
+	return DefineACL{from, to, aclAccount}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DefineACL) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From       string         `json:"from"`
+		To         string         `json:"to"`
+		ACLAccount acl.ACLAccount `json:"aclAccount"`
+	}{
+		From:       in.From.String(),
+		To:         in.To.String(),
+		ACLAccount: in.ACLAccount,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in DefineACL) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if in.ACLAccount == nil {
+		return sdk.ErrUnknownRequest("ACLAccount should not be empty.")
+	}
+	for _, permissionExpiry := range in.ACLAccount.GetPermissionExpiries() {
+		if err := permissionExpiry.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MsgDefineACLs : message define acls
+type MsgDefineACLs struct {
+	DefineACLs []DefineACL `json:"defineACLs"`
+}
+
+// NewMsgDefineACLs : new message define acls
+func NewMsgDefineACLs(defineACLs []DefineACL) MsgDefineACLs {
+	return MsgDefineACLs{defineACLs}
+}
+
+var _ sdk.Msg = MsgDefineACLs{}
+
+// Type : implements msg
+func (msg MsgDefineACLs) Type() string { return "bank" }
+
+func (msg MsgDefineACLs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDefineACLs) ValidateBasic() sdk.Error {
+	if len(msg.DefineACLs) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.DefineACLs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDefineACLs) GetSignBytes() []byte {
+	var defineACLs []json.RawMessage
+	for _, defineACL := range msg.DefineACLs {
+		defineACLs = append(defineACLs, defineACL.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DefineACLs []json.RawMessage `json:"defineACLs"`
+	}{
+		DefineACLs: defineACLs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDefineACLs) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.DefineACLs))
+	for i, in := range msg.DefineACLs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgDefineACLs : build define acls message
+func BuildMsgDefineACLs(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount, msgs []DefineACL) []DefineACL {
+	defineACL := NewDefineACL(from, to, aclAccount)
+	msgs = append(msgs, defineACL)
+	return msgs
+}
+
+// BuildMsgDefineACLWithACLs : build define acls message
+func BuildMsgDefineACLWithACLs(msgs []DefineACL) sdk.Msg {
+	return NewMsgDefineACLs(msgs)
+}
+
+// BuildMsgDefineACL : build define acls message
+func BuildMsgDefineACL(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount) sdk.Msg {
+	defineACL := NewDefineACL(from, to, aclAccount)
+	return NewMsgDefineACLs([]DefineACL{defineACL})
+}
+
+// DefineRole : singular define role message
+type DefineRole struct {
+	From sdk.AccAddress `json:"from"`
+	Role acl.Role       `json:"role"`
+}
+
+// NewDefineRole : new define role struct
+func NewDefineRole(from sdk.AccAddress, role acl.Role) DefineRole {
+	return DefineRole{from, role}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DefineRole) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From string   `json:"from"`
+		Role acl.Role `json:"role"`
+	}{
+		From: in.From.String(),
+		Role: in.Role,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in DefineRole) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	}
+	return in.Role.ValidateBasic()
+}
+
+// MsgDefineRoles : message define roles
+type MsgDefineRoles struct {
+	DefineRoles []DefineRole `json:"defineRoles"`
+}
+
+// NewMsgDefineRoles : new message define roles
+func NewMsgDefineRoles(defineRoles []DefineRole) MsgDefineRoles {
+	return MsgDefineRoles{defineRoles}
+}
+
+var _ sdk.Msg = MsgDefineRoles{}
+
+// Type : implements msg
+func (msg MsgDefineRoles) Type() string { return "bank" }
+
+func (msg MsgDefineRoles) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDefineRoles) ValidateBasic() sdk.Error {
+	if len(msg.DefineRoles) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.DefineRoles {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDefineRoles) GetSignBytes() []byte {
+	var defineRoles []json.RawMessage
+	for _, defineRole := range msg.DefineRoles {
+		defineRoles = append(defineRoles, defineRole.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DefineRoles []json.RawMessage `json:"defineRoles"`
+	}{
+		DefineRoles: defineRoles,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDefineRoles) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.DefineRoles))
+	for i, in := range msg.DefineRoles {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgDefineRole : build define roles message
+func BuildMsgDefineRole(from sdk.AccAddress, role acl.Role) sdk.Msg {
+	defineRole := NewDefineRole(from, role)
+	return NewMsgDefineRoles([]DefineRole{defineRole})
+}
+
+// SuspendACL : singular suspend acl message
+type SuspendACL struct {
+	From   sdk.AccAddress `json:"from"`
+	To     sdk.AccAddress `json:"to"`
+	Reason string         `json:"reason"`
+}
+
+// NewSuspendACL : new suspend acl struct
+func NewSuspendACL(from sdk.AccAddress, to sdk.AccAddress, reason string) SuspendACL {
+	return SuspendACL{from, to, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SuspendACL) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		Reason string `json:"reason"`
+	}{
+		From:   in.From.String(),
+		To:     in.To.String(),
+		Reason: in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in SuspendACL) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.Reason) == 0 {
+		return sdk.ErrUnknownRequest("reason should not be empty")
+	}
+	return nil
+}
+
+// MsgSuspendACLs : message suspend acls
+type MsgSuspendACLs struct {
+	SuspendACLs []SuspendACL `json:"suspendACLs"`
+}
+
+// NewMsgSuspendACLs : new message suspend acls
+func NewMsgSuspendACLs(suspendACLs []SuspendACL) MsgSuspendACLs {
+	return MsgSuspendACLs{suspendACLs}
+}
+
+var _ sdk.Msg = MsgSuspendACLs{}
+
+// Type : implements msg
+func (msg MsgSuspendACLs) Type() string { return "bank" }
+
+func (msg MsgSuspendACLs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgSuspendACLs) ValidateBasic() sdk.Error {
+	if len(msg.SuspendACLs) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.SuspendACLs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgSuspendACLs) GetSignBytes() []byte {
+	var suspendACLs []json.RawMessage
+	for _, suspendACL := range msg.SuspendACLs {
+		suspendACLs = append(suspendACLs, suspendACL.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SuspendACLs []json.RawMessage `json:"suspendACLs"`
+	}{
+		SuspendACLs: suspendACLs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgSuspendACLs) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SuspendACLs))
+	for i, in := range msg.SuspendACLs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgSuspendACL : build suspend acls message
+func BuildMsgSuspendACL(from sdk.AccAddress, to sdk.AccAddress, reason string) sdk.Msg {
+	suspendACL := NewSuspendACL(from, to, reason)
+	return NewMsgSuspendACLs([]SuspendACL{suspendACL})
+}
+
+// ReinstateACL : singular reinstate acl message
+type ReinstateACL struct {
+	From   sdk.AccAddress `json:"from"`
+	To     sdk.AccAddress `json:"to"`
+	Reason string         `json:"reason"`
+}
+
+// NewReinstateACL : new reinstate acl struct
+func NewReinstateACL(from sdk.AccAddress, to sdk.AccAddress, reason string) ReinstateACL {
+	return ReinstateACL{from, to, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ReinstateACL) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		Reason string `json:"reason"`
+	}{
+		From:   in.From.String(),
+		To:     in.To.String(),
+		Reason: in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in ReinstateACL) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.Reason) == 0 {
+		return sdk.ErrUnknownRequest("reason should not be empty")
+	}
+	return nil
+}
+
+// MsgReinstateACLs : message reinstate acls
+type MsgReinstateACLs struct {
+	ReinstateACLs []ReinstateACL `json:"reinstateACLs"`
+}
+
+// NewMsgReinstateACLs : new message reinstate acls
+func NewMsgReinstateACLs(reinstateACLs []ReinstateACL) MsgReinstateACLs {
+	return MsgReinstateACLs{reinstateACLs}
+}
+
+var _ sdk.Msg = MsgReinstateACLs{}
+
+// Type : implements msg
+func (msg MsgReinstateACLs) Type() string { return "bank" }
+
+func (msg MsgReinstateACLs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgReinstateACLs) ValidateBasic() sdk.Error {
+	if len(msg.ReinstateACLs) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.ReinstateACLs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgReinstateACLs) GetSignBytes() []byte {
+	var reinstateACLs []json.RawMessage
+	for _, reinstateACL := range msg.ReinstateACLs {
+		reinstateACLs = append(reinstateACLs, reinstateACL.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ReinstateACLs []json.RawMessage `json:"reinstateACLs"`
+	}{
+		ReinstateACLs: reinstateACLs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgReinstateACLs) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.ReinstateACLs))
+	for i, in := range msg.ReinstateACLs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgReinstateACL : build reinstate acls message
+func BuildMsgReinstateACL(from sdk.AccAddress, to sdk.AccAddress, reason string) sdk.Msg {
+	reinstateACL := NewReinstateACL(from, to, reason)
+	return NewMsgReinstateACLs([]ReinstateACL{reinstateACL})
+}
+
+// RevokeACL : singular revoke acl message
+type RevokeACL struct {
+	From   sdk.AccAddress `json:"from"`
+	To     sdk.AccAddress `json:"to"`
+	Reason string         `json:"reason"`
+}
+
+// NewRevokeACL : new revoke acl struct
+func NewRevokeACL(from sdk.AccAddress, to sdk.AccAddress, reason string) RevokeACL {
+	return RevokeACL{from, to, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RevokeACL) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		Reason string `json:"reason"`
+	}{
+		From:   in.From.String(),
+		To:     in.To.String(),
+		Reason: in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in RevokeACL) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.Reason) == 0 {
+		return sdk.ErrUnknownRequest("reason should not be empty")
+	}
+	return nil
+}
+
+// MsgRevokeACLs : message revoke acls
+type MsgRevokeACLs struct {
+	RevokeACLs []RevokeACL `json:"revokeACLs"`
+}
+
+// NewMsgRevokeACLs : new message revoke acls
+func NewMsgRevokeACLs(revokeACLs []RevokeACL) MsgRevokeACLs {
+	return MsgRevokeACLs{revokeACLs}
+}
+
+var _ sdk.Msg = MsgRevokeACLs{}
+
+// Type : implements msg
+func (msg MsgRevokeACLs) Type() string { return "bank" }
+
+func (msg MsgRevokeACLs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRevokeACLs) ValidateBasic() sdk.Error {
+	if len(msg.RevokeACLs) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.RevokeACLs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRevokeACLs) GetSignBytes() []byte {
+	var revokeACLs []json.RawMessage
+	for _, revokeACL := range msg.RevokeACLs {
+		revokeACLs = append(revokeACLs, revokeACL.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RevokeACLs []json.RawMessage `json:"revokeACLs"`
+	}{
+		RevokeACLs: revokeACLs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRevokeACLs) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.RevokeACLs))
+	for i, in := range msg.RevokeACLs {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgRevokeACL : build revoke acls message
+func BuildMsgRevokeACL(from sdk.AccAddress, to sdk.AccAddress, reason string) sdk.Msg {
+	revokeACL := NewRevokeACL(from, to, reason)
+	return NewMsgRevokeACLs([]RevokeACL{revokeACL})
+}
+
+// RotateZoneAddress : singular rotate zone address message
+type RotateZoneAddress struct {
+	From       sdk.AccAddress `json:"from"`
+	ZoneID     acl.ZoneID     `json:"zoneID"`
+	NewAddress sdk.AccAddress `json:"newAddress"`
+}
+
+// NewRotateZoneAddress : new rotate zone address struct
+func NewRotateZoneAddress(from sdk.AccAddress, zoneID acl.ZoneID, newAddress sdk.AccAddress) RotateZoneAddress {
+	return RotateZoneAddress{from, zoneID, newAddress}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RotateZoneAddress) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From       string `json:"from"`
+		ZoneID     string `json:"zoneID"`
+		NewAddress string `json:"newAddress"`
+	}{
+		From:       in.From.String(),
+		ZoneID:     in.ZoneID.String(),
+		NewAddress: in.NewAddress.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in RotateZoneAddress) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.NewAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.NewAddress.String())
+	} else if len(in.ZoneID) == 0 {
+		return sdk.ErrInternal("zoneID should not be empty")
+	}
+	return nil
+}
+
+// MsgRotateZoneAddresses : message rotate zone addresses
+type MsgRotateZoneAddresses struct {
+	RotateZoneAddresses []RotateZoneAddress `json:"rotateZoneAddresses"`
+}
+
+// NewMsgRotateZoneAddresses : new message rotate zone addresses
+func NewMsgRotateZoneAddresses(rotateZoneAddresses []RotateZoneAddress) MsgRotateZoneAddresses {
+	return MsgRotateZoneAddresses{rotateZoneAddresses}
+}
+
+var _ sdk.Msg = MsgRotateZoneAddresses{}
+
+// Type : implements msg
+func (msg MsgRotateZoneAddresses) Type() string { return "bank" }
+
+func (msg MsgRotateZoneAddresses) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRotateZoneAddresses) ValidateBasic() sdk.Error {
+	if len(msg.RotateZoneAddresses) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.RotateZoneAddresses {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRotateZoneAddresses) GetSignBytes() []byte {
+	var rotateZoneAddresses []json.RawMessage
+	for _, rotateZoneAddress := range msg.RotateZoneAddresses {
+		rotateZoneAddresses = append(rotateZoneAddresses, rotateZoneAddress.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RotateZoneAddresses []json.RawMessage `json:"rotateZoneAddresses"`
+	}{
+		RotateZoneAddresses: rotateZoneAddresses,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRotateZoneAddresses) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.RotateZoneAddresses))
+	for i, in := range msg.RotateZoneAddresses {
+		addrs[i] = in.From
+	}
+	return addrs
+}
+
+// BuildMsgRotateZoneAddress : build rotate zone addresses message
+func BuildMsgRotateZoneAddress(from sdk.AccAddress, zoneID acl.ZoneID, newAddress sdk.AccAddress) sdk.Msg {
+	rotateZoneAddress := NewRotateZoneAddress(from, zoneID, newAddress)
+	return NewMsgRotateZoneAddresses([]RotateZoneAddress{rotateZoneAddress})
+}
+
+// RotateOrganizationAddress : singular rotate organization address message
+type RotateOrganizationAddress struct {
+	From           sdk.AccAddress     `json:"from"`
+	OrganizationID acl.OrganizationID `json:"organizationID"`
+	NewAddress     sdk.AccAddress     `json:"newAddress"`
+}
+
+// NewRotateOrganizationAddress : new rotate organization address struct
+func NewRotateOrganizationAddress(from sdk.AccAddress, organizationID acl.OrganizationID, newAddress sdk.AccAddress) RotateOrganizationAddress {
+	return RotateOrganizationAddress{from, organizationID, newAddress}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RotateOrganizationAddress) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		From           string `json:"from"`
+		OrganizationID string `json:"organizationID"`
+		NewAddress     string `json:"newAddress"`
+	}{
+		From:           in.From.String(),
+		OrganizationID: in.OrganizationID.String(),
+		NewAddress:     in.NewAddress.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+// ValidateBasic : Validate Basic
+func (in RotateOrganizationAddress) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.NewAddress) == 0 {
+		return sdk.ErrInvalidAddress(in.NewAddress.String())
+	} else if len(in.OrganizationID) == 0 {
+		return sdk.ErrInternal("organizationID should not be empty")
+	}
+	return nil
+}
+
+// MsgRotateOrganizationAddresses : message rotate organization addresses
+type MsgRotateOrganizationAddresses struct {
+	RotateOrganizationAddresses []RotateOrganizationAddress `json:"rotateOrganizationAddresses"`
+}
+
+// NewMsgRotateOrganizationAddresses : new message rotate organization addresses
+func NewMsgRotateOrganizationAddresses(rotateOrganizationAddresses []RotateOrganizationAddress) MsgRotateOrganizationAddresses {
+	return MsgRotateOrganizationAddresses{rotateOrganizationAddresses}
+}
+
+var _ sdk.Msg = MsgRotateOrganizationAddresses{}
+
+// Type : implements msg
+func (msg MsgRotateOrganizationAddresses) Type() string { return "bank" }
+
+func (msg MsgRotateOrganizationAddresses) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRotateOrganizationAddresses) ValidateBasic() sdk.Error {
+	if len(msg.RotateOrganizationAddresses) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
+	}
+	for _, in := range msg.RotateOrganizationAddresses {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRotateOrganizationAddresses) GetSignBytes() []byte {
+	var rotateOrganizationAddresses []json.RawMessage
+	for _, rotateOrganizationAddress := range msg.RotateOrganizationAddresses {
+		rotateOrganizationAddresses = append(rotateOrganizationAddresses, rotateOrganizationAddress.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RotateOrganizationAddresses []json.RawMessage `json:"rotateOrganizationAddresses"`
+	}{
+		RotateOrganizationAddresses: rotateOrganizationAddresses,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRotateOrganizationAddresses) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.RotateOrganizationAddresses))
+	for i, in := range msg.RotateOrganizationAddresses {
+		addrs[i] = in.From
+	}
+	return addrs
 }
 
-// NewReleaseAsset : initializer
-func NewReleaseAsset(zoneAddress sdk.AccAddress, ownerAddress sdk.AccAddress, pegHash types.PegHash) ReleaseAsset {
-	return ReleaseAsset{zoneAddress, ownerAddress, pegHash}
+// BuildMsgRotateOrganizationAddress : build rotate organization addresses message
+func BuildMsgRotateOrganizationAddress(from sdk.AccAddress, organizationID acl.OrganizationID, newAddress sdk.AccAddress) sdk.Msg {
+	rotateOrganizationAddress := NewRotateOrganizationAddress(from, organizationID, newAddress)
+	return NewMsgRotateOrganizationAddresses([]RotateOrganizationAddress{rotateOrganizationAddress})
+}
+
+// DefineSubOrganization : singular define sub organization message
+type DefineSubOrganization struct {
+	From           sdk.AccAddress     `json:"from"`
+	To             sdk.AccAddress     `json:"to"`
+	OrganizationID acl.OrganizationID `json:"organizationID"`
+	ParentID       acl.OrganizationID `json:"parentID"`
+	ACL            acl.ACL            `json:"acl"`
+}
+
+// NewDefineSubOrganization : new define sub organization struct
+func NewDefineSubOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID,
+	parentID acl.OrganizationID, _acl acl.ACL) DefineSubOrganization {
+	return DefineSubOrganization{from, to, organizationID, parentID, _acl}
 }
 
 // GetSignBytes : get bytes to sign
-func (in ReleaseAsset) GetSignBytes() []byte {
+func (in DefineSubOrganization) GetSignBytes() []byte {
 	bin, err := ModuleCdc.MarshalJSON(struct {
-		ZoneAddress  string `json:"zoneAddress"`
-		OwnerAddress string `json:"ownerAddress"`
-		PegHash      string `json:"pegHash"`
+		From           string  `json:"from"`
+		To             string  `json:"to"`
+		OrganizationID string  `json:"organizationID"`
+		ParentID       string  `json:"parentID"`
+		ACL            acl.ACL `json:"acl"`
 	}{
-		ZoneAddress:  in.ZoneAddress.String(),
-		OwnerAddress: in.OwnerAddress.String(),
-		PegHash:      in.PegHash.String(),
+		From:           in.From.String(),
+		To:             in.To.String(),
+		OrganizationID: in.OrganizationID.String(),
+		ParentID:       in.ParentID.String(),
+		ACL:            in.ACL,
 	})
 	if err != nil {
 		panic(err)
@@ -1126,46 +3035,43 @@ func (in ReleaseAsset) GetSignBytes() []byte {
 	return bin
 }
 
-func (in ReleaseAsset) ValidateBasic() sdk.Error {
-	if len(in.OwnerAddress) == 0 {
-		return sdk.ErrInvalidAddress(in.OwnerAddress.String())
-	} else if len(in.ZoneAddress) == 0 {
-		return sdk.ErrInvalidAddress(in.ZoneAddress.String())
-	} else if len(in.PegHash) == 0 {
-		return sdk.ErrUnknownRequest("PegHash is Empty")
+// ValidateBasic : Validate Basic
+func (in DefineSubOrganization) ValidateBasic() sdk.Error {
+	if len(in.From) == 0 {
+		return sdk.ErrInvalidAddress(in.From.String())
+	} else if len(in.To) == 0 {
+		return sdk.ErrInvalidAddress(in.To.String())
+	} else if len(in.OrganizationID) == 0 || len(in.ParentID) == 0 {
+		return sdk.ErrInternal("organizationID and parentID should not be empty")
+	} else if in.OrganizationID.String() == in.ParentID.String() {
+		return sdk.ErrInternal("organization cannot be its own parent")
 	}
 	return nil
 }
 
-// #####ReleaseAsset
-
-// *****MsgBankReleaseAssets
-
-// MsgBankReleaseAssets : high level release of asset module
-type MsgBankReleaseAssets struct {
-	ReleaseAssets []ReleaseAsset `json:"releseAssets"`
+// MsgDefineSubOrganizations : message define sub organizations
+type MsgDefineSubOrganizations struct {
+	DefineSubOrganizations []DefineSubOrganization `json:"defineSubOrganizations"`
 }
 
-// NewMsgBankReleaseAssets : initilizer
-func NewMsgBankReleaseAssets(releseAsset []ReleaseAsset) MsgBankReleaseAssets {
-	return MsgBankReleaseAssets{releseAsset}
+// NewMsgDefineSubOrganizations : new message define sub organizations
+func NewMsgDefineSubOrganizations(defineSubOrganizations []DefineSubOrganization) MsgDefineSubOrganizations {
+	return MsgDefineSubOrganizations{defineSubOrganizations}
 }
 
-// ***** Implementing sdk.Msg
-
-var _ sdk.Msg = MsgBankReleaseAssets{}
+var _ sdk.Msg = MsgDefineSubOrganizations{}
 
 // Type : implements msg
-func (msg MsgBankReleaseAssets) Type() string { return "bank" }
+func (msg MsgDefineSubOrganizations) Type() string { return "bank" }
 
-func (msg MsgBankReleaseAssets) Route() string { return RouterKey }
+func (msg MsgDefineSubOrganizations) Route() string { return RouterKey }
 
 // ValidateBasic : implements msg
-func (msg MsgBankReleaseAssets) ValidateBasic() sdk.Error {
-	if len(msg.ReleaseAssets) == 0 {
+func (msg MsgDefineSubOrganizations) ValidateBasic() sdk.Error {
+	if len(msg.DefineSubOrganizations) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
-	for _, in := range msg.ReleaseAssets {
+	for _, in := range msg.DefineSubOrganizations {
 		if err := in.ValidateBasic(); err != nil {
 			return err.TraceSDK("")
 		}
@@ -1174,16 +3080,16 @@ func (msg MsgBankReleaseAssets) ValidateBasic() sdk.Error {
 }
 
 // GetSignBytes : implements msg
-func (msg MsgBankReleaseAssets) GetSignBytes() []byte {
-	var releaseAssets []json.RawMessage
-	for _, releaseAsset := range msg.ReleaseAssets {
-		releaseAssets = append(releaseAssets, releaseAsset.GetSignBytes())
+func (msg MsgDefineSubOrganizations) GetSignBytes() []byte {
+	var defineSubOrganizations []json.RawMessage
+	for _, defineSubOrganization := range msg.DefineSubOrganizations {
+		defineSubOrganizations = append(defineSubOrganizations, defineSubOrganization.GetSignBytes())
 	}
 
 	b, err := ModuleCdc.MarshalJSON(struct {
-		ReleaseAssets []json.RawMessage `json:"releaseAssets"`
+		DefineSubOrganizations []json.RawMessage `json:"defineSubOrganizations"`
 	}{
-		ReleaseAssets: releaseAssets,
+		DefineSubOrganizations: defineSubOrganizations,
 	})
 	if err != nil {
 		panic(err)
@@ -1192,41 +3098,48 @@ func (msg MsgBankReleaseAssets) GetSignBytes() []byte {
 }
 
 // GetSigners : implements msg
-func (msg MsgBankReleaseAssets) GetSigners() []sdk.AccAddress {
-	addrs := make([]sdk.AccAddress, len(msg.ReleaseAssets))
-	for i, in := range msg.ReleaseAssets {
-		addrs[i] = in.ZoneAddress
+func (msg MsgDefineSubOrganizations) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.DefineSubOrganizations))
+	for i, in := range msg.DefineSubOrganizations {
+		addrs[i] = in.From
 	}
 	return addrs
 }
 
-// ##### Implement sdk.Msg
-
-// #####MsgBankReleaseAssets
+// BuildMsgDefineSubOrganization : build define sub organizations message
+func BuildMsgDefineSubOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID,
+	parentID acl.OrganizationID, _acl acl.ACL) sdk.Msg {
+	defineSubOrganization := NewDefineSubOrganization(from, to, organizationID, parentID, _acl)
+	return NewMsgDefineSubOrganizations([]DefineSubOrganization{defineSubOrganization})
+}
 
-// DefineZone : singular define zone message
-// *****ACL
-type DefineZone struct {
-	From   sdk.AccAddress `json:"from"`
-	To     sdk.AccAddress `json:"to"`
-	ZoneID acl.ZoneID     `json:"zoneID"`
+// SetOrganizationAdmins : singular set organization admins message
+type SetOrganizationAdmins struct {
+	From           sdk.AccAddress     `json:"from"`
+	OrganizationID acl.OrganizationID `json:"organizationID"`
+	Admins         []sdk.AccAddress   `json:"admins"`
 }
 
-// NewDefineZone : new define zone struct
-func NewDefineZone(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID) DefineZone {
-	return DefineZone{from, to, zoneID}
+// NewSetOrganizationAdmins : new set organization admins struct
+func NewSetOrganizationAdmins(from sdk.AccAddress, organizationID acl.OrganizationID, admins []sdk.AccAddress) SetOrganizationAdmins {
+	return SetOrganizationAdmins{from, organizationID, admins}
 }
 
 // GetSignBytes : get bytes to sign
-func (in DefineZone) GetSignBytes() []byte {
+func (in SetOrganizationAdmins) GetSignBytes() []byte {
+	var admins []string
+	for _, admin := range in.Admins {
+		admins = append(admins, admin.String())
+	}
+
 	bin, err := ModuleCdc.MarshalJSON(struct {
-		From   string `json:"from"`
-		To     string `json:"to"`
-		ZoneID string `json:"zoneID"`
+		From           string   `json:"from"`
+		OrganizationID string   `json:"organizationID"`
+		Admins         []string `json:"admins"`
 	}{
-		From:   in.From.String(),
-		To:     in.To.String(),
-		ZoneID: in.ZoneID.String(),
+		From:           in.From.String(),
+		OrganizationID: in.OrganizationID.String(),
+		Admins:         admins,
 	})
 	if err != nil {
 		panic(err)
@@ -1235,40 +3148,43 @@ func (in DefineZone) GetSignBytes() []byte {
 }
 
 // ValidateBasic : Validate Basic
-func (in DefineZone) ValidateBasic() sdk.Error {
+func (in SetOrganizationAdmins) ValidateBasic() sdk.Error {
 	if len(in.From) == 0 {
 		return sdk.ErrInvalidAddress(in.From.String())
-	} else if len(in.To) == 0 {
-		return sdk.ErrInvalidAddress(in.To.String())
-	} else if len(in.ZoneID) == 0 {
-		return sdk.ErrInvalidAddress(in.ZoneID.String())
+	} else if len(in.OrganizationID) == 0 {
+		return sdk.ErrInternal("organizationID should not be empty")
+	}
+	for _, admin := range in.Admins {
+		if len(admin) == 0 {
+			return sdk.ErrInvalidAddress(admin.String())
+		}
 	}
 	return nil
 }
 
-// MsgDefineZones : message define zones
-type MsgDefineZones struct {
-	DefineZones []DefineZone `json:"defineZones"`
+// MsgSetOrganizationAdmins : message set organization admins
+type MsgSetOrganizationAdmins struct {
+	SetOrganizationAdmins []SetOrganizationAdmins `json:"setOrganizationAdmins"`
 }
 
-// NewMsgDefineZones : new message define zones
-func NewMsgDefineZones(defineZones []DefineZone) MsgDefineZones {
-	return MsgDefineZones{defineZones}
+// NewMsgSetOrganizationAdmins : new message set organization admins
+func NewMsgSetOrganizationAdmins(setOrganizationAdmins []SetOrganizationAdmins) MsgSetOrganizationAdmins {
+	return MsgSetOrganizationAdmins{setOrganizationAdmins}
 }
 
-var _ sdk.Msg = MsgDefineZones{}
+var _ sdk.Msg = MsgSetOrganizationAdmins{}
 
 // Type : implements msg
-func (msg MsgDefineZones) Type() string { return "bank" }
+func (msg MsgSetOrganizationAdmins) Type() string { return "bank" }
 
-func (msg MsgDefineZones) Route() string { return RouterKey }
+func (msg MsgSetOrganizationAdmins) Route() string { return RouterKey }
 
 // ValidateBasic : implements msg
-func (msg MsgDefineZones) ValidateBasic() sdk.Error {
-	if len(msg.DefineZones) == 0 {
+func (msg MsgSetOrganizationAdmins) ValidateBasic() sdk.Error {
+	if len(msg.SetOrganizationAdmins) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
-	for _, in := range msg.DefineZones {
+	for _, in := range msg.SetOrganizationAdmins {
 		if err := in.ValidateBasic(); err != nil {
 			return err.TraceSDK("")
 		}
@@ -1277,16 +3193,16 @@ func (msg MsgDefineZones) ValidateBasic() sdk.Error {
 }
 
 // GetSignBytes : implements msg
-func (msg MsgDefineZones) GetSignBytes() []byte {
-	var defineZones []json.RawMessage
-	for _, defineZone := range msg.DefineZones {
-		defineZones = append(defineZones, defineZone.GetSignBytes())
+func (msg MsgSetOrganizationAdmins) GetSignBytes() []byte {
+	var setOrganizationAdmins []json.RawMessage
+	for _, setOrganizationAdmin := range msg.SetOrganizationAdmins {
+		setOrganizationAdmins = append(setOrganizationAdmins, setOrganizationAdmin.GetSignBytes())
 	}
 
 	b, err := ModuleCdc.MarshalJSON(struct {
-		DefineZones []json.RawMessage `json:"defineZones"`
+		SetOrganizationAdmins []json.RawMessage `json:"setOrganizationAdmins"`
 	}{
-		DefineZones: defineZones,
+		SetOrganizationAdmins: setOrganizationAdmins,
 	})
 	if err != nil {
 		panic(err)
@@ -1295,57 +3211,48 @@ func (msg MsgDefineZones) GetSignBytes() []byte {
 }
 
 // GetSigners : implements msg
-func (msg MsgDefineZones) GetSigners() []sdk.AccAddress {
-	addrs := make([]sdk.AccAddress, len(msg.DefineZones))
-	for i, in := range msg.DefineZones {
+func (msg MsgSetOrganizationAdmins) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SetOrganizationAdmins))
+	for i, in := range msg.SetOrganizationAdmins {
 		addrs[i] = in.From
 	}
 	return addrs
 }
 
-// BuildMsgDefineZones : build define zones message
-func BuildMsgDefineZones(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID, msgs []DefineZone) []DefineZone {
-	defineZone := NewDefineZone(from, to, zoneID)
-	msgs = append(msgs, defineZone)
-	return msgs
-}
-
-// BuildMsgDefineZoneWithDefineZones : build define zones message
-func BuildMsgDefineZoneWithDefineZones(msgs []DefineZone) sdk.Msg {
-	return NewMsgDefineZones(msgs)
-}
-
-// BuildMsgDefineZone : build define zones message
-func BuildMsgDefineZone(from sdk.AccAddress, to sdk.AccAddress, zoneID acl.ZoneID) sdk.Msg {
-	defineZone := NewDefineZone(from, to, zoneID)
-	return NewMsgDefineZones([]DefineZone{defineZone})
+// BuildMsgSetOrganizationAdmins : build set organization admins message
+func BuildMsgSetOrganizationAdmins(from sdk.AccAddress, organizationID acl.OrganizationID, admins []sdk.AccAddress) sdk.Msg {
+	setOrganizationAdmins := NewSetOrganizationAdmins(from, organizationID, admins)
+	return NewMsgSetOrganizationAdmins([]SetOrganizationAdmins{setOrganizationAdmins})
 }
 
-// DefineOrganization : singular define organization message
-type DefineOrganization struct {
-	From           sdk.AccAddress     `json:"from"`
-	To             sdk.AccAddress     `json:"to"`
-	OrganizationID acl.OrganizationID `json:"organizationID"`
-	ZoneID         acl.ZoneID         `json:"zoneID"`
+// RecordKYC : singular record kyc message
+type RecordKYC struct {
+	From         sdk.AccAddress `json:"from"`
+	To           sdk.AccAddress `json:"to"`
+	Level        int64          `json:"level"`
+	DocumentHash string         `json:"documentHash"`
+	ExpiresAt    int64          `json:"expiresAt"`
 }
 
-// NewDefineOrganization : new define organization struct
-func NewDefineOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID) DefineOrganization {
-	return DefineOrganization{from, to, organizationID, zoneID}
+// NewRecordKYC : new record kyc struct
+func NewRecordKYC(from sdk.AccAddress, to sdk.AccAddress, level int64, documentHash string, expiresAt int64) RecordKYC {
+	return RecordKYC{from, to, level, documentHash, expiresAt}
 }
 
 // GetSignBytes : get bytes to sign
-func (in DefineOrganization) GetSignBytes() []byte {
+func (in RecordKYC) GetSignBytes() []byte {
 	bin, err := ModuleCdc.MarshalJSON(struct {
-		From           string `json:"from"`
-		To             string `json:"to"`
-		OrganizationID string `json:"organizationID"`
-		ZoneID         string `json:"zoneID"`
+		From         string `json:"from"`
+		To           string `json:"to"`
+		Level        int64  `json:"level"`
+		DocumentHash string `json:"documentHash"`
+		ExpiresAt    int64  `json:"expiresAt"`
 	}{
-		From:           in.From.String(),
-		To:             in.To.String(),
-		OrganizationID: in.OrganizationID.String(),
-		ZoneID:         in.ZoneID.String(),
+		From:         in.From.String(),
+		To:           in.To.String(),
+		Level:        in.Level,
+		DocumentHash: in.DocumentHash,
+		ExpiresAt:    in.ExpiresAt,
 	})
 	if err != nil {
 		panic(err)
@@ -1354,42 +3261,42 @@ func (in DefineOrganization) GetSignBytes() []byte {
 }
 
 // ValidateBasic : Validate Basic
-func (in DefineOrganization) ValidateBasic() sdk.Error {
+func (in RecordKYC) ValidateBasic() sdk.Error {
 	if len(in.From) == 0 {
 		return sdk.ErrInvalidAddress(in.From.String())
 	} else if len(in.To) == 0 {
 		return sdk.ErrInvalidAddress(in.To.String())
-	} else if len(in.OrganizationID) == 0 {
-		return sdk.ErrInvalidAddress(in.OrganizationID.String())
-	} else if len(in.ZoneID) == 0 {
-		return sdk.ErrInvalidAddress(in.ZoneID.String())
+	} else if len(in.DocumentHash) == 0 {
+		return sdk.ErrUnknownRequest("documentHash should not be empty")
+	} else if in.Level < 0 || in.ExpiresAt < 0 {
+		return sdk.ErrUnknownRequest("level and expiresAt should not be negative")
 	}
 	return nil
 }
 
-// MsgDefineOrganizations : message define organizations
-type MsgDefineOrganizations struct {
-	DefineOrganizations []DefineOrganization `json:"defineOrganizations"`
+// MsgRecordKYCs : message record kycs
+type MsgRecordKYCs struct {
+	RecordKYCs []RecordKYC `json:"recordKYCs"`
 }
 
-// NewMsgDefineOrganizations : new message define organizations
-func NewMsgDefineOrganizations(defineOrganizations []DefineOrganization) MsgDefineOrganizations {
-	return MsgDefineOrganizations{defineOrganizations}
+// NewMsgRecordKYCs : new message record kycs
+func NewMsgRecordKYCs(recordKYCs []RecordKYC) MsgRecordKYCs {
+	return MsgRecordKYCs{recordKYCs}
 }
 
-var _ sdk.Msg = MsgDefineOrganizations{}
+var _ sdk.Msg = MsgRecordKYCs{}
 
 // Type : implements msg
-func (msg MsgDefineOrganizations) Type() string { return "bank" }
+func (msg MsgRecordKYCs) Type() string { return "bank" }
 
-func (msg MsgDefineOrganizations) Route() string { return RouterKey }
+func (msg MsgRecordKYCs) Route() string { return RouterKey }
 
 // ValidateBasic : implements msg
-func (msg MsgDefineOrganizations) ValidateBasic() sdk.Error {
-	if len(msg.DefineOrganizations) == 0 {
-		return ErrNoInputs(DefaultCodespace).TraceSDK("")
+func (msg MsgRecordKYCs) ValidateBasic() sdk.Error {
+	if len(msg.RecordKYCs) == 0 {
+		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
-	for _, in := range msg.DefineOrganizations {
+	for _, in := range msg.RecordKYCs {
 		if err := in.ValidateBasic(); err != nil {
 			return err.TraceSDK("")
 		}
@@ -1398,16 +3305,16 @@ func (msg MsgDefineOrganizations) ValidateBasic() sdk.Error {
 }
 
 // GetSignBytes : implements msg
-func (msg MsgDefineOrganizations) GetSignBytes() []byte {
-	var defineOrganizations []json.RawMessage
-	for _, defineOrganization := range msg.DefineOrganizations {
-		defineOrganizations = append(defineOrganizations, defineOrganization.GetSignBytes())
+func (msg MsgRecordKYCs) GetSignBytes() []byte {
+	var recordKYCs []json.RawMessage
+	for _, recordKYC := range msg.RecordKYCs {
+		recordKYCs = append(recordKYCs, recordKYC.GetSignBytes())
 	}
 
 	b, err := ModuleCdc.MarshalJSON(struct {
-		DefineOrganizations []json.RawMessage `json:"defineOrganizations"`
+		RecordKYCs []json.RawMessage `json:"recordKYCs"`
 	}{
-		DefineOrganizations: defineOrganizations,
+		RecordKYCs: recordKYCs,
 	})
 	if err != nil {
 		panic(err)
@@ -1416,54 +3323,46 @@ func (msg MsgDefineOrganizations) GetSignBytes() []byte {
 }
 
 // GetSigners : implements msg
-func (msg MsgDefineOrganizations) GetSigners() []sdk.AccAddress {
-	addrs := make([]sdk.AccAddress, len(msg.DefineOrganizations))
-	for i, in := range msg.DefineOrganizations {
+func (msg MsgRecordKYCs) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.RecordKYCs))
+	for i, in := range msg.RecordKYCs {
 		addrs[i] = in.From
 	}
 	return addrs
 }
 
-// BuildMsgDefineOrganizations : build define organization message
-func BuildMsgDefineOrganizations(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID, msgs []DefineOrganization) []DefineOrganization {
-	defineOrganization := NewDefineOrganization(from, to, organizationID, zoneID)
-	msgs = append(msgs, defineOrganization)
-	return msgs
-}
-
-// BuildMsgDefineOrganizationWithMsgs : build define organization message
-func BuildMsgDefineOrganizationWithMsgs(msgs []DefineOrganization) sdk.Msg {
-	return NewMsgDefineOrganizations(msgs)
-}
-
-// BuildMsgDefineOrganization : build define organization message
-func BuildMsgDefineOrganization(from sdk.AccAddress, to sdk.AccAddress, organizationID acl.OrganizationID, zoneID acl.ZoneID) sdk.Msg {
-	defineOrganization := NewDefineOrganization(from, to, organizationID, zoneID)
-	return NewMsgDefineOrganizations([]DefineOrganization{defineOrganization})
+// BuildMsgRecordKYC : build record kycs message
+func BuildMsgRecordKYC(from sdk.AccAddress, to sdk.AccAddress, level int64, documentHash string, expiresAt int64) sdk.Msg {
+	recordKYC := NewRecordKYC(from, to, level, documentHash, expiresAt)
+	return NewMsgRecordKYCs([]RecordKYC{recordKYC})
 }
 
-// DefineACL : indular define acl message
-type DefineACL struct {
-	From       sdk.AccAddress `json:"from"`
-	To         sdk.AccAddress `json:"to"`
-	ACLAccount acl.ACLAccount `json:"aclAccount"`
+// SetTradingLimit : singular set trading limit message, of the organization when it has an organization id and of the
+// account otherwise
+type SetTradingLimit struct {
+	From           sdk.AccAddress     `json:"from"`
+	OrganizationID acl.OrganizationID `json:"organizationID"`
+	To             sdk.AccAddress     `json:"to"`
+	TradingLimit   acl.TradingLimit   `json:"tradingLimit"`
 }
 
-// NewDefineACL : new define acl struct
-func NewDefineACL(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount) DefineACL {
-	return DefineACL{from, to, aclAccount}
+// NewSetTradingLimit : new set trading limit struct
+func NewSetTradingLimit(from sdk.AccAddress, organizationID acl.OrganizationID, to sdk.AccAddress, tradingLimit acl.TradingLimit) SetTradingLimit {
+	return SetTradingLimit{from, organizationID, to, tradingLimit}
 }
 
 // GetSignBytes : get bytes to sign
-func (in DefineACL) GetSignBytes() []byte {
+func (in SetTradingLimit) GetSignBytes() []byte {
 	bin, err := ModuleCdc.MarshalJSON(struct {
-		From       string         `json:"from"`
-		To         string         `json:"to"`
-		ACLAccount acl.ACLAccount `json:"aclAccount"`
+		From           string           `json:"from"`
+		OrganizationID string           `json:"organizationID"`
+		To             string           `json:"to"`
+		TradingLimit   acl.TradingLimit `json:"tradingLimit"`
 	}{
-		From:       in.From.String(),
-		To:         in.To.String(),
-		ACLAccount: in.ACLAccount,
+		From:           in.From.String(),
+		OrganizationID: in.OrganizationID.String(),
+		To:             in.To.String(),
+		TradingLimit:   in.TradingLimit,
 	})
 	if err != nil {
 		panic(err)
@@ -1472,38 +3371,38 @@ func (in DefineACL) GetSignBytes() []byte {
 }
 
 // ValidateBasic : Validate Basic
-func (in DefineACL) ValidateBasic() sdk.Error {
+func (in SetTradingLimit) ValidateBasic() sdk.Error {
 	if len(in.From) == 0 {
 		return sdk.ErrInvalidAddress(in.From.String())
-	} else if len(in.To) == 0 {
-		return sdk.ErrInvalidAddress(in.To.String())
+	} else if (len(in.OrganizationID) == 0) == (len(in.To) == 0) {
+		return sdk.ErrUnknownRequest("either organizationID or to should be set")
 	}
-	return nil
+	return in.TradingLimit.ValidateBasic()
 }
 
-// MsgDefineACLs : message define acls
-type MsgDefineACLs struct {
-	DefineACLs []DefineACL `json:"defineACLs"`
+// MsgSetTradingLimits : message set trading limits
+type MsgSetTradingLimits struct {
+	SetTradingLimits []SetTradingLimit `json:"setTradingLimits"`
 }
 
-// NewMsgDefineACLs : new message define acls
-func NewMsgDefineACLs(defineACLs []DefineACL) MsgDefineACLs {
-	return MsgDefineACLs{defineACLs}
+// NewMsgSetTradingLimits : new message set trading limits
+func NewMsgSetTradingLimits(setTradingLimits []SetTradingLimit) MsgSetTradingLimits {
+	return MsgSetTradingLimits{setTradingLimits}
 }
 
-var _ sdk.Msg = MsgDefineACLs{}
+var _ sdk.Msg = MsgSetTradingLimits{}
 
 // Type : implements msg
-func (msg MsgDefineACLs) Type() string { return "bank" }
+func (msg MsgSetTradingLimits) Type() string { return "bank" }
 
-func (msg MsgDefineACLs) Route() string { return RouterKey }
+func (msg MsgSetTradingLimits) Route() string { return RouterKey }
 
 // ValidateBasic : implements msg
-func (msg MsgDefineACLs) ValidateBasic() sdk.Error {
-	if len(msg.DefineACLs) == 0 {
+func (msg MsgSetTradingLimits) ValidateBasic() sdk.Error {
+	if len(msg.SetTradingLimits) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
-	for _, in := range msg.DefineACLs {
+	for _, in := range msg.SetTradingLimits {
 		if err := in.ValidateBasic(); err != nil {
 			return err.TraceSDK("")
 		}
@@ -1512,16 +3411,16 @@ func (msg MsgDefineACLs) ValidateBasic() sdk.Error {
 }
 
 // GetSignBytes : implements msg
-func (msg MsgDefineACLs) GetSignBytes() []byte {
-	var defineACLs []json.RawMessage
-	for _, defineACL := range msg.DefineACLs {
-		defineACLs = append(defineACLs, defineACL.GetSignBytes())
+func (msg MsgSetTradingLimits) GetSignBytes() []byte {
+	var setTradingLimits []json.RawMessage
+	for _, setTradingLimit := range msg.SetTradingLimits {
+		setTradingLimits = append(setTradingLimits, setTradingLimit.GetSignBytes())
 	}
 
 	b, err := ModuleCdc.MarshalJSON(struct {
-		DefineACLs []json.RawMessage `json:"defineACLs"`
+		SetTradingLimits []json.RawMessage `json:"setTradingLimits"`
 	}{
-		DefineACLs: defineACLs,
+		SetTradingLimits: setTradingLimits,
 	})
 	if err != nil {
 		panic(err)
@@ -1530,30 +3429,18 @@ func (msg MsgDefineACLs) GetSignBytes() []byte {
 }
 
 // GetSigners : implements msg
-func (msg MsgDefineACLs) GetSigners() []sdk.AccAddress {
-	addrs := make([]sdk.AccAddress, len(msg.DefineACLs))
-	for i, in := range msg.DefineACLs {
+func (msg MsgSetTradingLimits) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.SetTradingLimits))
+	for i, in := range msg.SetTradingLimits {
 		addrs[i] = in.From
 	}
 	return addrs
 }
 
-// BuildMsgDefineACLs : build define acls message
-func BuildMsgDefineACLs(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount, msgs []DefineACL) []DefineACL {
-	defineACL := NewDefineACL(from, to, aclAccount)
-	msgs = append(msgs, defineACL)
-	return msgs
-}
-
-// BuildMsgDefineACLWithACLs : build define acls message
-func BuildMsgDefineACLWithACLs(msgs []DefineACL) sdk.Msg {
-	return NewMsgDefineACLs(msgs)
-}
-
-// BuildMsgDefineACL : build define acls message
-func BuildMsgDefineACL(from sdk.AccAddress, to sdk.AccAddress, aclAccount acl.ACLAccount) sdk.Msg {
-	defineACL := NewDefineACL(from, to, aclAccount)
-	return NewMsgDefineACLs([]DefineACL{defineACL})
+// BuildMsgSetTradingLimit : build set trading limits message
+func BuildMsgSetTradingLimit(from sdk.AccAddress, organizationID acl.OrganizationID, to sdk.AccAddress, tradingLimit acl.TradingLimit) sdk.Msg {
+	setTradingLimit := NewSetTradingLimit(from, organizationID, to, tradingLimit)
+	return NewMsgSetTradingLimits([]SetTradingLimit{setTradingLimit})
 }
 
 // #####ACL
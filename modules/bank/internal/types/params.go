@@ -9,14 +9,21 @@ const (
 	DefaultParamspace = ModuleName
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultClawbackGoverned zones claw back directly
+	DefaultClawbackGoverned = false
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeyClawbackGoverned is store's key for ClawbackGoverned
+	ParamStoreKeyClawbackGoverned = []byte("clawbackgoverned")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeySendEnabled, false,
+		ParamStoreKeyClawbackGoverned, false,
 	)
 }
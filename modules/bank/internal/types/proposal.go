@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+)
+
+const (
+	ProposalTypeClawback = "Clawback"
+)
+
+var _ govTypes.Content = ClawbackProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeClawback)
+	govTypes.RegisterProposalTypeCodec(ClawbackProposal{}, "commit-blockchain/ClawbackProposal")
+}
+
+// ClawbackProposal : returns a fraudulent peg to its issuer once passed, the only clawback while clawbacks are governed
+type ClawbackProposal struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Clawback    Clawback `json:"clawback"`
+}
+
+func NewClawbackProposal(title, description string, clawback Clawback) ClawbackProposal {
+	return ClawbackProposal{title, description, clawback}
+}
+
+func (proposal ClawbackProposal) GetTitle() string       { return proposal.Title }
+func (proposal ClawbackProposal) GetDescription() string { return proposal.Description }
+func (proposal ClawbackProposal) ProposalRoute() string  { return RouterKey }
+func (proposal ClawbackProposal) ProposalType() string   { return ProposalTypeClawback }
+
+func (proposal ClawbackProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodespace, proposal); err != nil {
+		return err
+	}
+	return proposal.Clawback.ValidateBasic()
+}
+
+func (proposal ClawbackProposal) String() string {
+	return fmt.Sprintf(`Clawback Proposal:
+  Title:         %s
+  Description:   %s
+  IssuerAddress: %s
+  FromAddress:   %s
+  PegType:       %s
+  PegHash:       %s
+  Reason:        %s
+`, proposal.Title, proposal.Description, proposal.Clawback.IssuerAddress.String(), proposal.Clawback.FromAddress.String(),
+		proposal.Clawback.PegType, proposal.Clawback.PegHash.String(), proposal.Clawback.Reason)
+}
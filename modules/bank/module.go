@@ -68,13 +68,30 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		cli.BuyerExecuteOrderCmd(cdc),
 		cli.DefineACLCmd(cdc),
 		cli.DefineOrganizationCmd(cdc),
+		cli.DefineRoleCmd(cdc),
+		cli.SuspendACLCmd(cdc),
+		cli.ReinstateACLCmd(cdc),
+		cli.RevokeACLCmd(cdc),
+		cli.RotateZoneAddressCmd(cdc),
+		cli.RotateOrganizationAddressCmd(cdc),
+		cli.DefineSubOrganizationCmd(cdc),
+		cli.SetOrganizationAdminsCmd(cdc),
+		cli.RecordKYCCmd(cdc),
+		cli.SetTradingLimitCmd(cdc),
 		cli.DefineZoneCmd(cdc),
 		cli.IssueAssetCmd(cdc),
 		cli.IssueFiatCmd(cdc),
 		cli.RedeemAssetCmd(cdc),
 		cli.RedeemFiatCmd(cdc),
 		cli.ReleaseAssetCmd(cdc),
+		cli.ClawbackCmd(cdc),
 		cli.SellerExecuteOrderCmd(cdc),
+		cli.SellerExecuteTrancheCmd(cdc),
+		cli.RefundTrancheOrderCmd(cdc),
+		cli.SendSwapAssetCmd(cdc),
+		cli.ExecuteSwapCmd(cdc),
+		cli.SendCoinToOrderCmd(cdc),
+		cli.ConsolidateFiatCmd(cdc),
 		cli.SendAssetCmd(cdc),
 		cli.SendFiatCmd(cdc),
 	)...)
@@ -106,14 +123,20 @@ type AppModule struct {
 	AppModuleBasic
 	keeper        Keeper
 	accountKeeper types.AccountKeeper
+	reserveKeeper types.ReserveKeeper
+	escrowKeepers []types.PegEscrowKeeper
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper) AppModule {
+// NewAppModule creates a new AppModule object, escrowKeepers are the modules holding pegs outside of account wallets
+func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper, reserveKeeper types.ReserveKeeper,
+	escrowKeepers ...types.PegEscrowKeeper) AppModule {
+
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
+		reserveKeeper:  reserveKeeper,
+		escrowKeepers:  escrowKeepers,
 	}
 }
 
@@ -122,7 +145,7 @@ func (AppModule) Name() string { return ModuleName }
 
 // register invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	keeper.RegisterInvariants(ir, am.accountKeeper)
+	keeper.RegisterInvariants(ir, am.accountKeeper, am.reserveKeeper, am.escrowKeepers)
 }
 
 // module message route name
@@ -0,0 +1,30 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/bank/client/cli"
+	"github.com/commitHub/commitBlockchain/modules/bank/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/bank/internal/types"
+	govclient "github.com/commitHub/commitBlockchain/modules/gov/client"
+	govTypes "github.com/commitHub/commitBlockchain/modules/gov/types"
+)
+
+// ClawbackProposalHandler : clawback proposal client handler
+var ClawbackProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitClawbackProposal, rest.ClawbackProposalRESTHandler)
+
+// NewClawbackProposalHandler : handles the clawbacks passed by governance
+func NewClawbackProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ClawbackProposal:
+			return k.ExecuteClawback(ctx, c.Clawback)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized bank proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
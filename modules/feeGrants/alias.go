@@ -0,0 +1,52 @@
+package feeGrants
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/feeGrants/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	QueryFeeAllowance  = keeper.QueryFeeAllowance
+	QueryFeeAllowances = keeper.QueryFeeAllowances
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewFeeAllowance = types.NewFeeAllowance
+
+	ErrInvalidFeeAllowance = types.ErrInvalidFeeAllowance
+	ErrUnauthorized        = types.ErrUnauthorized
+
+	BuildMsgGrantFeeAllowances  = types.BuildMsgGrantFeeAllowances
+	BuildMsgRevokeFeeAllowances = types.BuildMsgRevokeFeeAllowances
+
+	EventTypeGrantFeeAllowance  = types.EventTypeGrantFeeAllowance
+	EventTypeRevokeFeeAllowance = types.EventTypeRevokeFeeAllowance
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+
+	FeeAllowance = types.FeeAllowance
+
+	MsgGrantFeeAllowances  = types.MsgGrantFeeAllowances
+	GrantFeeAllowance      = types.GrantFeeAllowance
+	MsgRevokeFeeAllowances = types.MsgRevokeFeeAllowances
+	RevokeFeeAllowance     = types.RevokeFeeAllowance
+)
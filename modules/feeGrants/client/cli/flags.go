@@ -0,0 +1,27 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagTo              = "to"
+	FlagSpendLimit      = "spend-limit"
+	FlagExpiryHeight    = "expiry-height"
+	FlagAllowedMsgTypes = "allowed-msg-types"
+)
+
+var (
+	fsTo              = flag.NewFlagSet("", flag.ContinueOnError)
+	fsSpendLimit      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsExpiryHeight    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAllowedMsgTypes = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsTo.String(FlagTo, "", "Address of the trader whose fees are paid")
+	fsSpendLimit.String(FlagSpendLimit, "", "Fees paid at most, e.g. 1000commit")
+	fsExpiryHeight.Int64(FlagExpiryHeight, 0, "Block height the allowance expires at, 0 never expires")
+	fsAllowedMsgTypes.String(FlagAllowedMsgTypes, "", "Comma separated message types paid, empty for every message type")
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+func GrantFeeAllowanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "Pay the fees of a trader of the organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := cTypes.ParseCoins(viper.GetString(FlagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			var allowedMsgTypes []string
+			if viper.GetString(FlagAllowedMsgTypes) != "" {
+				allowedMsgTypes = strings.Split(viper.GetString(FlagAllowedMsgTypes), ",")
+			}
+
+			msg := feeGrantTypes.BuildMsgGrantFeeAllowances(cliCtx.GetFromAddress(), to, spendLimit,
+				viper.GetInt64(FlagExpiryHeight), allowedMsgTypes)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsTo)
+	cmd.Flags().AddFlagSet(fsSpendLimit)
+	cmd.Flags().AddFlagSet(fsExpiryHeight)
+	cmd.Flags().AddFlagSet(fsAllowedMsgTypes)
+	return cmd
+}
+
+func RevokeFeeAllowanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Stop paying the fees of a trader",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := cTypes.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			msg := feeGrantTypes.BuildMsgRevokeFeeAllowances(cliCtx.GetFromAddress(), to)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsTo)
+	return cmd
+}
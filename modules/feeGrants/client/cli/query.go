@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+func GetFeeAllowanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [grantee]",
+		Short: "Query the fee allowance granted to a trader",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", feeGrantTypes.QuerierRoute, "queryFeeAllowance", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var feeAllowance feeGrantTypes.FeeAllowance
+			cdc.MustUnmarshalJSON(res, &feeAllowance)
+			return cliCtx.PrintOutput(feeAllowance)
+		},
+	}
+
+	return cmd
+}
+
+func GetFeeAllowancesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowances [granter]",
+		Short: "Query the fee allowances granted by an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", feeGrantTypes.QuerierRoute, "queryFeeAllowances", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var feeAllowances []feeGrantTypes.FeeAllowance
+			cdc.MustUnmarshalJSON(res, &feeAllowances)
+
+			output, err := cdc.MarshalJSONIndent(feeAllowances, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+func QueryFeeAllowanceRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", feeGrantTypes.QuerierRoute, "queryFeeAllowance", vars["grantee"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query FeeAllowance. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var feeAllowance feeGrantTypes.FeeAllowance
+		cliCtx.Codec.MustUnmarshalJSON(res, &feeAllowance)
+
+		rest.PostProcessResponse(w, cliCtx, feeAllowance)
+	}
+}
+
+func QueryFeeAllowancesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", feeGrantTypes.QuerierRoute, "queryFeeAllowances", vars["granter"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query FeeAllowances. Error: %s", err.Error()))
+			return
+		}
+
+		var feeAllowances []feeGrantTypes.FeeAllowance
+		cliCtx.Codec.MustUnmarshalJSON(res, &feeAllowances)
+
+		rest.PostProcessResponse(w, cliCtx, feeAllowances)
+	}
+}
@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/feeAllowance/{grantee}", QueryFeeAllowanceRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/feeAllowances/{granter}", QueryFeeAllowancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/grantFeeAllowance", GrantFeeAllowanceRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/revokeFeeAllowance", RevokeFeeAllowanceRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
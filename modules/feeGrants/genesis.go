@@ -0,0 +1,17 @@
+package feeGrants
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, feeAllowance := range data.FeeAllowances {
+		keeper.SetFeeAllowance(ctx, feeAllowance)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	feeAllowances := keeper.GetFeeAllowances(ctx, nil)
+
+	return GenesisState{FeeAllowances: feeAllowances}
+}
@@ -0,0 +1,48 @@
+package feeGrants
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowances:
+			return handleMsgGrantFeeAllowances(ctx, k, msg)
+		case MsgRevokeFeeAllowances:
+			return handleMsgRevokeFeeAllowances(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowances(ctx cTypes.Context, k Keeper, msg MsgGrantFeeAllowances) cTypes.Result {
+	for _, grantFeeAllowance := range msg.GrantFeeAllowances {
+		if err := k.GrantFeeAllowance(ctx, grantFeeAllowance); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRevokeFeeAllowances(ctx cTypes.Context, k Keeper, msg MsgRevokeFeeAllowances) cTypes.Result {
+	for _, revokeFeeAllowance := range msg.RevokeFeeAllowances {
+		if err := k.RevokeFeeAllowance(ctx, revokeFeeAllowance); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
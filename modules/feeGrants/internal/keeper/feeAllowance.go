@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+// GrantFeeAllowance : only the organization of the grantee can pay its fees
+func (k Keeper) GrantFeeAllowance(ctx cTypes.Context, grantFeeAllowance feeGrantTypes.GrantFeeAllowance) cTypes.Error {
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, grantFeeAllowance.ToAddress)
+	if err != nil {
+		return err
+	}
+	if !k.aclKeeper.CheckValidOrganizationAddress(ctx, aclAccount.GetZoneID(), aclAccount.GetOrganizationID(), grantFeeAllowance.FromAddress) {
+		return feeGrantTypes.ErrUnauthorized(feeGrantTypes.DefaultCodeSpace)
+	}
+	if grantFeeAllowance.ExpiryHeight != 0 && grantFeeAllowance.ExpiryHeight <= ctx.BlockHeight() {
+		return feeGrantTypes.ErrInvalidFeeAllowance(feeGrantTypes.DefaultCodeSpace, "ExpiryHeight should be in the future.")
+	}
+
+	feeAllowance := grantFeeAllowance.GetFeeAllowance()
+	k.SetFeeAllowance(ctx, feeAllowance)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(feeGrantTypes.EventTypeGrantFeeAllowance,
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyGranter, feeAllowance.Granter.String()),
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyGrantee, feeAllowance.Grantee.String()),
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeySpendLimit, feeAllowance.SpendLimit.String()),
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyExpiryHeight, strconv.FormatInt(feeAllowance.ExpiryHeight, 10)),
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyAllowedMsgTypes, strings.Join(feeAllowance.AllowedMsgTypes, ",")),
+		))
+
+	return nil
+}
+
+// RevokeFeeAllowance : only the granter can revoke the allowance
+func (k Keeper) RevokeFeeAllowance(ctx cTypes.Context, revokeFeeAllowance feeGrantTypes.RevokeFeeAllowance) cTypes.Error {
+	feeAllowance, err := k.GetFeeAllowance(ctx, revokeFeeAllowance.ToAddress)
+	if err != nil {
+		return err
+	}
+	if !feeAllowance.Granter.Equals(revokeFeeAllowance.FromAddress) {
+		return feeGrantTypes.ErrUnauthorized(feeGrantTypes.DefaultCodeSpace)
+	}
+
+	k.DeleteFeeAllowance(ctx, revokeFeeAllowance.ToAddress)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(feeGrantTypes.EventTypeRevokeFeeAllowance,
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyGranter, feeAllowance.Granter.String()),
+			cTypes.NewAttribute(feeGrantTypes.AttributeKeyGrantee, feeAllowance.Grantee.String()),
+		))
+
+	return nil
+}
+
+// UseGrantedFees : returns the granter paying the fee of the grantee's transaction and takes the fee off its allowance.
+// Transactions the allowance doesn't cover, with another message type or a fee above what is left, are paid by the grantee.
+// Allowances of grantees that left the organization, or of granters that no longer hold its address, are removed.
+func (k Keeper) UseGrantedFees(ctx cTypes.Context, grantee cTypes.AccAddress, fee cTypes.Coins, msgs []cTypes.Msg) (cTypes.AccAddress, bool) {
+	feeAllowance, err := k.GetFeeAllowance(ctx, grantee)
+	if err != nil {
+		return nil, false
+	}
+	if feeAllowance.IsExpired(ctx.BlockHeight()) || !k.isGrantedByOrganization(ctx, feeAllowance) {
+		k.DeleteFeeAllowance(ctx, grantee)
+		return nil, false
+	}
+	if !feeAllowance.Allows(msgs) {
+		return nil, false
+	}
+
+	spendLimit, negative := feeAllowance.SpendLimit.SafeSub(fee)
+	if negative {
+		return nil, false
+	}
+
+	if spendLimit.IsZero() {
+		k.DeleteFeeAllowance(ctx, grantee)
+	} else {
+		feeAllowance.SpendLimit = spendLimit
+		k.SetFeeAllowance(ctx, feeAllowance)
+	}
+	return feeAllowance.Granter, true
+}
+
+// isGrantedByOrganization : the granter still holds the address of the organization of the grantee
+func (k Keeper) isGrantedByOrganization(ctx cTypes.Context, feeAllowance feeGrantTypes.FeeAllowance) bool {
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, feeAllowance.Grantee)
+	if err != nil {
+		return false
+	}
+	return k.aclKeeper.CheckValidOrganizationAddress(ctx, aclAccount.GetZoneID(), aclAccount.GetOrganizationID(), feeAllowance.Granter)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+// testACLKeeper : organizations of accounts, and addresses of organizations
+type testACLKeeper struct {
+	accounts      map[string]acl.OrganizationID
+	organizations map[string]cTypes.AccAddress
+}
+
+func (k testACLKeeper) GetAccountACLDetails(_ cTypes.Context, address cTypes.AccAddress) (acl.ACLAccount, cTypes.Error) {
+	organizationID, found := k.accounts[address.String()]
+	if !found {
+		return nil, cTypes.ErrUnknownAddress(address.String())
+	}
+	return &acl.BaseACLAccount{Address: address, ZoneID: acl.ZoneID([]byte("zone")), OrganizationID: organizationID}, nil
+}
+
+func (k testACLKeeper) CheckValidOrganizationAddress(_ cTypes.Context, _ acl.ZoneID, organizationID acl.OrganizationID,
+	address cTypes.AccAddress) bool {
+
+	return k.organizations[organizationID.String()].Equals(address)
+}
+
+func setupTestInput(t *testing.T) (cTypes.Context, Keeper, testACLKeeper) {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(feeGrantTypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	aclKeeper := testACLKeeper{map[string]acl.OrganizationID{}, map[string]cTypes.AccAddress{}}
+	k := NewKeeper(key, codec.New(), aclKeeper)
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	return ctx, k, aclKeeper
+}
+
+func TestUseGrantedFeesOfTheOrganization(t *testing.T) {
+	ctx, k, aclKeeper := setupTestInput(t)
+
+	organization, grantee := cTypes.AccAddress([]byte("organization")), cTypes.AccAddress([]byte("grantee"))
+	organizationID := acl.OrganizationID([]byte("organization"))
+	aclKeeper.organizations[organizationID.String()] = organization
+	aclKeeper.accounts[grantee.String()] = organizationID
+
+	fee := cTypes.NewCoins(cTypes.NewInt64Coin("stake", 10))
+	require.Nil(t, k.GrantFeeAllowance(ctx, feeGrantTypes.NewGrantFeeAllowance(organization, grantee,
+		cTypes.NewCoins(cTypes.NewInt64Coin("stake", 100)), 0, nil)))
+
+	granter, ok := k.UseGrantedFees(ctx, grantee, fee, nil)
+	require.True(t, ok)
+	require.Equal(t, organization, granter)
+
+	// the organization rotates its address, the allowance of the old address is removed
+	aclKeeper.organizations[organizationID.String()] = cTypes.AccAddress([]byte("rotated"))
+	_, ok = k.UseGrantedFees(ctx, grantee, fee, nil)
+	require.False(t, ok)
+	_, err := k.GetFeeAllowance(ctx, grantee)
+	require.NotNil(t, err)
+}
+
+func TestUseGrantedFeesOfAnotherOrganization(t *testing.T) {
+	ctx, k, aclKeeper := setupTestInput(t)
+
+	organization, grantee := cTypes.AccAddress([]byte("organization")), cTypes.AccAddress([]byte("grantee"))
+	organizationID := acl.OrganizationID([]byte("organization"))
+	aclKeeper.organizations[organizationID.String()] = organization
+	aclKeeper.accounts[grantee.String()] = organizationID
+
+	require.Nil(t, k.GrantFeeAllowance(ctx, feeGrantTypes.NewGrantFeeAllowance(organization, grantee,
+		cTypes.NewCoins(cTypes.NewInt64Coin("stake", 100)), 0, nil)))
+
+	// the grantee moves to another organization
+	aclKeeper.accounts[grantee.String()] = acl.OrganizationID([]byte("other"))
+	_, ok := k.UseGrantedFees(ctx, grantee, cTypes.NewCoins(cTypes.NewInt64Coin("stake", 10)), nil)
+	require.False(t, ok)
+}
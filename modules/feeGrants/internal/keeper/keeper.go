@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	feeGrantTypes "github.com/commitHub/commitBlockchain/modules/feeGrants/internal/types"
+)
+
+type Keeper struct {
+	storeKey  cTypes.StoreKey
+	cdc       *codec.Codec
+	aclKeeper feeGrantTypes.ACLKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, aclKeeper feeGrantTypes.ACLKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		aclKeeper: aclKeeper,
+	}
+}
+
+// feeGrants/{0x01}/{grantee} => feeAllowance
+func (k Keeper) SetFeeAllowance(ctx cTypes.Context, feeAllowance feeGrantTypes.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(feeAllowance)
+	store.Set(feeGrantTypes.GetFeeAllowanceKey(feeAllowance.Grantee), bz)
+}
+
+// returns the fee allowance granted to the grantee
+func (k Keeper) GetFeeAllowance(ctx cTypes.Context, grantee cTypes.AccAddress) (feeAllowance feeGrantTypes.FeeAllowance, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(feeGrantTypes.GetFeeAllowanceKey(grantee))
+	if bz == nil {
+		return feeAllowance, feeGrantTypes.ErrInvalidFeeAllowance(feeGrantTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &feeAllowance)
+	return feeAllowance, nil
+}
+
+func (k Keeper) DeleteFeeAllowance(ctx cTypes.Context, grantee cTypes.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(feeGrantTypes.GetFeeAllowanceKey(grantee))
+}
+
+// get all fee allowances of the granter, or of all granters if granter is nil => []FeeAllowance from store
+func (k Keeper) GetFeeAllowances(ctx cTypes.Context, granter cTypes.AccAddress) (feeAllowances []feeGrantTypes.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, feeGrantTypes.FeeAllowanceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feeAllowance feeGrantTypes.FeeAllowance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &feeAllowance)
+		if granter == nil || feeAllowance.Granter.Equals(granter) {
+			feeAllowances = append(feeAllowances, feeAllowance)
+		}
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryFeeAllowance  = "queryFeeAllowance"
+	QueryFeeAllowances = "queryFeeAllowances"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryFeeAllowance:
+			return queryFeeAllowance(ctx, path[1:], k)
+		case QueryFeeAllowances:
+			return queryFeeAllowances(ctx, path[1:], k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown feeGrants query endpoint")
+		}
+	}
+}
+
+// queryFeeAllowance : fee allowance granted to the grantee
+func queryFeeAllowance(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	grantee, errRes := cTypes.AccAddressFromBech32(path[0])
+	if errRes != nil {
+		return nil, cTypes.ErrInvalidAddress(fmt.Sprintf("invalid grantee %s", path[0]))
+	}
+
+	feeAllowance, err := k.GetFeeAllowance(ctx, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, feeAllowance)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+// queryFeeAllowances : fee allowances granted by the granter
+func queryFeeAllowances(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	granter, errRes := cTypes.AccAddressFromBech32(path[0])
+	if errRes != nil {
+		return nil, cTypes.ErrInvalidAddress(fmt.Sprintf("invalid granter %s", path[0]))
+	}
+
+	feeAllowances := k.GetFeeAllowances(ctx, granter)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, feeAllowances)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,16 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgGrantFeeAllowances{}, "commit-blockchain/MsgGrantFeeAllowances", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowances{}, "commit-blockchain/MsgRevokeFeeAllowances", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
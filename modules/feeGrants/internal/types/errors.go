@@ -0,0 +1,29 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidFeeAllowance  cTypes.CodeType = 1501
+	CodeUnauthorized         cTypes.CodeType = 1502
+	CodeInvalidInputsOutputs cTypes.CodeType = 1503
+)
+
+func ErrInvalidFeeAllowance(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidFeeAllowance, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidFeeAllowance, "fee allowance doesn't exist")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
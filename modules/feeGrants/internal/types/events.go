@@ -0,0 +1,12 @@
+package types
+
+var (
+	EventTypeGrantFeeAllowance  = "grantFeeAllowance"
+	EventTypeRevokeFeeAllowance = "revokeFeeAllowance"
+
+	AttributeKeyGranter         = "granter"
+	AttributeKeyGrantee         = "grantee"
+	AttributeKeySpendLimit      = "spendLimit"
+	AttributeKeyExpiryHeight    = "expiryHeight"
+	AttributeKeyAllowedMsgTypes = "allowedMsgTypes"
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+type ACLKeeper interface {
+	GetAccountACLDetails(ctx cTypes.Context, address cTypes.AccAddress) (acl.ACLAccount, cTypes.Error)
+	CheckValidOrganizationAddress(ctx cTypes.Context, zoneID acl.ZoneID, organizationID acl.OrganizationID, address cTypes.AccAddress) bool
+}
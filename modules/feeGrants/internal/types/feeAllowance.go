@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance : fees of the grantee's transactions the granter pays, up to SpendLimit until ExpiryHeight, for the
+// AllowedMsgTypes only. A zero ExpiryHeight never expires and empty AllowedMsgTypes allow every message type.
+type FeeAllowance struct {
+	Granter         cTypes.AccAddress `json:"granter"`
+	Grantee         cTypes.AccAddress `json:"grantee"`
+	SpendLimit      cTypes.Coins      `json:"spendLimit"`
+	ExpiryHeight    int64             `json:"expiryHeight"`
+	AllowedMsgTypes []string          `json:"allowedMsgTypes"`
+}
+
+func NewFeeAllowance(granter cTypes.AccAddress, grantee cTypes.AccAddress, spendLimit cTypes.Coins, expiryHeight int64,
+	allowedMsgTypes []string) FeeAllowance {
+
+	return FeeAllowance{
+		Granter:         granter,
+		Grantee:         grantee,
+		SpendLimit:      spendLimit,
+		ExpiryHeight:    expiryHeight,
+		AllowedMsgTypes: allowedMsgTypes,
+	}
+}
+
+// IsExpired : the allowance can't be used from the expiry height on
+func (feeAllowance FeeAllowance) IsExpired(height int64) bool {
+	return feeAllowance.ExpiryHeight != 0 && height >= feeAllowance.ExpiryHeight
+}
+
+// Allows : the allowance covers every message of the transaction
+func (feeAllowance FeeAllowance) Allows(msgs []cTypes.Msg) bool {
+	if len(feeAllowance.AllowedMsgTypes) == 0 {
+		return true
+	}
+	for _, msg := range msgs {
+		allowed := false
+		for _, msgType := range feeAllowance.AllowedMsgTypes {
+			if msg.Type() == msgType {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+func (feeAllowance FeeAllowance) ValidateBasic() cTypes.Error {
+	if len(feeAllowance.Granter) == 0 {
+		return cTypes.ErrInvalidAddress(feeAllowance.Granter.String())
+	} else if len(feeAllowance.Grantee) == 0 {
+		return cTypes.ErrInvalidAddress(feeAllowance.Grantee.String())
+	} else if feeAllowance.Granter.Equals(feeAllowance.Grantee) {
+		return ErrInvalidFeeAllowance(DefaultCodeSpace, "Granter can't grant a fee allowance to itself.")
+	} else if !feeAllowance.SpendLimit.IsValid() {
+		return cTypes.ErrInvalidCoins(feeAllowance.SpendLimit.String())
+	} else if feeAllowance.ExpiryHeight < 0 {
+		return ErrInvalidFeeAllowance(DefaultCodeSpace, "ExpiryHeight should not be negative.")
+	}
+	return nil
+}
+
+func (feeAllowance FeeAllowance) String() string {
+	return fmt.Sprintf(`FeeAllowance:
+Granter: %s,
+Grantee: %s,
+SpendLimit: %s,
+ExpiryHeight: %d,
+AllowedMsgTypes: %s,
+`, feeAllowance.Granter.String(), feeAllowance.Grantee.String(), feeAllowance.SpendLimit.String(),
+		feeAllowance.ExpiryHeight, strings.Join(feeAllowance.AllowedMsgTypes, ","))
+}
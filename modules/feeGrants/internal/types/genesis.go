@@ -0,0 +1,25 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	FeeAllowances []FeeAllowance `json:"feeAllowances"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	grantees := make(map[string]bool)
+	for _, feeAllowance := range data.FeeAllowances {
+		if err := feeAllowance.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fee allowance in genesis: %s", err.Error())
+		}
+		if grantees[feeAllowance.Grantee.String()] {
+			return fmt.Errorf("duplicate fee allowance of %s in genesis", feeAllowance.Grantee.String())
+		}
+		grantees[feeAllowance.Grantee.String()] = true
+	}
+	return nil
+}
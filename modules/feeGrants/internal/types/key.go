@@ -0,0 +1,21 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "feeGrants"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	FeeAllowanceKey = []byte{0x01}
+)
+
+// feeGrants/{0x01}/{grantee}
+func GetFeeAllowanceKey(grantee cTypes.AccAddress) []byte {
+	return append(FeeAllowanceKey, grantee.Bytes()...)
+}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// *****GrantFeeAllowance
+
+// GrantFeeAllowance : organization pays the fees of a trader of the organization, replacing its previous allowance
+type GrantFeeAllowance struct {
+	FromAddress     cTypes.AccAddress `json:"fromAddress"`
+	ToAddress       cTypes.AccAddress `json:"toAddress"`
+	SpendLimit      cTypes.Coins      `json:"spendLimit"`
+	ExpiryHeight    int64             `json:"expiryHeight"`
+	AllowedMsgTypes []string          `json:"allowedMsgTypes"`
+}
+
+// NewGrantFeeAllowance : initializer
+func NewGrantFeeAllowance(fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, spendLimit cTypes.Coins,
+	expiryHeight int64, allowedMsgTypes []string) GrantFeeAllowance {
+
+	return GrantFeeAllowance{fromAddress, toAddress, spendLimit, expiryHeight, allowedMsgTypes}
+}
+
+// GetSignBytes : get bytes to sign
+func (in GrantFeeAllowance) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress     string       `json:"fromAddress"`
+		ToAddress       string       `json:"toAddress"`
+		SpendLimit      cTypes.Coins `json:"spendLimit"`
+		ExpiryHeight    int64        `json:"expiryHeight"`
+		AllowedMsgTypes []string     `json:"allowedMsgTypes"`
+	}{
+		FromAddress:     in.FromAddress.String(),
+		ToAddress:       in.ToAddress.String(),
+		SpendLimit:      in.SpendLimit,
+		ExpiryHeight:    in.ExpiryHeight,
+		AllowedMsgTypes: in.AllowedMsgTypes,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in GrantFeeAllowance) ValidateBasic() cTypes.Error {
+	if in.SpendLimit.Empty() {
+		return cTypes.ErrInvalidCoins(in.SpendLimit.String())
+	}
+	return in.GetFeeAllowance().ValidateBasic()
+}
+
+// GetFeeAllowance : allowance granted by the message
+func (in GrantFeeAllowance) GetFeeAllowance() FeeAllowance {
+	return NewFeeAllowance(in.FromAddress, in.ToAddress, in.SpendLimit, in.ExpiryHeight, in.AllowedMsgTypes)
+}
+
+// MsgGrantFeeAllowances : high level grant fee allowance of feeGrants module
+type MsgGrantFeeAllowances struct {
+	GrantFeeAllowances []GrantFeeAllowance `json:"grantFeeAllowances"`
+}
+
+// NewMsgGrantFeeAllowances : initializer
+func NewMsgGrantFeeAllowances(grantFeeAllowances []GrantFeeAllowance) MsgGrantFeeAllowances {
+	return MsgGrantFeeAllowances{grantFeeAllowances}
+}
+
+var _ cTypes.Msg = MsgGrantFeeAllowances{}
+
+// Type : implements msg
+func (msg MsgGrantFeeAllowances) Type() string { return "grantFeeAllowances" }
+
+func (msg MsgGrantFeeAllowances) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgGrantFeeAllowances) ValidateBasic() cTypes.Error {
+	if len(msg.GrantFeeAllowances) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.GrantFeeAllowances {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgGrantFeeAllowances) GetSignBytes() []byte {
+	var grantFeeAllowances []json.RawMessage
+	for _, grantFeeAllowance := range msg.GrantFeeAllowances {
+		grantFeeAllowances = append(grantFeeAllowances, grantFeeAllowance.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		GrantFeeAllowances []json.RawMessage `json:"grantFeeAllowances"`
+	}{
+		GrantFeeAllowances: grantFeeAllowances,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgGrantFeeAllowances) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.GrantFeeAllowances))
+	for i, in := range msg.GrantFeeAllowances {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgGrantFeeAllowances : build the MsgGrantFeeAllowances
+func BuildMsgGrantFeeAllowances(fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, spendLimit cTypes.Coins,
+	expiryHeight int64, allowedMsgTypes []string) cTypes.Msg {
+
+	grantFeeAllowance := NewGrantFeeAllowance(fromAddress, toAddress, spendLimit, expiryHeight, allowedMsgTypes)
+	msg := NewMsgGrantFeeAllowances([]GrantFeeAllowance{grantFeeAllowance})
+	return msg
+}
+
+// #####GrantFeeAllowance
+
+// *****RevokeFeeAllowance
+
+// RevokeFeeAllowance : granter stops paying the fees of the grantee
+type RevokeFeeAllowance struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	ToAddress   cTypes.AccAddress `json:"toAddress"`
+}
+
+// NewRevokeFeeAllowance : initializer
+func NewRevokeFeeAllowance(fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress) RevokeFeeAllowance {
+	return RevokeFeeAllowance{fromAddress, toAddress}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RevokeFeeAllowance) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		ToAddress   string `json:"toAddress"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		ToAddress:   in.ToAddress.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RevokeFeeAllowance) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.ToAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.ToAddress.String())
+	}
+	return nil
+}
+
+// MsgRevokeFeeAllowances : high level revoke fee allowance of feeGrants module
+type MsgRevokeFeeAllowances struct {
+	RevokeFeeAllowances []RevokeFeeAllowance `json:"revokeFeeAllowances"`
+}
+
+// NewMsgRevokeFeeAllowances : initializer
+func NewMsgRevokeFeeAllowances(revokeFeeAllowances []RevokeFeeAllowance) MsgRevokeFeeAllowances {
+	return MsgRevokeFeeAllowances{revokeFeeAllowances}
+}
+
+var _ cTypes.Msg = MsgRevokeFeeAllowances{}
+
+// Type : implements msg
+func (msg MsgRevokeFeeAllowances) Type() string { return "revokeFeeAllowances" }
+
+func (msg MsgRevokeFeeAllowances) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRevokeFeeAllowances) ValidateBasic() cTypes.Error {
+	if len(msg.RevokeFeeAllowances) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.RevokeFeeAllowances {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRevokeFeeAllowances) GetSignBytes() []byte {
+	var revokeFeeAllowances []json.RawMessage
+	for _, revokeFeeAllowance := range msg.RevokeFeeAllowances {
+		revokeFeeAllowances = append(revokeFeeAllowances, revokeFeeAllowance.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RevokeFeeAllowances []json.RawMessage `json:"revokeFeeAllowances"`
+	}{
+		RevokeFeeAllowances: revokeFeeAllowances,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRevokeFeeAllowances) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.RevokeFeeAllowances))
+	for i, in := range msg.RevokeFeeAllowances {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgRevokeFeeAllowances : build the MsgRevokeFeeAllowances
+func BuildMsgRevokeFeeAllowances(fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress) cTypes.Msg {
+	revokeFeeAllowance := NewRevokeFeeAllowance(fromAddress, toAddress)
+	msg := NewMsgRevokeFeeAllowances([]RevokeFeeAllowance{revokeFeeAllowance})
+	return msg
+}
+
+// #####RevokeFeeAllowance
@@ -0,0 +1,60 @@
+package fiatTokens
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	QueryFiatToken  = keeper.QueryFiatToken
+	QueryFiatTokens = keeper.QueryFiatTokens
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewFiatToken    = types.NewFiatToken
+	GetFiatTokenKey = types.GetFiatTokenKey
+
+	ErrInvalidFiatToken    = types.ErrInvalidFiatToken
+	ErrFiatTokenExists     = types.ErrFiatTokenExists
+	ErrInsufficientReserve = types.ErrInsufficientReserve
+	ErrUnauthorized        = types.ErrUnauthorized
+
+	BuildMsgRegisterFiatToken = types.BuildMsgRegisterFiatToken
+	BuildMsgMintFiatToken     = types.BuildMsgMintFiatToken
+	BuildMsgBurnFiatToken     = types.BuildMsgBurnFiatToken
+
+	EventTypeRegisterFiatToken = types.EventTypeRegisterFiatToken
+	EventTypeMintFiatToken     = types.EventTypeMintFiatToken
+	EventTypeBurnFiatToken     = types.EventTypeBurnFiatToken
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+
+	FiatToken = types.FiatToken
+
+	MsgRegisterFiatTokens = types.MsgRegisterFiatTokens
+	MsgMintFiatTokens     = types.MsgMintFiatTokens
+	MsgBurnFiatTokens     = types.MsgBurnFiatTokens
+
+	RegisterFiatToken = types.RegisterFiatToken
+	MintFiatToken     = types.MintFiatToken
+	BurnFiatToken     = types.BurnFiatToken
+)
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagDenom    = "denom"
+	FlagZoneID   = "zone-id"
+	FlagCurrency = "currency"
+	FlagAmount   = "amount"
+)
+
+var (
+	fsDenom    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsZoneID   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCurrency = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAmount   = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsDenom.String(FlagDenom, "", "Coin denomination of the fiat token, e.g. uzoneusd")
+	fsZoneID.String(FlagZoneID, "", "ZoneID registering the fiat token")
+	fsCurrency.String(FlagCurrency, "", "Currency of the fiat pegs backing the fiat token, e.g. usd")
+	fsAmount.String(FlagAmount, "0", "Amount of fiat to convert")
+}
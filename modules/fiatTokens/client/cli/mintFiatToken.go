@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+func MintFiatTokenCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Convert fiat pegs into coins of a fiat token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := fiatTokenTypes.BuildMsgMintFiatToken(cliCtx.GetFromAddress(), viper.GetString(FlagDenom), viper.GetInt64(FlagAmount))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDenom)
+	cmd.Flags().AddFlagSet(fsAmount)
+	return cmd
+}
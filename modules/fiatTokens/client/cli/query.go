@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+func GetFiatTokenCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fiat-token [denom]",
+		Short: "Query fiat token details and reserve",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", fiatTokenTypes.QuerierRoute, "queryFiatToken", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var fiatToken fiatTokenTypes.FiatToken
+			cdc.MustUnmarshalJSON(res, &fiatToken)
+			return cliCtx.PrintOutput(fiatToken)
+		},
+	}
+
+	return cmd
+}
+
+func GetFiatTokensCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fiat-tokens",
+		Short: "Query all fiat tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", fiatTokenTypes.QuerierRoute, "queryFiatTokens"), nil)
+			if err != nil {
+				return err
+			}
+
+			var fiatTokens []fiatTokenTypes.FiatToken
+			cdc.MustUnmarshalJSON(res, &fiatTokens)
+
+			output, err := cdc.MarshalJSONIndent(fiatTokens, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+func RegisterFiatTokenCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register the coin denomination fiat pegs of the zone convert into",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			msg := fiatTokenTypes.BuildMsgRegisterFiatToken(cliCtx.GetFromAddress(), viper.GetString(FlagDenom), zoneID,
+				viper.GetString(FlagCurrency))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDenom)
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsCurrency)
+	return cmd
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+func QueryFiatTokenRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", fiatTokenTypes.QuerierRoute, "queryFiatToken", vars["denom"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query FiatToken. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var fiatToken fiatTokenTypes.FiatToken
+		cliCtx.Codec.MustUnmarshalJSON(res, &fiatToken)
+
+		rest.PostProcessResponse(w, cliCtx, fiatToken)
+	}
+}
+
+func QueryFiatTokensRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", fiatTokenTypes.QuerierRoute, "queryFiatTokens"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query FiatTokens. Error: %s", err.Error()))
+			return
+		}
+
+		var fiatTokens []fiatTokenTypes.FiatToken
+		cliCtx.Codec.MustUnmarshalJSON(res, &fiatTokens)
+
+		rest.PostProcessResponse(w, cliCtx, fiatTokens)
+	}
+}
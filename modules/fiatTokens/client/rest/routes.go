@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/fiatToken/{denom}", QueryFiatTokenRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/fiatTokens", QueryFiatTokensRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/registerFiatToken", RegisterFiatTokenRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/mintFiatToken", MintFiatTokenRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/burnFiatToken", BurnFiatTokenRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
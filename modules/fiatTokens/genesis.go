@@ -0,0 +1,17 @@
+package fiatTokens
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, fiatToken := range data.FiatTokens {
+		keeper.SetFiatToken(ctx, fiatToken)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	fiatTokens := keeper.GetFiatTokens(ctx)
+
+	return GenesisState{FiatTokens: fiatTokens}
+}
@@ -0,0 +1,62 @@
+package fiatTokens
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgRegisterFiatTokens:
+			return handleMsgRegisterFiatTokens(ctx, k, msg)
+		case MsgMintFiatTokens:
+			return handleMsgMintFiatTokens(ctx, k, msg)
+		case MsgBurnFiatTokens:
+			return handleMsgBurnFiatTokens(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgRegisterFiatTokens(ctx cTypes.Context, k Keeper, msg MsgRegisterFiatTokens) cTypes.Result {
+	for _, registerFiatToken := range msg.RegisterFiatTokens {
+		if err := k.RegisterFiatToken(ctx, registerFiatToken); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgMintFiatTokens(ctx cTypes.Context, k Keeper, msg MsgMintFiatTokens) cTypes.Result {
+	for _, mintFiatToken := range msg.MintFiatTokens {
+		if err := k.MintFiatToken(ctx, mintFiatToken); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgBurnFiatTokens(ctx cTypes.Context, k Keeper, msg MsgBurnFiatTokens) cTypes.Result {
+	for _, burnFiatToken := range msg.BurnFiatTokens {
+		if err := k.BurnFiatToken(ctx, burnFiatToken); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+// RegisterFiatToken : zone registers the coin denomination its fiat pegs can be converted into
+func (k Keeper) RegisterFiatToken(ctx cTypes.Context, registerFiatToken fiatTokenTypes.RegisterFiatToken) cTypes.Error {
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, registerFiatToken.ZoneID, registerFiatToken.FromAddress) {
+		return fiatTokenTypes.ErrUnauthorized(fiatTokenTypes.DefaultCodeSpace)
+	}
+	if _, err := k.GetFiatToken(ctx, registerFiatToken.Denom); err == nil {
+		return fiatTokenTypes.ErrFiatTokenExists(fiatTokenTypes.DefaultCodeSpace, "")
+	}
+	if !k.supplyKeeper.GetSupply(ctx).Total.AmountOf(registerFiatToken.Denom).IsZero() {
+		return fiatTokenTypes.ErrFiatTokenExists(fiatTokenTypes.DefaultCodeSpace, "denomination is already in supply")
+	}
+
+	fiatToken := fiatTokenTypes.NewFiatToken(registerFiatToken.Denom, registerFiatToken.ZoneID, registerFiatToken.Currency)
+	k.SetFiatToken(ctx, fiatToken)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(fiatTokenTypes.EventTypeRegisterFiatToken,
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyDenom, fiatToken.Denom),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyZoneID, fiatToken.ZoneID.String()),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyCurrency, fiatToken.Currency),
+		))
+
+	return nil
+}
+
+// MintFiatToken : move fiat pegs the zone issued from an account of the zone into the reserve and mint the same amount
+// of coins
+func (k Keeper) MintFiatToken(ctx cTypes.Context, mintFiatToken fiatTokenTypes.MintFiatToken) cTypes.Error {
+	fiatToken, err := k.GetFiatToken(ctx, mintFiatToken.Denom)
+	if err != nil {
+		return err
+	}
+	if err := k.checkZoneAccount(ctx, fiatToken, mintFiatToken.FromAddress); err != nil {
+		return err
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, mintFiatToken.FromAddress)
+	if account == nil {
+		return cTypes.ErrUnknownAddress(mintFiatToken.FromAddress.String())
+	}
+	zoneFiatPegWallet, otherFiatPegWallet := splitZoneFiats(fiatToken, account.GetFiatPegWallet())
+	sentFiatPegWallet, oldFiatPegWallet := types.SubtractAmountFromWallet(mintFiatToken.Amount, zoneFiatPegWallet)
+	if len(sentFiatPegWallet) == 0 {
+		return cTypes.ErrInsufficientCoins("Insufficient funds issued by the zone of the fiat token in its currency")
+	}
+	if err := k.aclKeeper.CheckFrozen(ctx, mintFiatToken.FromAddress, acl.PegTypeFiat,
+		types.GetFiatPegWalletPegHashes(sentFiatPegWallet)...); err != nil {
+		return err
+	}
+	_ = account.SetFiatPegWallet(types.AddFiatPegToWallet(oldFiatPegWallet, otherFiatPegWallet))
+	k.accountKeeper.SetAccount(ctx, account)
+
+	fiatToken.Reserve = types.AddFiatPegToWallet(fiatToken.Reserve, sentFiatPegWallet)
+	k.SetFiatToken(ctx, fiatToken)
+
+	coins := fiatToken.GetCoins(mintFiatToken.Amount)
+	if err := k.supplyKeeper.MintCoins(ctx, fiatTokenTypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, fiatTokenTypes.ModuleName, mintFiatToken.FromAddress, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(fiatTokenTypes.EventTypeMintFiatToken,
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyFromAddress, mintFiatToken.FromAddress.String()),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyDenom, fiatToken.Denom),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyAmount, strconv.FormatInt(mintFiatToken.Amount, 10)),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyReserve, strconv.FormatInt(fiatToken.GetReserveBalance(), 10)),
+		))
+
+	return nil
+}
+
+// BurnFiatToken : burn coins of an account of the zone and return the same amount of fiat pegs from the reserve
+func (k Keeper) BurnFiatToken(ctx cTypes.Context, burnFiatToken fiatTokenTypes.BurnFiatToken) cTypes.Error {
+	fiatToken, err := k.GetFiatToken(ctx, burnFiatToken.Denom)
+	if err != nil {
+		return err
+	}
+	if err := k.checkZoneAccount(ctx, fiatToken, burnFiatToken.FromAddress); err != nil {
+		return err
+	}
+
+	sentFiatPegWallet, reserve := types.SubtractAmountFromWallet(burnFiatToken.Amount, fiatToken.Reserve)
+	if len(sentFiatPegWallet) == 0 {
+		return fiatTokenTypes.ErrInsufficientReserve(fiatTokenTypes.DefaultCodeSpace, "")
+	}
+
+	coins := fiatToken.GetCoins(burnFiatToken.Amount)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, burnFiatToken.FromAddress, fiatTokenTypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, fiatTokenTypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	fiatToken.Reserve = reserve
+	k.SetFiatToken(ctx, fiatToken)
+
+	account := k.accountKeeper.GetAccount(ctx, burnFiatToken.FromAddress)
+	_ = account.SetFiatPegWallet(types.AddFiatPegToWallet(account.GetFiatPegWallet(), sentFiatPegWallet))
+	k.accountKeeper.SetAccount(ctx, account)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(fiatTokenTypes.EventTypeBurnFiatToken,
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyFromAddress, burnFiatToken.FromAddress.String()),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyDenom, fiatToken.Denom),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyAmount, strconv.FormatInt(burnFiatToken.Amount, 10)),
+			cTypes.NewAttribute(fiatTokenTypes.AttributeKeyReserve, strconv.FormatInt(fiatToken.GetReserveBalance(), 10)),
+		))
+
+	return nil
+}
+
+func (k Keeper) checkZoneAccount(ctx cTypes.Context, fiatToken fiatTokenTypes.FiatToken, address cTypes.AccAddress) cTypes.Error {
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !aclAccount.GetACL().SendFiat || aclAccount.GetZoneID().String() != fiatToken.ZoneID.String() {
+		return fiatTokenTypes.ErrUnauthorized(fiatTokenTypes.DefaultCodeSpace)
+	}
+	return nil
+}
+
+// splitZoneFiats : the fiat pegs of the wallet issued by the zone of the fiat token in its currency, and all others
+func splitZoneFiats(fiatToken fiatTokenTypes.FiatToken, fiatPegWallet types.FiatPegWallet) (
+	zoneFiatPegWallet types.FiatPegWallet, otherFiatPegWallet types.FiatPegWallet) {
+
+	for _, fiatPeg := range fiatPegWallet {
+		if fiatToken.Backs(fiatPeg) {
+			zoneFiatPegWallet = append(zoneFiatPegWallet, fiatPeg)
+		} else {
+			otherFiatPegWallet = append(otherFiatPegWallet, fiatPeg)
+		}
+	}
+	return
+}
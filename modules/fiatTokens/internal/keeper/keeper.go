@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+)
+
+type Keeper struct {
+	storeKey      cTypes.StoreKey
+	cdc           *codec.Codec
+	accountKeeper fiatTokenTypes.AccountKeeper
+	aclKeeper     fiatTokenTypes.ACLKeeper
+	supplyKeeper  fiatTokenTypes.SupplyKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, accountKeeper fiatTokenTypes.AccountKeeper,
+	aclKeeper fiatTokenTypes.ACLKeeper, supplyKeeper fiatTokenTypes.SupplyKeeper) Keeper {
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		aclKeeper:     aclKeeper,
+		supplyKeeper:  supplyKeeper,
+	}
+}
+
+// fiatTokens/{0x01}/{denom} => fiatToken
+func (k Keeper) SetFiatToken(ctx cTypes.Context, fiatToken fiatTokenTypes.FiatToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(fiatToken)
+	store.Set(fiatTokenTypes.GetFiatTokenKey(fiatToken.Denom), bz)
+}
+
+// returns fiat token by denom
+func (k Keeper) GetFiatToken(ctx cTypes.Context, denom string) (fiatToken fiatTokenTypes.FiatToken, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(fiatTokenTypes.GetFiatTokenKey(denom))
+	if bz == nil {
+		return fiatToken, fiatTokenTypes.ErrInvalidFiatToken(fiatTokenTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &fiatToken)
+	return fiatToken, nil
+}
+
+// get all fiat tokens => []FiatToken from store
+func (k Keeper) GetFiatTokens(ctx cTypes.Context) (fiatTokens []fiatTokenTypes.FiatToken) {
+	k.IterateFiatTokens(ctx, func(fiatToken fiatTokenTypes.FiatToken) (stop bool) {
+		fiatTokens = append(fiatTokens, fiatToken)
+		return false
+	},
+	)
+	return
+}
+
+func (k Keeper) IterateFiatTokens(ctx cTypes.Context, handler func(fiatToken fiatTokenTypes.FiatToken) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, fiatTokenTypes.FiatTokenKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fiatToken fiatTokenTypes.FiatToken
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fiatToken)
+		if handler(fiatToken) {
+			break
+		}
+	}
+}
+
+// GetEscrowedFiatPegWallet : fiat pegs held in the reserves of all fiat tokens
+func (k Keeper) GetEscrowedFiatPegWallet(ctx cTypes.Context) (fiatPegWallet types.FiatPegWallet) {
+	for _, fiatToken := range k.GetFiatTokens(ctx) {
+		fiatPegWallet = append(fiatPegWallet, fiatToken.Reserve...)
+	}
+	return
+}
+
+// GetEscrowedAssetPegWallet : fiat tokens hold no asset pegs
+func (k Keeper) GetEscrowedAssetPegWallet(ctx cTypes.Context) types.AssetPegWallet {
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	fiatTokenTypes "github.com/commitHub/commitBlockchain/modules/fiatTokens/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryFiatToken  = "queryFiatToken"
+	QueryFiatTokens = "queryFiatTokens"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryFiatToken:
+			return queryFiatToken(ctx, path[1:], k)
+		case QueryFiatTokens:
+			return queryFiatTokens(ctx, k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown fiatTokens query endpoint")
+		}
+	}
+}
+
+func queryFiatToken(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	fiatToken, err := k.GetFiatToken(ctx, path[0])
+	if err != nil {
+		return nil, fiatTokenTypes.ErrInvalidFiatToken(fiatTokenTypes.DefaultCodeSpace, fmt.Sprintf("fiat token %s "+
+			" not found", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, fiatToken)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryFiatTokens(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	fiatTokens := k.GetFiatTokens(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, fiatTokens)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
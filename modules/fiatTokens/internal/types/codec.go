@@ -0,0 +1,17 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterFiatTokens{}, "commit-blockchain/MsgRegisterFiatTokens", nil)
+	cdc.RegisterConcrete(MsgMintFiatTokens{}, "commit-blockchain/MsgMintFiatTokens", nil)
+	cdc.RegisterConcrete(MsgBurnFiatTokens{}, "commit-blockchain/MsgBurnFiatTokens", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
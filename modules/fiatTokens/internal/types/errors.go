@@ -0,0 +1,45 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidFiatToken     cTypes.CodeType = 1001
+	CodeFiatTokenExists      cTypes.CodeType = 1002
+	CodeInsufficientReserve  cTypes.CodeType = 1003
+	CodeUnauthorized         cTypes.CodeType = 1004
+	CodeInvalidInputsOutputs cTypes.CodeType = 1005
+)
+
+func ErrInvalidFiatToken(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidFiatToken, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidFiatToken, "fiat token doesn't exist")
+}
+
+func ErrFiatTokenExists(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeFiatTokenExists, msg)
+	}
+	return cTypes.NewError(codespace, CodeFiatTokenExists, "fiat token already exists for denomination")
+}
+
+func ErrInsufficientReserve(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInsufficientReserve, msg)
+	}
+	return cTypes.NewError(codespace, CodeInsufficientReserve, "reserve of fiat token is insufficient")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
@@ -0,0 +1,14 @@
+package types
+
+var (
+	EventTypeRegisterFiatToken = "registerFiatToken"
+	EventTypeMintFiatToken     = "mintFiatToken"
+	EventTypeBurnFiatToken     = "burnFiatToken"
+
+	AttributeKeyDenom       = "denom"
+	AttributeKeyZoneID      = "zoneID"
+	AttributeKeyCurrency    = "currency"
+	AttributeKeyFromAddress = "fromAddress"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyReserve     = "reserve"
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+var reDenom = regexp.MustCompile(`^[a-z][a-z0-9]{2,15}$`)
+
+// FiatToken : fungible coin denomination of a zone, backed by the fiat pegs of Currency held in Reserve
+type FiatToken struct {
+	Denom    string              `json:"denom"`
+	ZoneID   acl.ZoneID          `json:"zoneID"`
+	Currency string              `json:"currency"`
+	Reserve  types.FiatPegWallet `json:"reserve"`
+}
+
+func NewFiatToken(denom string, zoneID acl.ZoneID, currency string) FiatToken {
+	return FiatToken{
+		Denom:    denom,
+		ZoneID:   zoneID,
+		Currency: currency,
+	}
+}
+
+func (fiatToken FiatToken) String() string {
+	return fmt.Sprintf(`FiatToken:
+Denom: %s,
+ZoneID: %s,
+Currency: %s,
+Reserve: %d,
+`, fiatToken.Denom, fiatToken.ZoneID.String(), fiatToken.Currency, fiatToken.GetReserveBalance())
+}
+
+func (fiatToken FiatToken) ValidateBasic() cTypes.Error {
+	if !reDenom.MatchString(fiatToken.Denom) {
+		return ErrInvalidFiatToken(DefaultCodeSpace, fmt.Sprintf("Invalid denomination %s.", fiatToken.Denom))
+	} else if len(fiatToken.ZoneID) == 0 {
+		return ErrInvalidFiatToken(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if !(cTypes.Coin{Denom: fiatToken.Currency, Amount: cTypes.ZeroInt()}).IsValid() {
+		return ErrInvalidFiatToken(DefaultCodeSpace, fmt.Sprintf("Invalid currency %s.", fiatToken.Currency))
+	}
+	return nil
+}
+
+// Backs : true if the fiat peg was issued by the zone of the fiat token in its currency
+func (fiatToken FiatToken) Backs(fiatPeg types.BaseFiatPeg) bool {
+	return fiatPeg.GetZoneID().String() == fiatToken.ZoneID.String() && fiatPeg.GetCurrency() == fiatToken.Currency
+}
+
+// GetReserveBalance : fiat amount backing the coins of the denomination
+func (fiatToken FiatToken) GetReserveBalance() int64 {
+	return types.GetFiatPegWalletBalance(fiatToken.Reserve)
+}
+
+// GetCoins : coins of the denomination worth amount of fiat
+func (fiatToken FiatToken) GetCoins(amount int64) cTypes.Coins {
+	return cTypes.NewCoins(cTypes.NewInt64Coin(fiatToken.Denom, amount))
+}
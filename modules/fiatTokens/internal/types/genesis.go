@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	FiatTokens []FiatToken `json:"fiatTokens"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	denoms := make(map[string]bool)
+	for _, fiatToken := range data.FiatTokens {
+		if err := fiatToken.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fiat token in genesis: %s", err.Error())
+		}
+		for _, fiatPeg := range fiatToken.Reserve {
+			if !fiatToken.Backs(fiatPeg) {
+				return fmt.Errorf("fiat token %s in genesis reserves fiat peg %s of another zone or currency",
+					fiatToken.Denom, fiatPeg.PegHash.String())
+			}
+		}
+		if denoms[fiatToken.Denom] {
+			return fmt.Errorf("duplicate fiat token %s in genesis", fiatToken.Denom)
+		}
+		denoms[fiatToken.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	ModuleName   = "fiatTokens"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	FiatTokenKey = []byte{0x01}
+)
+
+// fiatTokens/{0x01}/{denom}
+func GetFiatTokenKey(denom string) []byte {
+	return append(FiatTokenKey, []byte(denom)...)
+}
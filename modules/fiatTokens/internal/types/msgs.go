@@ -0,0 +1,332 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// *****RegisterFiatToken
+
+// RegisterFiatToken : zone registers a coin denomination backed by fiat pegs of its accounts
+type RegisterFiatToken struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	ZoneID      acl.ZoneID        `json:"zoneID"`
+	Currency    string            `json:"currency"`
+}
+
+// NewRegisterFiatToken : initializer
+func NewRegisterFiatToken(fromAddress cTypes.AccAddress, denom string, zoneID acl.ZoneID, currency string) RegisterFiatToken {
+	return RegisterFiatToken{fromAddress, denom, zoneID, currency}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RegisterFiatToken) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		ZoneID      string `json:"zoneID"`
+		Currency    string `json:"currency"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		ZoneID:      in.ZoneID.String(),
+		Currency:    in.Currency,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RegisterFiatToken) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	}
+	return NewFiatToken(in.Denom, in.ZoneID, in.Currency).ValidateBasic()
+}
+
+// MsgRegisterFiatTokens : high level fiat token registration of fiatTokens module
+type MsgRegisterFiatTokens struct {
+	RegisterFiatTokens []RegisterFiatToken `json:"registerFiatTokens"`
+}
+
+// NewMsgRegisterFiatTokens : initializer
+func NewMsgRegisterFiatTokens(registerFiatTokens []RegisterFiatToken) MsgRegisterFiatTokens {
+	return MsgRegisterFiatTokens{registerFiatTokens}
+}
+
+var _ cTypes.Msg = MsgRegisterFiatTokens{}
+
+// Type : implements msg
+func (msg MsgRegisterFiatTokens) Type() string { return "registerFiatTokens" }
+
+func (msg MsgRegisterFiatTokens) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRegisterFiatTokens) ValidateBasic() cTypes.Error {
+	if len(msg.RegisterFiatTokens) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.RegisterFiatTokens {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRegisterFiatTokens) GetSignBytes() []byte {
+	var registerFiatTokens []json.RawMessage
+	for _, registerFiatToken := range msg.RegisterFiatTokens {
+		registerFiatTokens = append(registerFiatTokens, registerFiatToken.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RegisterFiatTokens []json.RawMessage `json:"registerFiatTokens"`
+	}{
+		RegisterFiatTokens: registerFiatTokens,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRegisterFiatTokens) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.RegisterFiatTokens))
+	for i, in := range msg.RegisterFiatTokens {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgRegisterFiatToken : build the MsgRegisterFiatTokens
+func BuildMsgRegisterFiatToken(fromAddress cTypes.AccAddress, denom string, zoneID acl.ZoneID, currency string) cTypes.Msg {
+	registerFiatToken := NewRegisterFiatToken(fromAddress, denom, zoneID, currency)
+	msg := NewMsgRegisterFiatTokens([]RegisterFiatToken{registerFiatToken})
+	return msg
+}
+
+// #####RegisterFiatToken
+
+// *****MintFiatToken
+
+// MintFiatToken : move Amount of fiat pegs into the reserve of Denom, minting as many coins
+type MintFiatToken struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	Amount      int64             `json:"amount"`
+}
+
+// NewMintFiatToken : initializer
+func NewMintFiatToken(fromAddress cTypes.AccAddress, denom string, amount int64) MintFiatToken {
+	return MintFiatToken{fromAddress, denom, amount}
+}
+
+// GetSignBytes : get bytes to sign
+func (in MintFiatToken) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		Amount      int64  `json:"amount"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		Amount:      in.Amount,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in MintFiatToken) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if !reDenom.MatchString(in.Denom) {
+		return ErrInvalidFiatToken(DefaultCodeSpace, "Invalid denomination.")
+	} else if in.Amount <= 0 {
+		return cTypes.ErrInvalidCoins("Amount should be positive.")
+	}
+	return nil
+}
+
+// MsgMintFiatTokens : high level fiat token minting of fiatTokens module
+type MsgMintFiatTokens struct {
+	MintFiatTokens []MintFiatToken `json:"mintFiatTokens"`
+}
+
+// NewMsgMintFiatTokens : initializer
+func NewMsgMintFiatTokens(mintFiatTokens []MintFiatToken) MsgMintFiatTokens {
+	return MsgMintFiatTokens{mintFiatTokens}
+}
+
+var _ cTypes.Msg = MsgMintFiatTokens{}
+
+// Type : implements msg
+func (msg MsgMintFiatTokens) Type() string { return "mintFiatTokens" }
+
+func (msg MsgMintFiatTokens) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgMintFiatTokens) ValidateBasic() cTypes.Error {
+	if len(msg.MintFiatTokens) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.MintFiatTokens {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgMintFiatTokens) GetSignBytes() []byte {
+	var mintFiatTokens []json.RawMessage
+	for _, mintFiatToken := range msg.MintFiatTokens {
+		mintFiatTokens = append(mintFiatTokens, mintFiatToken.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		MintFiatTokens []json.RawMessage `json:"mintFiatTokens"`
+	}{
+		MintFiatTokens: mintFiatTokens,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgMintFiatTokens) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.MintFiatTokens))
+	for i, in := range msg.MintFiatTokens {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgMintFiatToken : build the MsgMintFiatTokens
+func BuildMsgMintFiatToken(fromAddress cTypes.AccAddress, denom string, amount int64) cTypes.Msg {
+	mintFiatToken := NewMintFiatToken(fromAddress, denom, amount)
+	msg := NewMsgMintFiatTokens([]MintFiatToken{mintFiatToken})
+	return msg
+}
+
+// #####MintFiatToken
+
+// *****BurnFiatToken
+
+// BurnFiatToken : burn Amount coins of Denom, returning as much fiat from the reserve
+type BurnFiatToken struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	Amount      int64             `json:"amount"`
+}
+
+// NewBurnFiatToken : initializer
+func NewBurnFiatToken(fromAddress cTypes.AccAddress, denom string, amount int64) BurnFiatToken {
+	return BurnFiatToken{fromAddress, denom, amount}
+}
+
+// GetSignBytes : get bytes to sign
+func (in BurnFiatToken) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		Amount      int64  `json:"amount"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		Amount:      in.Amount,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in BurnFiatToken) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if !reDenom.MatchString(in.Denom) {
+		return ErrInvalidFiatToken(DefaultCodeSpace, "Invalid denomination.")
+	} else if in.Amount <= 0 {
+		return cTypes.ErrInvalidCoins("Amount should be positive.")
+	}
+	return nil
+}
+
+// MsgBurnFiatTokens : high level fiat token burning of fiatTokens module
+type MsgBurnFiatTokens struct {
+	BurnFiatTokens []BurnFiatToken `json:"burnFiatTokens"`
+}
+
+// NewMsgBurnFiatTokens : initializer
+func NewMsgBurnFiatTokens(burnFiatTokens []BurnFiatToken) MsgBurnFiatTokens {
+	return MsgBurnFiatTokens{burnFiatTokens}
+}
+
+var _ cTypes.Msg = MsgBurnFiatTokens{}
+
+// Type : implements msg
+func (msg MsgBurnFiatTokens) Type() string { return "burnFiatTokens" }
+
+func (msg MsgBurnFiatTokens) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgBurnFiatTokens) ValidateBasic() cTypes.Error {
+	if len(msg.BurnFiatTokens) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.BurnFiatTokens {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgBurnFiatTokens) GetSignBytes() []byte {
+	var burnFiatTokens []json.RawMessage
+	for _, burnFiatToken := range msg.BurnFiatTokens {
+		burnFiatTokens = append(burnFiatTokens, burnFiatToken.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		BurnFiatTokens []json.RawMessage `json:"burnFiatTokens"`
+	}{
+		BurnFiatTokens: burnFiatTokens,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgBurnFiatTokens) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.BurnFiatTokens))
+	for i, in := range msg.BurnFiatTokens {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgBurnFiatToken : build the MsgBurnFiatTokens
+func BuildMsgBurnFiatToken(fromAddress cTypes.AccAddress, denom string, amount int64) cTypes.Msg {
+	burnFiatToken := NewBurnFiatToken(fromAddress, denom, amount)
+	msg := NewMsgBurnFiatTokens([]BurnFiatToken{burnFiatToken})
+	return msg
+}
+
+// #####BurnFiatToken
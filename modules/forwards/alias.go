@@ -0,0 +1,81 @@
+package forwards
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/forwards/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultParamspace = types.DefaultParamspace
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	StatusPending   = types.StatusPending
+	StatusActive    = types.StatusActive
+	StatusSettled   = types.StatusSettled
+	StatusDefaulted = types.StatusDefaulted
+	StatusCancelled = types.StatusCancelled
+
+	QueryForward  = keeper.QueryForward
+	QueryForwards = keeper.QueryForwards
+	QueryParams   = keeper.QueryParams
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	ValidateParams      = types.ValidateParams
+	ParamKeyTable       = types.ParamKeyTable
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewForward           = types.NewForward
+	NewMarginRequirement = types.NewMarginRequirement
+	GetForwardKey        = types.GetForwardKey
+
+	ErrInvalidForward  = types.ErrInvalidForward
+	ErrForwardExists   = types.ErrForwardExists
+	ErrInvalidMaturity = types.ErrInvalidMaturity
+	ErrInvalidMargin   = types.ErrInvalidMargin
+	ErrUnauthorized    = types.ErrUnauthorized
+
+	BuildMsgOpenForward   = types.BuildMsgOpenForward
+	BuildMsgDepositMargin = types.BuildMsgDepositMargin
+	BuildMsgMarginCall    = types.BuildMsgMarginCall
+
+	EventTypeOpenForward    = types.EventTypeOpenForward
+	EventTypeDepositMargin  = types.EventTypeDepositMargin
+	EventTypeMarginCall     = types.EventTypeMarginCall
+	EventTypeSettleForward  = types.EventTypeSettleForward
+	EventTypeForwardDefault = types.EventTypeForwardDefault
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+	Params       = types.Params
+
+	Forward           = types.Forward
+	ForwardStatus     = types.ForwardStatus
+	Margin            = types.Margin
+	MarginRequirement = types.MarginRequirement
+
+	MsgOpenForwards   = types.MsgOpenForwards
+	MsgDepositMargins = types.MsgDepositMargins
+	MsgMarginCalls    = types.MsgMarginCalls
+
+	OpenForward   = types.OpenForward
+	DepositMargin = types.DepositMargin
+	MarginCall    = types.MarginCall
+)
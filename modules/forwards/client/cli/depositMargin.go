@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+func DepositMarginCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-margin",
+		Short: "Post or top up margin on a forward",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			negotiationID, err := negotiation.GetNegotiationIDFromString(viper.GetString(FlagNegotiationID))
+			if err != nil {
+				return err
+			}
+
+			coins, err := cTypes.ParseCoins(viper.GetString(FlagCoins))
+			if err != nil {
+				return err
+			}
+
+			msg := forwardTypes.BuildMsgDepositMargin(cliCtx.GetFromAddress(), negotiationID, viper.GetInt64(FlagFiatAmount), coins)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsNegotiationID)
+	cmd.Flags().AddFlagSet(fsFiatAmount)
+	cmd.Flags().AddFlagSet(fsCoins)
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagNegotiationID    = "negotiation-id"
+	FlagMaturityHeight   = "maturity-height"
+	FlagMarginCallPeriod = "margin-call-period"
+	FlagBuyerFiatMargin  = "buyer-fiat-margin"
+	FlagBuyerCoinMargin  = "buyer-coin-margin"
+	FlagSellerFiatMargin = "seller-fiat-margin"
+	FlagSellerCoinMargin = "seller-coin-margin"
+	FlagFiatAmount       = "fiat-amount"
+	FlagCoins            = "coins"
+)
+
+var (
+	fsNegotiationID    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMaturityHeight   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMarginCallPeriod = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRequiredMargin   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsFiatAmount       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCoins            = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsNegotiationID.String(FlagNegotiationID, "", "NegotiationID of the forward")
+	fsMaturityHeight.String(FlagMaturityHeight, "", "Block height at which delivery is due")
+	fsMarginCallPeriod.String(FlagMarginCallPeriod, "", "Blocks a party has to answer a margin call")
+	fsRequiredMargin.String(FlagBuyerFiatMargin, "0", "Fiat margin the buyer has to post")
+	fsRequiredMargin.String(FlagBuyerCoinMargin, "", "Coin margin the buyer has to post")
+	fsRequiredMargin.String(FlagSellerFiatMargin, "0", "Fiat margin the seller has to post")
+	fsRequiredMargin.String(FlagSellerCoinMargin, "", "Coin margin the seller has to post")
+	fsFiatAmount.String(FlagFiatAmount, "0", "Amount of fiat")
+	fsCoins.String(FlagCoins, "", "Coins, e.g. 10commit")
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+func OpenForwardCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Open a forward on a confirmed negotiation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			negotiationID, err := negotiation.GetNegotiationIDFromString(viper.GetString(FlagNegotiationID))
+			if err != nil {
+				return err
+			}
+
+			buyerCoins, err := cTypes.ParseCoins(viper.GetString(FlagBuyerCoinMargin))
+			if err != nil {
+				return err
+			}
+			sellerCoins, err := cTypes.ParseCoins(viper.GetString(FlagSellerCoinMargin))
+			if err != nil {
+				return err
+			}
+
+			msg := forwardTypes.BuildMsgOpenForward(cliCtx.GetFromAddress(), negotiationID,
+				viper.GetInt64(FlagMaturityHeight), viper.GetInt64(FlagMarginCallPeriod),
+				forwardTypes.NewMarginRequirement(viper.GetInt64(FlagBuyerFiatMargin), buyerCoins),
+				forwardTypes.NewMarginRequirement(viper.GetInt64(FlagSellerFiatMargin), sellerCoins))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsNegotiationID)
+	cmd.Flags().AddFlagSet(fsMaturityHeight)
+	cmd.Flags().AddFlagSet(fsMarginCallPeriod)
+	cmd.Flags().AddFlagSet(fsRequiredMargin)
+	return cmd
+}
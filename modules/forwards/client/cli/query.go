@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+func GetForwardCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward [negotiation-id]",
+		Short: "Query forward details",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			negotiationID, err := negotiation.GetNegotiationIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", forwardTypes.QuerierRoute, "queryForward", negotiationID), nil)
+			if err != nil {
+				return err
+			}
+
+			var forward forwardTypes.Forward
+			cdc.MustUnmarshalJSON(res, &forward)
+			return cliCtx.PrintOutput(forward)
+		},
+	}
+
+	return cmd
+}
+
+func GetForwardsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forwards",
+		Short: "Query all forwards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", forwardTypes.QuerierRoute, "queryForwards"), nil)
+			if err != nil {
+				return err
+			}
+
+			var forwards []forwardTypes.Forward
+			cdc.MustUnmarshalJSON(res, &forwards)
+
+			output, err := cdc.MarshalJSONIndent(forwards, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the forwards parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", forwardTypes.QuerierRoute, "queryParams"), nil)
+			if err != nil {
+				return err
+			}
+
+			var params forwardTypes.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+type openForwardReq struct {
+	BaseReq          rest.BaseReq `json:"base_req"`
+	NegotiationID    string       `json:"negotiationID" valid:"required~Enter the NegotiationID,hexadecimal~Invalid NegotiationID"`
+	MaturityHeight   int64        `json:"maturityHeight" valid:"required~Enter the Valid MaturityHeight"`
+	MarginCallPeriod int64        `json:"marginCallPeriod" valid:"required~Enter the Valid MarginCallPeriod"`
+	BuyerFiatMargin  int64        `json:"buyerFiatMargin"`
+	BuyerCoinMargin  cTypes.Coins `json:"buyerCoinMargin"`
+	SellerFiatMargin int64        `json:"sellerFiatMargin"`
+	SellerCoinMargin cTypes.Coins `json:"sellerCoinMargin"`
+	Password         string       `json:"password" valid:"required~Enter the Password"`
+	Mode             string       `json:"mode"`
+}
+
+func OpenForwardRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req openForwardReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(forwardTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		negotiationID, err := negotiation.GetNegotiationIDFromString(req.NegotiationID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryACLAccount", fromAddr), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query account. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("Unauthorized transaction. ACL is not defined for account"))
+			return
+		}
+
+		var account acl.ACLAccount
+		cliCtx.Codec.MustUnmarshalJSON(res, &account)
+
+		if !account.GetACL().Negotiation {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Unauthorized transaction"))
+			return
+		}
+
+		msg := forwardTypes.BuildMsgOpenForward(fromAddr, negotiationID, req.MaturityHeight, req.MarginCallPeriod,
+			forwardTypes.NewMarginRequirement(req.BuyerFiatMargin, req.BuyerCoinMargin),
+			forwardTypes.NewMarginRequirement(req.SellerFiatMargin, req.SellerCoinMargin))
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("OPFW")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+func QueryForwardRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		negotiationID, err := negotiation.GetNegotiationIDFromString(vars["negotiation-id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("cannot decode NegotiationID. Error: %s", err.Error()))
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", forwardTypes.QuerierRoute, "queryForward", negotiationID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Forward. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var forward forwardTypes.Forward
+		cliCtx.Codec.MustUnmarshalJSON(res, &forward)
+
+		rest.PostProcessResponse(w, cliCtx, forward)
+	}
+}
+
+func QueryForwardsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", forwardTypes.QuerierRoute, "queryForwards"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Forwards. Error: %s", err.Error()))
+			return
+		}
+
+		var forwards []forwardTypes.Forward
+		cliCtx.Codec.MustUnmarshalJSON(res, &forwards)
+
+		rest.PostProcessResponse(w, cliCtx, forwards)
+	}
+}
@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/forward/{negotiation-id}", QueryForwardRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/forwards", QueryForwardsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/openForward", OpenForwardRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/depositMargin", DepositMarginRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/marginCall", MarginCallRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
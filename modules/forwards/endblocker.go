@@ -0,0 +1,11 @@
+package forwards
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker : default forwards with expired margin calls, then settle matured forwards
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.ExpireMarginCalls(ctx)
+	keeper.SettleForwards(ctx)
+}
@@ -0,0 +1,29 @@
+package forwards
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	for _, forward := range data.Forwards {
+		keeper.SetForward(ctx, forward)
+		if forward.Status != StatusPending && forward.Status != StatusActive {
+			continue
+		}
+		keeper.InsertSettlementQueue(ctx, forward.SettlementHeight, forward.NegotiationID)
+		if forward.BuyerMarginCall != 0 {
+			keeper.InsertMarginCallQueue(ctx, forward.BuyerMarginCall, forward.BuyerAddress, forward.NegotiationID)
+		}
+		if forward.SellerMarginCall != 0 {
+			keeper.InsertMarginCallQueue(ctx, forward.SellerMarginCall, forward.SellerAddress, forward.NegotiationID)
+		}
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	params := keeper.GetParams(ctx)
+	forwards := keeper.GetForwards(ctx)
+
+	return GenesisState{Params: params, Forwards: forwards}
+}
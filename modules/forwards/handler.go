@@ -0,0 +1,62 @@
+package forwards
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgOpenForwards:
+			return handleMsgOpenForwards(ctx, k, msg)
+		case MsgDepositMargins:
+			return handleMsgDepositMargins(ctx, k, msg)
+		case MsgMarginCalls:
+			return handleMsgMarginCalls(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgOpenForwards(ctx cTypes.Context, k Keeper, msg MsgOpenForwards) cTypes.Result {
+	for _, openForward := range msg.OpenForwards {
+		if err := k.OpenForward(ctx, openForward); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgDepositMargins(ctx cTypes.Context, k Keeper, msg MsgDepositMargins) cTypes.Result {
+	for _, depositMargin := range msg.DepositMargins {
+		if err := k.DepositMargin(ctx, depositMargin); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgMarginCalls(ctx cTypes.Context, k Keeper, msg MsgMarginCalls) cTypes.Result {
+	for _, marginCall := range msg.MarginCalls {
+		if err := k.MarginCall(ctx, marginCall); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
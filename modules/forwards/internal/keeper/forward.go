@@ -0,0 +1,342 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/types"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+)
+
+// OpenForward : create a pending forward on a negotiation signed by both parties
+func (k Keeper) OpenForward(ctx cTypes.Context, openForward forwardTypes.OpenForward) cTypes.Error {
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, openForward.FromAddress)
+	if err != nil {
+		return err
+	}
+	if !aclAccount.GetACL().Negotiation {
+		return forwardTypes.ErrUnauthorized(forwardTypes.DefaultCodeSpace)
+	}
+
+	_negotiation, err := k.negotiationKeeper.GetNegotiation(ctx, openForward.NegotiationID)
+	if err != nil {
+		return err
+	}
+	if _negotiation.GetBuyerSignature() == nil || _negotiation.GetSellerSignature() == nil {
+		return cTypes.ErrInternal("Signatures are not present")
+	}
+	if !_negotiation.GetBuyerAddress().Equals(openForward.FromAddress) && !_negotiation.GetSellerAddress().Equals(openForward.FromAddress) {
+		return forwardTypes.ErrUnauthorized(forwardTypes.DefaultCodeSpace)
+	}
+	if _, err := k.GetForward(ctx, openForward.NegotiationID); err == nil {
+		return forwardTypes.ErrForwardExists(forwardTypes.DefaultCodeSpace, "")
+	}
+	if err, _, _, _, _ := k.orderKeeper.GetOrderDetails(ctx, _negotiation.GetBuyerAddress(), _negotiation.GetSellerAddress(),
+		_negotiation.GetPegHash()); err == nil {
+		return forwardTypes.ErrForwardExists(forwardTypes.DefaultCodeSpace, "Order already in progress for negotiation.")
+	}
+	if openForward.MaturityHeight <= ctx.BlockHeight() {
+		return forwardTypes.ErrInvalidMaturity(forwardTypes.DefaultCodeSpace, "")
+	}
+
+	forward := forwardTypes.NewForward(_negotiation, openForward.MaturityHeight, openForward.MarginCallPeriod,
+		openForward.BuyerRequiredMargin, openForward.SellerRequiredMargin)
+	k.SetForward(ctx, forward)
+	k.InsertSettlementQueue(ctx, forward.SettlementHeight, forward.NegotiationID)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(forwardTypes.EventTypeOpenForward,
+			cTypes.NewAttribute(forwardTypes.AttributeKeyNegotiationID, forward.NegotiationID.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyBuyerAddress, forward.BuyerAddress.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeySellerAddress, forward.SellerAddress.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyMaturityHeight, strconv.FormatInt(forward.MaturityHeight, 10)),
+		))
+
+	return nil
+}
+
+// DepositMargin : move fiat pegs and coins of a party into the forward, activating it once both sides are margined
+func (k Keeper) DepositMargin(ctx cTypes.Context, depositMargin forwardTypes.DepositMargin) cTypes.Error {
+	forward, err := k.GetForward(ctx, depositMargin.NegotiationID)
+	if err != nil {
+		return err
+	}
+	if forward.Status != forwardTypes.StatusPending && forward.Status != forwardTypes.StatusActive {
+		return forwardTypes.ErrInvalidForward(forwardTypes.DefaultCodeSpace, fmt.Sprintf("forward is %s", forward.Status))
+	}
+	if !forward.IsParty(depositMargin.FromAddress) {
+		return forwardTypes.ErrUnauthorized(forwardTypes.DefaultCodeSpace)
+	}
+	if ctx.BlockHeight() >= forward.MaturityHeight {
+		return forwardTypes.ErrInvalidMaturity(forwardTypes.DefaultCodeSpace, "Forward already matured.")
+	}
+
+	var sentFiatPegWallet types.FiatPegWallet
+	if depositMargin.FiatAmount > 0 {
+		aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, depositMargin.FromAddress)
+		if err != nil {
+			return err
+		}
+		if !aclAccount.GetACL().SendFiat {
+			return forwardTypes.ErrUnauthorized(forwardTypes.DefaultCodeSpace)
+		}
+
+		account := k.accountKeeper.GetAccount(ctx, depositMargin.FromAddress)
+		sent, remaining := types.SubtractAmountFromWallet(depositMargin.FiatAmount, account.GetFiatPegWallet())
+		if len(sent) == 0 && len(remaining) == 0 {
+			return cTypes.ErrInsufficientCoins("Insufficient funds")
+		}
+		if err := k.aclKeeper.CheckFrozen(ctx, depositMargin.FromAddress, acl.PegTypeFiat, types.GetFiatPegWalletPegHashes(sent)...); err != nil {
+			return err
+		}
+		sentFiatPegWallet = sent
+		_ = account.SetFiatPegWallet(remaining)
+		k.accountKeeper.SetAccount(ctx, account)
+	}
+	if !depositMargin.Coins.Empty() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositMargin.FromAddress, forwardTypes.ModuleName, depositMargin.Coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	if forward.BuyerAddress.Equals(depositMargin.FromAddress) {
+		forward.BuyerMargin = addToMargin(forward.BuyerMargin, sentFiatPegWallet, depositMargin.Coins)
+		if forward.BuyerMarginCall != 0 && forward.BuyerMargin.Covers(forward.BuyerRequiredMargin) {
+			k.RemoveFromMarginCallQueue(ctx, forward.BuyerMarginCall, forward.BuyerAddress, forward.NegotiationID)
+			forward.BuyerMarginCall = 0
+		}
+	} else {
+		forward.SellerMargin = addToMargin(forward.SellerMargin, sentFiatPegWallet, depositMargin.Coins)
+		if forward.SellerMarginCall != 0 && forward.SellerMargin.Covers(forward.SellerRequiredMargin) {
+			k.RemoveFromMarginCallQueue(ctx, forward.SellerMarginCall, forward.SellerAddress, forward.NegotiationID)
+			forward.SellerMarginCall = 0
+		}
+	}
+
+	if forward.Status == forwardTypes.StatusPending && forward.IsMargined() {
+		err := k.activateForward(ctx, &forward)
+		if err != nil {
+			return err
+		}
+	}
+	k.SetForward(ctx, forward)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(forwardTypes.EventTypeDepositMargin,
+			cTypes.NewAttribute(forwardTypes.AttributeKeyNegotiationID, forward.NegotiationID.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyFromAddress, depositMargin.FromAddress.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyStatus, string(forward.Status)),
+		))
+
+	return nil
+}
+
+// activateForward : move the delivery window of the negotiation to the maturity height
+func (k Keeper) activateForward(ctx cTypes.Context, forward *forwardTypes.Forward) cTypes.Error {
+	_negotiation, err := k.negotiationKeeper.GetNegotiation(ctx, forward.NegotiationID)
+	if err != nil {
+		return err
+	}
+	_ = _negotiation.SetBuyerBlockHeight(forward.MaturityHeight)
+	_ = _negotiation.SetSellerBlockHeight(forward.MaturityHeight)
+	k.negotiationKeeper.SetNegotiation(ctx, _negotiation)
+
+	forward.Status = forwardTypes.StatusActive
+	return nil
+}
+
+// MarginCall : raise the margin requirement of the counterparty, which has MarginCallPeriod blocks to top up
+func (k Keeper) MarginCall(ctx cTypes.Context, marginCall forwardTypes.MarginCall) cTypes.Error {
+	forward, err := k.GetForward(ctx, marginCall.NegotiationID)
+	if err != nil {
+		return err
+	}
+	if forward.Status != forwardTypes.StatusActive {
+		return forwardTypes.ErrInvalidForward(forwardTypes.DefaultCodeSpace, fmt.Sprintf("forward is %s", forward.Status))
+	}
+	if !forward.IsParty(marginCall.FromAddress) {
+		return forwardTypes.ErrUnauthorized(forwardTypes.DefaultCodeSpace)
+	}
+	if ctx.BlockHeight() >= forward.MaturityHeight {
+		return forwardTypes.ErrInvalidMaturity(forwardTypes.DefaultCodeSpace, "Forward already matured.")
+	}
+
+	requirement := forwardTypes.NewMarginRequirement(marginCall.FiatAmount, marginCall.Coins)
+	calledAddress := forward.BuyerAddress
+	margin, openingRequirement, oldRequirement, deadline := forward.BuyerMargin, forward.BuyerOpeningMargin,
+		forward.BuyerRequiredMargin, forward.BuyerMarginCall
+	if forward.BuyerAddress.Equals(marginCall.FromAddress) {
+		calledAddress = forward.SellerAddress
+		margin, openingRequirement, oldRequirement, deadline = forward.SellerMargin, forward.SellerOpeningMargin,
+			forward.SellerRequiredMargin, forward.SellerMarginCall
+	}
+	if requirement.FiatAmount < oldRequirement.FiatAmount || !requirement.Coins.IsAllGTE(oldRequirement.Coins) {
+		return forwardTypes.ErrInvalidMargin(forwardTypes.DefaultCodeSpace, "Margin call cannot lower the requirement.")
+	}
+
+	maxRequirement := openingRequirement.MaxMarginCall(forward.Bid, k.GetParams(ctx).MaxMarginCallRatio)
+	if requirement.FiatAmount > maxRequirement.FiatAmount {
+		return forwardTypes.ErrInvalidMargin(forwardTypes.DefaultCodeSpace, "Fiat margin cannot exceed the bid.")
+	}
+	if !requirement.Coins.IsAllLTE(maxRequirement.Coins) {
+		return forwardTypes.ErrInvalidMargin(forwardTypes.DefaultCodeSpace, fmt.Sprintf("Coin margin cannot exceed %s.",
+			maxRequirement.Coins.String()))
+	}
+
+	// a running margin call keeps its deadline
+	if deadline == 0 && !margin.Covers(requirement) {
+		deadline = ctx.BlockHeight() + forward.MarginCallPeriod
+		k.InsertMarginCallQueue(ctx, deadline, calledAddress, forward.NegotiationID)
+	}
+
+	if calledAddress.Equals(forward.BuyerAddress) {
+		forward.BuyerRequiredMargin, forward.BuyerMarginCall = requirement, deadline
+	} else {
+		forward.SellerRequiredMargin, forward.SellerMarginCall = requirement, deadline
+	}
+	k.SetForward(ctx, forward)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(forwardTypes.EventTypeMarginCall,
+			cTypes.NewAttribute(forwardTypes.AttributeKeyNegotiationID, forward.NegotiationID.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyFromAddress, marginCall.FromAddress.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyCalledAddress, calledAddress.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyDeadline, strconv.FormatInt(deadline, 10)),
+		))
+
+	return nil
+}
+
+// ExpireMarginCalls : default forwards whose called party did not top up before the deadline
+func (k Keeper) ExpireMarginCalls(ctx cTypes.Context) {
+	k.IterateMarginCallQueue(ctx, ctx.BlockHeight(), func(forward forwardTypes.Forward, calledAddress cTypes.AccAddress) bool {
+		var buyerDefaulted, sellerDefaulted bool
+		if forward.BuyerAddress.Equals(calledAddress) {
+			k.RemoveFromMarginCallQueue(ctx, forward.BuyerMarginCall, forward.BuyerAddress, forward.NegotiationID)
+			forward.BuyerMarginCall = 0
+			buyerDefaulted = !forward.BuyerMargin.Covers(forward.BuyerRequiredMargin)
+		} else {
+			k.RemoveFromMarginCallQueue(ctx, forward.SellerMarginCall, forward.SellerAddress, forward.NegotiationID)
+			forward.SellerMarginCall = 0
+			sellerDefaulted = !forward.SellerMargin.Covers(forward.SellerRequiredMargin)
+		}
+
+		if forward.Status == forwardTypes.StatusActive && (buyerDefaulted || sellerDefaulted) {
+			k.closeForward(ctx, forward, buyerDefaulted, sellerDefaulted)
+		} else {
+			k.SetForward(ctx, forward)
+		}
+		return false
+	})
+}
+
+// SettleForwards : settle forwards whose delivery window after maturity has closed
+func (k Keeper) SettleForwards(ctx cTypes.Context) {
+	k.IterateSettlementQueue(ctx, ctx.BlockHeight(), func(forward forwardTypes.Forward) bool {
+		switch forward.Status {
+		case forwardTypes.StatusPending:
+			k.closeForward(ctx, forward, true, true)
+		case forwardTypes.StatusActive:
+			err, assetPegWallet, fiatPegWallet, fiatProofHash, awbProofHash := k.orderKeeper.GetOrderDetails(ctx,
+				forward.BuyerAddress, forward.SellerAddress, forward.PegHash)
+
+			payment := types.GetFiatPegWalletBalance(fiatPegWallet)
+			if _negotiation, nErr := k.negotiationKeeper.GetNegotiation(ctx, forward.NegotiationID); nErr == nil &&
+				negotiation.IsCoinSettled(_negotiation) {
+				payment = k.orderKeeper.GetOrderCoins(ctx, forward.BuyerAddress, forward.SellerAddress,
+					forward.PegHash).AmountOf(_negotiation.GetSettlementDenom()).Int64()
+			}
+
+			sellerDelivered := err == nil && (awbProofHash != "" || containsAssetPeg(assetPegWallet, forward.PegHash))
+			buyerDelivered := err == nil && (fiatProofHash != "" || payment >= forward.Bid)
+			k.closeForward(ctx, forward, !buyerDelivered, !sellerDelivered)
+		}
+		return false
+	})
+}
+
+// closeForward : pay out margins. A defaulting party's margin goes to the counterparty, if only one side defaulted.
+func (k Keeper) closeForward(ctx cTypes.Context, forward forwardTypes.Forward, buyerDefaulted, sellerDefaulted bool) {
+	if forward.BuyerMarginCall != 0 {
+		k.RemoveFromMarginCallQueue(ctx, forward.BuyerMarginCall, forward.BuyerAddress, forward.NegotiationID)
+		forward.BuyerMarginCall = 0
+	}
+	if forward.SellerMarginCall != 0 {
+		k.RemoveFromMarginCallQueue(ctx, forward.SellerMarginCall, forward.SellerAddress, forward.NegotiationID)
+		forward.SellerMarginCall = 0
+	}
+	k.RemoveFromSettlementQueue(ctx, forward.SettlementHeight, forward.NegotiationID)
+
+	buyerMarginTo, sellerMarginTo := forward.BuyerAddress, forward.SellerAddress
+	switch {
+	case buyerDefaulted && sellerDefaulted:
+		forward.Status = forwardTypes.StatusCancelled
+	case buyerDefaulted:
+		forward.Status = forwardTypes.StatusDefaulted
+		buyerMarginTo = forward.SellerAddress
+	case sellerDefaulted:
+		forward.Status = forwardTypes.StatusDefaulted
+		sellerMarginTo = forward.BuyerAddress
+	default:
+		forward.Status = forwardTypes.StatusSettled
+	}
+
+	forward.BuyerMargin = k.releaseMargin(ctx, buyerMarginTo, forward.BuyerMargin)
+	forward.SellerMargin = k.releaseMargin(ctx, sellerMarginTo, forward.SellerMargin)
+	k.SetForward(ctx, forward)
+
+	eventType := forwardTypes.EventTypeSettleForward
+	defaulter := ""
+	if forward.Status == forwardTypes.StatusDefaulted {
+		eventType = forwardTypes.EventTypeForwardDefault
+		defaulter = forward.SellerAddress.String()
+		if buyerDefaulted {
+			defaulter = forward.BuyerAddress.String()
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(eventType,
+			cTypes.NewAttribute(forwardTypes.AttributeKeyNegotiationID, forward.NegotiationID.String()),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyStatus, string(forward.Status)),
+			cTypes.NewAttribute(forwardTypes.AttributeKeyDefaulter, defaulter),
+		))
+}
+
+// releaseMargin : pay the margin out to address. Coins the module account fails to send stay in the returned margin
+// of the forward rather than halting the chain in the EndBlocker.
+func (k Keeper) releaseMargin(ctx cTypes.Context, address cTypes.AccAddress, margin forwardTypes.Margin) (kept forwardTypes.Margin) {
+	if len(margin.FiatPegWallet) != 0 {
+		account := k.accountKeeper.GetAccount(ctx, address)
+		_ = account.SetFiatPegWallet(types.AddFiatPegToWallet(account.GetFiatPegWallet(), margin.FiatPegWallet))
+		k.accountKeeper.SetAccount(ctx, account)
+	}
+	if !margin.Coins.Empty() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, forwardTypes.ModuleName, address, margin.Coins)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("forward margin of %s not released: %s", address.String(), err.Error()))
+			kept.Coins = margin.Coins
+		}
+	}
+	return kept
+}
+
+func addToMargin(margin forwardTypes.Margin, fiatPegWallet types.FiatPegWallet, coins cTypes.Coins) forwardTypes.Margin {
+	if len(fiatPegWallet) != 0 {
+		margin.FiatPegWallet = types.AddFiatPegToWallet(margin.FiatPegWallet, fiatPegWallet)
+	}
+	if !coins.Empty() {
+		margin.Coins = margin.Coins.Add(coins)
+	}
+	return margin
+}
+
+func containsAssetPeg(assetPegWallet types.AssetPegWallet, pegHash types.PegHash) bool {
+	i := assetPegWallet.SearchAssetPeg(pegHash)
+	return i < len(assetPegWallet) && assetPegWallet[i].GetPegHash().String() == pegHash.String()
+}
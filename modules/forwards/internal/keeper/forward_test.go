@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/params"
+	"github.com/commitHub/commitBlockchain/types"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+)
+
+type testAccountKeeper map[string]exported.Account
+
+func (ak testAccountKeeper) GetAccount(_ cTypes.Context, address cTypes.AccAddress) exported.Account {
+	if account, found := ak[address.String()]; found {
+		return account
+	}
+	account := auth.NewBaseAccountWithAddress(address)
+	return &account
+}
+
+func (ak testAccountKeeper) SetAccount(_ cTypes.Context, account exported.Account) {
+	ak[account.GetAddress().String()] = account
+}
+
+type testNegotiationKeeper map[string]negotiation.Negotiation
+
+func (nk testNegotiationKeeper) GetNegotiation(_ cTypes.Context, id negotiation.NegotiationID) (negotiation.Negotiation, cTypes.Error) {
+	if _negotiation, found := nk[id.String()]; found {
+		return _negotiation, nil
+	}
+	return nil, cTypes.ErrUnknownRequest("negotiation not found")
+}
+
+func (nk testNegotiationKeeper) SetNegotiation(_ cTypes.Context, _negotiation negotiation.Negotiation) {
+	nk[_negotiation.GetNegotiationID().String()] = _negotiation
+}
+
+type testOrderKeeper struct{}
+
+func (testOrderKeeper) GetOrderDetails(_ cTypes.Context, _ cTypes.AccAddress, _ cTypes.AccAddress,
+	_ types.PegHash) (cTypes.Error, types.AssetPegWallet, types.FiatPegWallet, string, string) {
+	return cTypes.ErrUnknownRequest("order not found"), nil, nil, "", ""
+}
+
+func (testOrderKeeper) GetOrderCoins(_ cTypes.Context, _ cTypes.AccAddress, _ cTypes.AccAddress, _ types.PegHash) cTypes.Coins {
+	return nil
+}
+
+type testACLKeeper struct{}
+
+func (testACLKeeper) CheckFrozen(_ cTypes.Context, _ cTypes.AccAddress, _ string, _ ...types.PegHash) cTypes.Error {
+	return nil
+}
+
+func (testACLKeeper) GetAccountACLDetails(_ cTypes.Context, address cTypes.AccAddress) (acl.ACLAccount, cTypes.Error) {
+	return &acl.BaseACLAccount{Address: address, ACL: acl.ACL{Negotiation: true, SendFiat: true}}, nil
+}
+
+type testSupplyKeeper struct {
+	ak     testAccountKeeper
+	module *cTypes.Coins
+	fail   *bool
+}
+
+func (sk testSupplyKeeper) SendCoinsFromAccountToModule(ctx cTypes.Context, address cTypes.AccAddress, _ string,
+	amount cTypes.Coins) cTypes.Error {
+
+	account := sk.ak.GetAccount(ctx, address)
+	coins, negative := account.GetCoins().SafeSub(amount)
+	if negative {
+		return cTypes.ErrInsufficientCoins(amount.String())
+	}
+	_ = account.SetCoins(coins)
+	sk.ak.SetAccount(ctx, account)
+	*sk.module = sk.module.Add(amount)
+	return nil
+}
+
+func (sk testSupplyKeeper) SendCoinsFromModuleToAccount(ctx cTypes.Context, _ string, address cTypes.AccAddress,
+	amount cTypes.Coins) cTypes.Error {
+
+	if *sk.fail {
+		return cTypes.ErrInternal("module account failure")
+	}
+	*sk.module = sk.module.Sub(amount)
+	account := sk.ak.GetAccount(ctx, address)
+	_ = account.SetCoins(account.GetCoins().Add(amount))
+	sk.ak.SetAccount(ctx, account)
+	return nil
+}
+
+type testInput struct {
+	ctx     cTypes.Context
+	k       Keeper
+	ak      testAccountKeeper
+	module  *cTypes.Coins
+	fail    *bool
+	buyer   cTypes.AccAddress
+	seller  cTypes.AccAddress
+	forward negotiation.NegotiationID
+}
+
+func stake(amount int64) cTypes.Coins {
+	return cTypes.NewCoins(cTypes.NewInt64Coin("stake", amount))
+}
+
+// setupTestInput : an active forward maturing at 100 with margin calls of 10 blocks, each side margined with 10stake
+func setupTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(forwardTypes.StoreKey)
+	keyParams := cTypes.NewKVStoreKey(params.StoreKey)
+	tkeyParams := cTypes.NewTransientStoreKey(params.TStoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, cTypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, cTypes.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	forwardTypes.RegisterCodec(cdc)
+
+	ak := testAccountKeeper{}
+	nk := testNegotiationKeeper{}
+	module, fail := cTypes.NewCoins(), false
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	k := NewKeeper(key, cdc, pk.Subspace(forwardTypes.DefaultParamspace), ak, nk, testOrderKeeper{}, testACLKeeper{},
+		testSupplyKeeper{ak, &module, &fail})
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	k.SetParams(ctx, forwardTypes.DefaultParams())
+
+	buyer, seller := cTypes.AccAddress([]byte("buyer_______________")), cTypes.AccAddress([]byte("seller______________"))
+	for _, address := range []cTypes.AccAddress{buyer, seller} {
+		account := ak.GetAccount(ctx, address)
+		_ = account.SetCoins(stake(100))
+		ak.SetAccount(ctx, account)
+	}
+
+	_negotiation := negotiation.NewNegotiation(buyer, seller, types.PegHash([]byte{0x31}))
+	_ = _negotiation.SetBid(1000)
+	_ = _negotiation.SetTime(5)
+	_ = _negotiation.SetBuyerSignature([]byte("buyer"))
+	_ = _negotiation.SetSellerSignature([]byte("seller"))
+	nk.SetNegotiation(ctx, _negotiation)
+
+	requirement := forwardTypes.NewMarginRequirement(0, stake(10))
+	require.NoError(t, k.OpenForward(ctx, forwardTypes.NewOpenForward(buyer, _negotiation.GetNegotiationID(), 100, 10,
+		requirement, requirement)))
+	require.NoError(t, k.DepositMargin(ctx, forwardTypes.NewDepositMargin(buyer, _negotiation.GetNegotiationID(), 0, stake(10))))
+	require.NoError(t, k.DepositMargin(ctx, forwardTypes.NewDepositMargin(seller, _negotiation.GetNegotiationID(), 0, stake(10))))
+
+	forward, err := k.GetForward(ctx, _negotiation.GetNegotiationID())
+	require.NoError(t, err)
+	require.Equal(t, forwardTypes.StatusActive, forward.Status)
+
+	return testInput{ctx, k, ak, &module, &fail, buyer, seller, _negotiation.GetNegotiationID()}
+}
+
+func TestMarginCallsOnBothPartiesWithTheSameDeadline(t *testing.T) {
+	input := setupTestInput(t)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	require.NoError(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0, stake(20))))
+	require.NoError(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.seller, input.forward, 0, stake(20))))
+
+	forward, _ := input.k.GetForward(ctx, input.forward)
+	require.Equal(t, int64(15), forward.BuyerMarginCall)
+	require.Equal(t, int64(15), forward.SellerMarginCall)
+
+	// the buyer tops up, the margin call on the seller stays queued
+	ctx = ctx.WithBlockHeight(8)
+	require.NoError(t, input.k.DepositMargin(ctx, forwardTypes.NewDepositMargin(input.buyer, input.forward, 0, stake(10))))
+
+	var called []cTypes.AccAddress
+	input.k.IterateMarginCallQueue(ctx, 15, func(_ forwardTypes.Forward, calledAddress cTypes.AccAddress) bool {
+		called = append(called, calledAddress)
+		return false
+	})
+	require.Equal(t, []cTypes.AccAddress{input.seller}, called)
+
+	input.k.ExpireMarginCalls(ctx.WithBlockHeight(14))
+	forward, _ = input.k.GetForward(ctx, input.forward)
+	require.Equal(t, forwardTypes.StatusActive, forward.Status)
+
+	input.k.ExpireMarginCalls(ctx.WithBlockHeight(15))
+	forward, _ = input.k.GetForward(ctx, input.forward)
+	require.Equal(t, forwardTypes.StatusDefaulted, forward.Status)
+	require.True(t, forward.BuyerMargin.Coins.Empty())
+	require.True(t, forward.SellerMargin.Coins.Empty())
+
+	// the buyer gets its 20stake margin back and the 10stake of the defaulting seller
+	require.True(t, input.ak.GetAccount(ctx, input.buyer).GetCoins().IsEqual(stake(110)))
+	require.True(t, input.ak.GetAccount(ctx, input.seller).GetCoins().IsEqual(stake(90)))
+	require.True(t, input.module.Empty())
+}
+
+func TestMarginCallMetBeforeTheDeadline(t *testing.T) {
+	input := setupTestInput(t)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	require.NoError(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0, stake(20))))
+	require.NoError(t, input.k.DepositMargin(ctx, forwardTypes.NewDepositMargin(input.seller, input.forward, 0, stake(10))))
+
+	input.k.ExpireMarginCalls(ctx.WithBlockHeight(15))
+	forward, _ := input.k.GetForward(ctx, input.forward)
+	require.Equal(t, forwardTypes.StatusActive, forward.Status)
+	require.Equal(t, int64(0), forward.SellerMarginCall)
+}
+
+func TestMarginCallAboveTheMaxRatioIsRejected(t *testing.T) {
+	input := setupTestInput(t)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	// the forward opened with 10stake on each side, the default ratio caps calls at 20stake
+	require.Error(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0, stake(21))))
+	require.Error(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0,
+		stake(10).Add(cTypes.NewCoins(cTypes.NewInt64Coin("other", 1))))))
+	require.Error(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 1001, stake(10))))
+
+	forward, _ := input.k.GetForward(ctx, input.forward)
+	require.True(t, forward.SellerRequiredMargin.Coins.IsEqual(stake(10)))
+	require.Equal(t, int64(0), forward.SellerMarginCall)
+
+	input.k.SetParams(ctx, forwardTypes.NewParams(cTypes.NewDecWithPrec(25, 1)))
+	require.NoError(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0, stake(25))))
+}
+
+func TestUndeliveredForwardIsCancelledAtSettlement(t *testing.T) {
+	input := setupTestInput(t)
+	ctx := input.ctx.WithBlockHeight(105)
+
+	// neither side delivers through the order, both default and get their margin back
+	input.k.SettleForwards(ctx)
+	forward, _ := input.k.GetForward(ctx, input.forward)
+	require.Equal(t, forwardTypes.StatusCancelled, forward.Status)
+	require.True(t, input.ak.GetAccount(ctx, input.buyer).GetCoins().IsEqual(stake(100)))
+	require.True(t, input.ak.GetAccount(ctx, input.seller).GetCoins().IsEqual(stake(100)))
+}
+
+func TestReleaseMarginFailureKeepsTheMargin(t *testing.T) {
+	input := setupTestInput(t)
+	ctx := input.ctx.WithBlockHeight(5)
+	*input.fail = true
+
+	require.NoError(t, input.k.MarginCall(ctx, forwardTypes.NewMarginCall(input.buyer, input.forward, 0, stake(20))))
+	require.NotPanics(t, func() { input.k.ExpireMarginCalls(ctx.WithBlockHeight(15)) })
+
+	forward, _ := input.k.GetForward(ctx, input.forward)
+	require.Equal(t, forwardTypes.StatusDefaulted, forward.Status)
+	require.True(t, forward.BuyerMargin.Coins.IsEqual(stake(10)))
+	require.True(t, forward.SellerMargin.Coins.IsEqual(stake(10)))
+}
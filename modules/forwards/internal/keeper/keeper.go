@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/modules/params"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+)
+
+type Keeper struct {
+	storeKey          cTypes.StoreKey
+	cdc               *codec.Codec
+	paramSpace        params.Subspace
+	accountKeeper     forwardTypes.AccountKeeper
+	negotiationKeeper forwardTypes.NegotiationKeeper
+	orderKeeper       forwardTypes.OrderKeeper
+	aclKeeper         forwardTypes.ACLKeeper
+	supplyKeeper      forwardTypes.SupplyKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, accountKeeper forwardTypes.AccountKeeper,
+	negotiationKeeper forwardTypes.NegotiationKeeper, orderKeeper forwardTypes.OrderKeeper,
+	aclKeeper forwardTypes.ACLKeeper, supplyKeeper forwardTypes.SupplyKeeper) Keeper {
+
+	return Keeper{
+		storeKey:          storeKey,
+		cdc:               cdc,
+		paramSpace:        paramSpace.WithKeyTable(forwardTypes.ParamKeyTable()),
+		accountKeeper:     accountKeeper,
+		negotiationKeeper: negotiationKeeper,
+		orderKeeper:       orderKeeper,
+		aclKeeper:         aclKeeper,
+		supplyKeeper:      supplyKeeper,
+	}
+}
+
+// get the forwards parameters
+func (k Keeper) GetParams(ctx cTypes.Context) (params forwardTypes.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the forwards parameters
+func (k Keeper) SetParams(ctx cTypes.Context, params forwardTypes.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// forwards/{0x01}/{negotiationID} => forward
+func (k Keeper) SetForward(ctx cTypes.Context, forward forwardTypes.Forward) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(forward)
+	store.Set(forwardTypes.GetForwardKey(forward.NegotiationID), bz)
+}
+
+// returns forward by negotiationID
+func (k Keeper) GetForward(ctx cTypes.Context, negotiationID negotiation.NegotiationID) (forward forwardTypes.Forward, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(forwardTypes.GetForwardKey(negotiationID))
+	if bz == nil {
+		return forward, forwardTypes.ErrInvalidForward(forwardTypes.DefaultCodeSpace, "forward not found.")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &forward)
+	return forward, nil
+}
+
+// get all forwards => []Forward from store
+func (k Keeper) GetForwards(ctx cTypes.Context) (forwards []forwardTypes.Forward) {
+	k.IterateForwards(ctx, func(forward forwardTypes.Forward) (stop bool) {
+		forwards = append(forwards, forward)
+		return false
+	},
+	)
+	return
+}
+
+func (k Keeper) IterateForwards(ctx cTypes.Context, handler func(forward forwardTypes.Forward) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, forwardTypes.ForwardKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var forward forwardTypes.Forward
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &forward)
+		if handler(forward) {
+			break
+		}
+	}
+}
+
+// InsertSettlementQueue : queue the forward for settlement at height
+func (k Keeper) InsertSettlementQueue(ctx cTypes.Context, height int64, negotiationID negotiation.NegotiationID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(forwardTypes.GetSettlementQueueKey(height, negotiationID), negotiationID)
+}
+
+// RemoveFromSettlementQueue : remove the forward from the settlement queue
+func (k Keeper) RemoveFromSettlementQueue(ctx cTypes.Context, height int64, negotiationID negotiation.NegotiationID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(forwardTypes.GetSettlementQueueKey(height, negotiationID))
+}
+
+// IterateSettlementQueue : iterate over the forwards settling at or before height
+func (k Keeper) IterateSettlementQueue(ctx cTypes.Context, height int64, handler func(forward forwardTypes.Forward) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(forwardTypes.SettlementQueueKey,
+		cTypes.PrefixEndBytes(forwardTypes.GetSettlementQueueHeightKey(height)))
+	k.iterateQueue(ctx, iterator, func(forward forwardTypes.Forward, _ []byte) bool {
+		return handler(forward)
+	})
+}
+
+// InsertMarginCallQueue : queue a margin call on the called party expiring at height
+func (k Keeper) InsertMarginCallQueue(ctx cTypes.Context, height int64, calledAddress cTypes.AccAddress,
+	negotiationID negotiation.NegotiationID) {
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(forwardTypes.GetMarginCallQueueKey(height, calledAddress, negotiationID), negotiationID)
+}
+
+// RemoveFromMarginCallQueue : remove the margin call on the called party from the queue
+func (k Keeper) RemoveFromMarginCallQueue(ctx cTypes.Context, height int64, calledAddress cTypes.AccAddress,
+	negotiationID negotiation.NegotiationID) {
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(forwardTypes.GetMarginCallQueueKey(height, calledAddress, negotiationID))
+}
+
+// IterateMarginCallQueue : iterate over the margin calls expiring at or before height with their called party
+func (k Keeper) IterateMarginCallQueue(ctx cTypes.Context, height int64,
+	handler func(forward forwardTypes.Forward, calledAddress cTypes.AccAddress) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(forwardTypes.MarginCallQueueKey,
+		cTypes.PrefixEndBytes(forwardTypes.GetMarginCallQueueHeightKey(height)))
+	k.iterateQueue(ctx, iterator, func(forward forwardTypes.Forward, key []byte) bool {
+		return handler(forward, forwardTypes.SplitMarginCallQueueKey(key))
+	})
+}
+
+func (k Keeper) iterateQueue(ctx cTypes.Context, iterator cTypes.Iterator,
+	handler func(forward forwardTypes.Forward, key []byte) (stop bool)) {
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		forward, err := k.GetForward(ctx, negotiation.NegotiationID(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		if handler(forward, iterator.Key()) {
+			break
+		}
+	}
+}
+
+// GetEscrowedFiatPegWallet : fiat pegs posted as margin in all forwards
+func (k Keeper) GetEscrowedFiatPegWallet(ctx cTypes.Context) (fiatPegWallet types.FiatPegWallet) {
+	k.IterateForwards(ctx, func(forward forwardTypes.Forward) (stop bool) {
+		fiatPegWallet = append(fiatPegWallet, forward.BuyerMargin.FiatPegWallet...)
+		fiatPegWallet = append(fiatPegWallet, forward.SellerMargin.FiatPegWallet...)
+		return false
+	})
+	return
+}
+
+// GetEscrowedAssetPegWallet : forwards deliver asset pegs through orders and hold none themselves
+func (k Keeper) GetEscrowedAssetPegWallet(ctx cTypes.Context) types.AssetPegWallet {
+	return nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+
+	forwardTypes "github.com/commitHub/commitBlockchain/modules/forwards/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryForward  = "queryForward"
+	QueryForwards = "queryForwards"
+	QueryParams   = "queryParams"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryForward:
+			return queryForward(ctx, path[1:], k)
+		case QueryForwards:
+			return queryForwards(ctx, k)
+		case QueryParams:
+			return queryParams(ctx, k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown forwards query endpoint")
+		}
+	}
+}
+
+func queryForward(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+
+	negotiationID, err := negotiation.GetNegotiationIDFromString(path[0])
+	if err != nil {
+		return nil, forwardTypes.ErrInvalidForward(forwardTypes.DefaultCodeSpace, fmt.Sprintf("forward with %s "+
+			" not found", err.Error()))
+	}
+	forward, err := k.GetForward(ctx, negotiationID)
+	if err != nil {
+		return nil, forwardTypes.ErrInvalidForward(forwardTypes.DefaultCodeSpace, fmt.Sprintf("forward with %s "+
+			" not found", negotiationID.String()))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, forward)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryForwards(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	forwards := k.GetForwards(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, forwards)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	params := k.GetParams(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, params)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
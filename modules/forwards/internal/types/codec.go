@@ -0,0 +1,17 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgOpenForwards{}, "commit-blockchain/MsgOpenForwards", nil)
+	cdc.RegisterConcrete(MsgDepositMargins{}, "commit-blockchain/MsgDepositMargins", nil)
+	cdc.RegisterConcrete(MsgMarginCalls{}, "commit-blockchain/MsgMarginCalls", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
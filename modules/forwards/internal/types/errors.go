@@ -0,0 +1,53 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidForward       cTypes.CodeType = 801
+	CodeForwardExists        cTypes.CodeType = 802
+	CodeInvalidMaturity      cTypes.CodeType = 803
+	CodeInvalidMargin        cTypes.CodeType = 804
+	CodeUnauthorized         cTypes.CodeType = 805
+	CodeInvalidInputsOutputs cTypes.CodeType = 806
+)
+
+func ErrInvalidForward(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidForward, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidForward, "forward doesn't exist")
+}
+
+func ErrForwardExists(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeForwardExists, msg)
+	}
+	return cTypes.NewError(codespace, CodeForwardExists, "forward already exists for negotiation")
+}
+
+func ErrInvalidMaturity(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidMaturity, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidMaturity, "maturity height must be in the future")
+}
+
+func ErrInvalidMargin(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidMargin, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidMargin, "invalid margin")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
@@ -0,0 +1,19 @@
+package types
+
+var (
+	EventTypeOpenForward    = "openForward"
+	EventTypeDepositMargin  = "depositMargin"
+	EventTypeMarginCall     = "marginCall"
+	EventTypeSettleForward  = "settleForward"
+	EventTypeForwardDefault = "forwardDefault"
+
+	AttributeKeyNegotiationID  = "negotiationID"
+	AttributeKeyBuyerAddress   = "buyerAddress"
+	AttributeKeySellerAddress  = "sellerAddress"
+	AttributeKeyFromAddress    = "fromAddress"
+	AttributeKeyCalledAddress  = "calledAddress"
+	AttributeKeyDefaulter      = "defaulter"
+	AttributeKeyMaturityHeight = "maturityHeight"
+	AttributeKeyDeadline       = "deadline"
+	AttributeKeyStatus         = "status"
+)
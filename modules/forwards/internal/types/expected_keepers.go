@@ -0,0 +1,37 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type AccountKeeper interface {
+	GetAccount(ctx cTypes.Context, address cTypes.AccAddress) exported.Account
+	SetAccount(ctx cTypes.Context, account exported.Account)
+}
+
+type NegotiationKeeper interface {
+	GetNegotiation(ctx cTypes.Context, id negotiation.NegotiationID) (negotiation.Negotiation, cTypes.Error)
+	SetNegotiation(ctx cTypes.Context, negotiation negotiation.Negotiation)
+}
+
+type OrderKeeper interface {
+	GetOrderDetails(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress,
+		pegHash types.PegHash) (cTypes.Error, types.AssetPegWallet, types.FiatPegWallet, string, string)
+	GetOrderCoins(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress,
+		pegHash types.PegHash) cTypes.Coins
+}
+
+type ACLKeeper interface {
+	GetAccountACLDetails(ctx cTypes.Context, fromAddress cTypes.AccAddress) (acl.ACLAccount, cTypes.Error)
+	CheckFrozen(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHashes ...types.PegHash) cTypes.Error
+}
+
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx cTypes.Context, senderAddr cTypes.AccAddress, recipientModule string, amt cTypes.Coins) cTypes.Error
+	SendCoinsFromModuleToAccount(ctx cTypes.Context, senderModule string, recipientAddr cTypes.AccAddress, amt cTypes.Coins) cTypes.Error
+}
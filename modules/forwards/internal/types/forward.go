@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type ForwardStatus string
+
+const (
+	// StatusPending : opened, waiting for both sides to post the required margin
+	StatusPending ForwardStatus = "pending"
+	// StatusActive : both sides margined, delivery happens through the order flow at maturity
+	StatusActive ForwardStatus = "active"
+	// StatusSettled : both sides delivered, margins returned
+	StatusSettled ForwardStatus = "settled"
+	// StatusDefaulted : a side failed to deliver or meet a margin call, its margin went to the counterparty
+	StatusDefaulted ForwardStatus = "defaulted"
+	// StatusCancelled : never became active before maturity, margins returned
+	StatusCancelled ForwardStatus = "cancelled"
+)
+
+// MarginRequirement : margin a party has to keep posted on the forward
+type MarginRequirement struct {
+	FiatAmount int64        `json:"fiatAmount"`
+	Coins      cTypes.Coins `json:"coins"`
+}
+
+func NewMarginRequirement(fiatAmount int64, coins cTypes.Coins) MarginRequirement {
+	return MarginRequirement{
+		FiatAmount: fiatAmount,
+		Coins:      coins,
+	}
+}
+
+func (requirement MarginRequirement) ValidateBasic() cTypes.Error {
+	if requirement.FiatAmount < 0 {
+		return ErrInvalidMargin(DefaultCodeSpace, "fiat margin should not be negative")
+	}
+	if !requirement.Coins.IsValid() {
+		return cTypes.ErrInvalidCoins(requirement.Coins.String())
+	}
+	return nil
+}
+
+// MaxMarginCall : highest requirement a margin call can raise the opening requirement to, the fiat margin is capped by the bid
+// and the coin margin by maxRatio times the coins agreed when opening
+func (requirement MarginRequirement) MaxMarginCall(bid int64, maxRatio cTypes.Dec) MarginRequirement {
+	var coins cTypes.Coins
+	for _, coin := range requirement.Coins {
+		coins = append(coins, cTypes.NewCoin(coin.Denom, maxRatio.MulInt(coin.Amount).TruncateInt()))
+	}
+	return NewMarginRequirement(bid, coins)
+}
+
+// Margin : margin posted by a party, held by the forwards module
+type Margin struct {
+	FiatPegWallet types.FiatPegWallet `json:"fiatPegWallet"`
+	Coins         cTypes.Coins        `json:"coins"`
+}
+
+// Covers : true if the posted margin satisfies the requirement
+func (margin Margin) Covers(requirement MarginRequirement) bool {
+	if types.GetFiatPegWalletBalance(margin.FiatPegWallet) < requirement.FiatAmount {
+		return false
+	}
+	return margin.Coins.IsAllGTE(requirement.Coins)
+}
+
+// Forward : forward contract on top of a confirmed negotiation
+type Forward struct {
+	NegotiationID        negotiation.NegotiationID `json:"negotiationID"`
+	BuyerAddress         cTypes.AccAddress         `json:"buyerAddress"`
+	SellerAddress        cTypes.AccAddress         `json:"sellerAddress"`
+	PegHash              types.PegHash             `json:"pegHash"`
+	Bid                  int64                     `json:"bid"`
+	MaturityHeight       int64                     `json:"maturityHeight"`
+	SettlementHeight     int64                     `json:"settlementHeight"`
+	MarginCallPeriod     int64                     `json:"marginCallPeriod"`
+	BuyerOpeningMargin   MarginRequirement         `json:"buyerOpeningMargin"`
+	SellerOpeningMargin  MarginRequirement         `json:"sellerOpeningMargin"`
+	BuyerRequiredMargin  MarginRequirement         `json:"buyerRequiredMargin"`
+	SellerRequiredMargin MarginRequirement         `json:"sellerRequiredMargin"`
+	BuyerMargin          Margin                    `json:"buyerMargin"`
+	SellerMargin         Margin                    `json:"sellerMargin"`
+	BuyerMarginCall      int64                     `json:"buyerMarginCall"`
+	SellerMarginCall     int64                     `json:"sellerMarginCall"`
+	Status               ForwardStatus             `json:"status"`
+}
+
+func NewForward(_negotiation negotiation.Negotiation, maturityHeight int64, marginCallPeriod int64,
+	buyerRequiredMargin, sellerRequiredMargin MarginRequirement) Forward {
+
+	return Forward{
+		NegotiationID:        _negotiation.GetNegotiationID(),
+		BuyerAddress:         _negotiation.GetBuyerAddress(),
+		SellerAddress:        _negotiation.GetSellerAddress(),
+		PegHash:              _negotiation.GetPegHash(),
+		Bid:                  _negotiation.GetBid(),
+		MaturityHeight:       maturityHeight,
+		SettlementHeight:     maturityHeight + _negotiation.GetTime(),
+		MarginCallPeriod:     marginCallPeriod,
+		BuyerOpeningMargin:   buyerRequiredMargin,
+		SellerOpeningMargin:  sellerRequiredMargin,
+		BuyerRequiredMargin:  buyerRequiredMargin,
+		SellerRequiredMargin: sellerRequiredMargin,
+		Status:               StatusPending,
+	}
+}
+
+func (forward Forward) String() string {
+	return fmt.Sprintf(`Forward:
+NegotiationID: %s,
+BuyerAddress: %s,
+SellerAddress: %s,
+PegHash: %s,
+Bid: %d,
+MaturityHeight: %d,
+SettlementHeight: %d,
+Status: %s,
+`, forward.NegotiationID.String(), forward.BuyerAddress.String(), forward.SellerAddress.String(),
+		forward.PegHash.String(), forward.Bid, forward.MaturityHeight, forward.SettlementHeight, forward.Status)
+}
+
+// IsParty : true if the address is the buyer or the seller of the forward
+func (forward Forward) IsParty(address cTypes.AccAddress) bool {
+	return forward.BuyerAddress.Equals(address) || forward.SellerAddress.Equals(address)
+}
+
+// IsMargined : true if both sides cover their margin requirement
+func (forward Forward) IsMargined() bool {
+	return forward.BuyerMargin.Covers(forward.BuyerRequiredMargin) && forward.SellerMargin.Covers(forward.SellerRequiredMargin)
+}
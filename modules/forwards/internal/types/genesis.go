@@ -0,0 +1,28 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	Params   Params    `json:"params"`
+	Forwards []Forward `json:"forwards"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	for _, forward := range data.Forwards {
+		if len(forward.NegotiationID) == 0 {
+			return fmt.Errorf("forward with empty negotiationID in genesis")
+		}
+		if forward.SettlementHeight < forward.MaturityHeight {
+			return fmt.Errorf("forward %s settles before maturity", forward.NegotiationID.String())
+		}
+	}
+	return nil
+}
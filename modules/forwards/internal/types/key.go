@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/binary"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+const (
+	ModuleName   = "forwards"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+
+	DefaultParamspace = ModuleName
+)
+
+var (
+	ForwardKey         = []byte{0x01}
+	SettlementQueueKey = []byte{0x02}
+	MarginCallQueueKey = []byte{0x03}
+)
+
+func GetForwardKey(negotiationID negotiation.NegotiationID) []byte {
+	return append(ForwardKey, negotiationID.Bytes()...)
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+// forwards/{0x02}/{height}
+func GetSettlementQueueHeightKey(height int64) []byte {
+	return append(SettlementQueueKey, heightBytes(height)...)
+}
+
+// forwards/{0x02}/{height}/{negotiationID}
+func GetSettlementQueueKey(height int64, negotiationID negotiation.NegotiationID) []byte {
+	return append(GetSettlementQueueHeightKey(height), negotiationID.Bytes()...)
+}
+
+// forwards/{0x03}/{height}
+func GetMarginCallQueueHeightKey(height int64) []byte {
+	return append(MarginCallQueueKey, heightBytes(height)...)
+}
+
+// forwards/{0x03}/{height}/{calledAddress}/{negotiationID}
+func GetMarginCallQueueKey(height int64, calledAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID) []byte {
+	return append(append(GetMarginCallQueueHeightKey(height), calledAddress.Bytes()...), negotiationID.Bytes()...)
+}
+
+// SplitMarginCallQueueKey : returns the called address from a margin call queue key
+func SplitMarginCallQueueKey(key []byte) cTypes.AccAddress {
+	return cTypes.AccAddress(key[1+8 : 1+8+cTypes.AddrLen])
+}
@@ -0,0 +1,367 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/negotiation"
+)
+
+// *****OpenForward
+
+// OpenForward : turn a confirmed negotiation into a forward maturing at MaturityHeight
+type OpenForward struct {
+	FromAddress          cTypes.AccAddress         `json:"fromAddress"`
+	NegotiationID        negotiation.NegotiationID `json:"negotiationID"`
+	MaturityHeight       int64                     `json:"maturityHeight"`
+	MarginCallPeriod     int64                     `json:"marginCallPeriod"`
+	BuyerRequiredMargin  MarginRequirement         `json:"buyerRequiredMargin"`
+	SellerRequiredMargin MarginRequirement         `json:"sellerRequiredMargin"`
+}
+
+// NewOpenForward : initializer
+func NewOpenForward(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, maturityHeight int64,
+	marginCallPeriod int64, buyerRequiredMargin, sellerRequiredMargin MarginRequirement) OpenForward {
+
+	return OpenForward{fromAddress, negotiationID, maturityHeight, marginCallPeriod, buyerRequiredMargin, sellerRequiredMargin}
+}
+
+// GetSignBytes : get bytes to sign
+func (in OpenForward) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress          string            `json:"fromAddress"`
+		NegotiationID        string            `json:"negotiationID"`
+		MaturityHeight       int64             `json:"maturityHeight"`
+		MarginCallPeriod     int64             `json:"marginCallPeriod"`
+		BuyerRequiredMargin  MarginRequirement `json:"buyerRequiredMargin"`
+		SellerRequiredMargin MarginRequirement `json:"sellerRequiredMargin"`
+	}{
+		FromAddress:          in.FromAddress.String(),
+		NegotiationID:        in.NegotiationID.String(),
+		MaturityHeight:       in.MaturityHeight,
+		MarginCallPeriod:     in.MarginCallPeriod,
+		BuyerRequiredMargin:  in.BuyerRequiredMargin,
+		SellerRequiredMargin: in.SellerRequiredMargin,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in OpenForward) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.NegotiationID) == 0 {
+		return cTypes.ErrUnknownRequest("NegotiationID should not be empty.")
+	} else if in.MaturityHeight <= 0 {
+		return ErrInvalidMaturity(DefaultCodeSpace, "Maturity height should be positive.")
+	} else if in.MarginCallPeriod <= 0 {
+		return ErrInvalidMargin(DefaultCodeSpace, "Margin call period should be positive.")
+	}
+	if err := in.BuyerRequiredMargin.ValidateBasic(); err != nil {
+		return err
+	}
+	return in.SellerRequiredMargin.ValidateBasic()
+}
+
+// MsgOpenForwards : high level open forward of forwards module
+type MsgOpenForwards struct {
+	OpenForwards []OpenForward `json:"openForwards"`
+}
+
+// NewMsgOpenForwards : initializer
+func NewMsgOpenForwards(openForwards []OpenForward) MsgOpenForwards {
+	return MsgOpenForwards{openForwards}
+}
+
+var _ cTypes.Msg = MsgOpenForwards{}
+
+// Type : implements msg
+func (msg MsgOpenForwards) Type() string { return "openForwards" }
+
+func (msg MsgOpenForwards) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgOpenForwards) ValidateBasic() cTypes.Error {
+	if len(msg.OpenForwards) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.OpenForwards {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgOpenForwards) GetSignBytes() []byte {
+	var openForwards []json.RawMessage
+	for _, openForward := range msg.OpenForwards {
+		openForwards = append(openForwards, openForward.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		OpenForwards []json.RawMessage `json:"openForwards"`
+	}{
+		OpenForwards: openForwards,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgOpenForwards) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.OpenForwards))
+	for i, in := range msg.OpenForwards {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgOpenForward : build the MsgOpenForwards
+func BuildMsgOpenForward(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, maturityHeight int64,
+	marginCallPeriod int64, buyerRequiredMargin, sellerRequiredMargin MarginRequirement) cTypes.Msg {
+
+	openForward := NewOpenForward(fromAddress, negotiationID, maturityHeight, marginCallPeriod, buyerRequiredMargin, sellerRequiredMargin)
+	msg := NewMsgOpenForwards([]OpenForward{openForward})
+	return msg
+}
+
+// #####OpenForward
+
+// *****DepositMargin
+
+// DepositMargin : post or top up margin on a forward
+type DepositMargin struct {
+	FromAddress   cTypes.AccAddress         `json:"fromAddress"`
+	NegotiationID negotiation.NegotiationID `json:"negotiationID"`
+	FiatAmount    int64                     `json:"fiatAmount"`
+	Coins         cTypes.Coins              `json:"coins"`
+}
+
+// NewDepositMargin : initializer
+func NewDepositMargin(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, fiatAmount int64,
+	coins cTypes.Coins) DepositMargin {
+
+	return DepositMargin{fromAddress, negotiationID, fiatAmount, coins}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DepositMargin) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress   string       `json:"fromAddress"`
+		NegotiationID string       `json:"negotiationID"`
+		FiatAmount    int64        `json:"fiatAmount"`
+		Coins         cTypes.Coins `json:"coins"`
+	}{
+		FromAddress:   in.FromAddress.String(),
+		NegotiationID: in.NegotiationID.String(),
+		FiatAmount:    in.FiatAmount,
+		Coins:         in.Coins,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in DepositMargin) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.NegotiationID) == 0 {
+		return cTypes.ErrUnknownRequest("NegotiationID should not be empty.")
+	} else if in.FiatAmount < 0 {
+		return ErrInvalidMargin(DefaultCodeSpace, "Fiat amount should not be negative.")
+	} else if !in.Coins.IsValid() {
+		return cTypes.ErrInvalidCoins(in.Coins.String())
+	} else if in.FiatAmount == 0 && in.Coins.Empty() {
+		return ErrInvalidMargin(DefaultCodeSpace, "Deposit should not be empty.")
+	}
+	return nil
+}
+
+// MsgDepositMargins : high level margin deposit of forwards module
+type MsgDepositMargins struct {
+	DepositMargins []DepositMargin `json:"depositMargins"`
+}
+
+// NewMsgDepositMargins : initializer
+func NewMsgDepositMargins(depositMargins []DepositMargin) MsgDepositMargins {
+	return MsgDepositMargins{depositMargins}
+}
+
+var _ cTypes.Msg = MsgDepositMargins{}
+
+// Type : implements msg
+func (msg MsgDepositMargins) Type() string { return "depositMargins" }
+
+func (msg MsgDepositMargins) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDepositMargins) ValidateBasic() cTypes.Error {
+	if len(msg.DepositMargins) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.DepositMargins {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDepositMargins) GetSignBytes() []byte {
+	var depositMargins []json.RawMessage
+	for _, depositMargin := range msg.DepositMargins {
+		depositMargins = append(depositMargins, depositMargin.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DepositMargins []json.RawMessage `json:"depositMargins"`
+	}{
+		DepositMargins: depositMargins,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDepositMargins) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.DepositMargins))
+	for i, in := range msg.DepositMargins {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgDepositMargin : build the MsgDepositMargins
+func BuildMsgDepositMargin(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, fiatAmount int64,
+	coins cTypes.Coins) cTypes.Msg {
+
+	depositMargin := NewDepositMargin(fromAddress, negotiationID, fiatAmount, coins)
+	msg := NewMsgDepositMargins([]DepositMargin{depositMargin})
+	return msg
+}
+
+// #####DepositMargin
+
+// *****MarginCall
+
+// MarginCall : raise the margin requirement of the counterparty of FromAddress
+type MarginCall struct {
+	FromAddress   cTypes.AccAddress         `json:"fromAddress"`
+	NegotiationID negotiation.NegotiationID `json:"negotiationID"`
+	FiatAmount    int64                     `json:"fiatAmount"`
+	Coins         cTypes.Coins              `json:"coins"`
+}
+
+// NewMarginCall : initializer
+func NewMarginCall(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, fiatAmount int64,
+	coins cTypes.Coins) MarginCall {
+
+	return MarginCall{fromAddress, negotiationID, fiatAmount, coins}
+}
+
+// GetSignBytes : get bytes to sign
+func (in MarginCall) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress   string       `json:"fromAddress"`
+		NegotiationID string       `json:"negotiationID"`
+		FiatAmount    int64        `json:"fiatAmount"`
+		Coins         cTypes.Coins `json:"coins"`
+	}{
+		FromAddress:   in.FromAddress.String(),
+		NegotiationID: in.NegotiationID.String(),
+		FiatAmount:    in.FiatAmount,
+		Coins:         in.Coins,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in MarginCall) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.NegotiationID) == 0 {
+		return cTypes.ErrUnknownRequest("NegotiationID should not be empty.")
+	}
+	return NewMarginRequirement(in.FiatAmount, in.Coins).ValidateBasic()
+}
+
+// MsgMarginCalls : high level margin call of forwards module
+type MsgMarginCalls struct {
+	MarginCalls []MarginCall `json:"marginCalls"`
+}
+
+// NewMsgMarginCalls : initializer
+func NewMsgMarginCalls(marginCalls []MarginCall) MsgMarginCalls {
+	return MsgMarginCalls{marginCalls}
+}
+
+var _ cTypes.Msg = MsgMarginCalls{}
+
+// Type : implements msg
+func (msg MsgMarginCalls) Type() string { return "marginCalls" }
+
+func (msg MsgMarginCalls) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgMarginCalls) ValidateBasic() cTypes.Error {
+	if len(msg.MarginCalls) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.MarginCalls {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgMarginCalls) GetSignBytes() []byte {
+	var marginCalls []json.RawMessage
+	for _, marginCall := range msg.MarginCalls {
+		marginCalls = append(marginCalls, marginCall.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		MarginCalls []json.RawMessage `json:"marginCalls"`
+	}{
+		MarginCalls: marginCalls,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgMarginCalls) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.MarginCalls))
+	for i, in := range msg.MarginCalls {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgMarginCall : build the MsgMarginCalls
+func BuildMsgMarginCall(fromAddress cTypes.AccAddress, negotiationID negotiation.NegotiationID, fiatAmount int64,
+	coins cTypes.Coins) cTypes.Msg {
+
+	marginCall := NewMarginCall(fromAddress, negotiationID, fiatAmount, coins)
+	msg := NewMsgMarginCalls([]MarginCall{marginCall})
+	return msg
+}
+
+// #####MarginCall
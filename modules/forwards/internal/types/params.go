@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/params"
+)
+
+// Parameter store keys
+var (
+	KeyMaxMarginCallRatio = []byte("MaxMarginCallRatio")
+)
+
+// forwards parameters
+type Params struct {
+	MaxMarginCallRatio cTypes.Dec `json:"max_margin_call_ratio" yaml:"max_margin_call_ratio"` // highest coin margin a call can require, as a multiple of the margin agreed when opening
+}
+
+// ParamTable for forwards module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxMarginCallRatio cTypes.Dec) Params {
+	return Params{
+		MaxMarginCallRatio: maxMarginCallRatio,
+	}
+}
+
+// default forwards module parameters, margin calls can at most double the coin margin
+func DefaultParams() Params {
+	return Params{
+		MaxMarginCallRatio: cTypes.NewDec(2),
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	if params.MaxMarginCallRatio.IsNil() || params.MaxMarginCallRatio.LT(cTypes.OneDec()) {
+		return fmt.Errorf("forwards parameter MaxMarginCallRatio should be at least 1, is %s", params.MaxMarginCallRatio)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Forwards Params:
+  MaxMarginCallRatio: %s
+`, p.MaxMarginCallRatio)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMaxMarginCallRatio, Value: &p.MaxMarginCallRatio},
+	}
+}
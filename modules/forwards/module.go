@@ -0,0 +1,135 @@
+package forwards
+
+import (
+	"encoding/json"
+
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types/module"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/commitHub/commitBlockchain/modules/forwards/client/cli"
+	"github.com/commitHub/commitBlockchain/modules/forwards/client/rest"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string { return ModuleName }
+
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) { RegisterCodec(cdc) }
+
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	rest.RegisterRoutes(ctx, rtr, kafkaBool, kafkaState)
+}
+
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	forwardsTxCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "forwards transaction sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	forwardsTxCmd.AddCommand(client.PostCommands(
+		cli.OpenForwardCmd(cdc),
+		cli.DepositMarginCmd(cdc),
+		cli.MarginCallCmd(cdc),
+	)...)
+
+	return forwardsTxCmd
+}
+
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	forwardsQueryCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "forwards query sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	forwardsQueryCmd.AddCommand(client.GetCommands(
+		cli.GetForwardCmd(cdc),
+		cli.GetForwardsCmd(cdc),
+		cli.GetParamsCmd(cdc),
+	)...)
+
+	return forwardsQueryCmd
+}
+
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+func (am AppModule) RegisterInvariants(ir cTypes.InvariantRegistry) {
+
+}
+
+func (AppModule) Route() string { return RouterKey }
+
+func (am AppModule) NewHandler() cTypes.Handler { return NewHandler(am.keeper) }
+
+func (am AppModule) QuerierRoute() string { return QuerierRoute }
+
+func (am AppModule) NewQuerierHandler() cTypes.Querier { return NewQuerier(am.keeper) }
+
+func (am AppModule) InitGenesis(ctx cTypes.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+
+	_ = ModuleCdc.UnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+
+	return []abci.ValidatorUpdate{}
+}
+
+func (am AppModule) ExportGenesis(ctx cTypes.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+
+	return ModuleCdc.MustMarshalJSON(gs)
+}
+
+func (AppModule) BeginBlock(cTypes.Context, abci.RequestBeginBlock) {}
+
+func (am AppModule) EndBlock(ctx cTypes.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
@@ -25,13 +25,26 @@ var (
 	NewNegotiation         = types.NewNegotiation
 	NewSignNegotiationBody = types.NewSignNegotiationBody
 
+	GetNegotiationPegHashes = types.GetNegotiationPegHashes
+	IsBasket                = types.IsBasket
+	ValidateBasket          = types.ValidateBasket
+	IsSwap                  = types.IsSwap
+	ValidateSwap            = types.ValidateSwap
+	IsCoinSettled           = types.IsCoinSettled
+	ValidateSettlementDenom = types.ValidateSettlementDenom
+	ErrInvalidSettlement    = types.ErrInvalidSettlement
+	EqualPegHashes          = types.EqualPegHashes
+
 	EventTypeChangeNegotiationBid  = types.EventTypeChangeNegotiationBid
 	EventTypeConfirmNegotiationBid = types.EventTypeConfirmNegotiationBid
+	EventTypeCancelNegotiation     = types.EventTypeCancelNegotiation
+	EventTypeExpireNegotiation     = types.EventTypeExpireNegotiation
 
 	AttributeKeyNegotiationID = types.AttributeKeyNegotiationID
 	AttributeKeyBuyerAddress  = types.AttributeKeyBuyerAddress
 	AttributeKeySellerAddress = types.AttributeKeySellerAddress
 	AttributeKeyPegHash       = types.AttributeKeyPegHash
+	AttributeKeyParty         = types.AttributeKeyParty
 
 	ErrCodeVerifySignature = types.ErrVerifySignature
 	ErrCodeInvalidBid      = types.ErrInvalidBid
@@ -40,13 +53,16 @@ var (
 	NewKeeper  = keeper.NewKeeper
 
 	GetNegotiationKey = types.GetNegotiationKey
+	GetSoleSigner     = types.GetSoleSigner
 
-	ErrUnauthorized = types.ErrUnauthorized
+	ErrUnauthorized  = types.ErrUnauthorized
+	ErrInvalidBasket = types.ErrInvalidBasket
 
-	BuildMsgChangeBuyerBid   = types.BuildMsgChangeBuyerBid
-	BuildMsgChangeSellerBid  = types.BuildMsgChangeSellerBid
-	BuildMsgConfirmBuyerBid  = types.BuildMsgConfirmBuyerBid
-	BuildMsgConfirmSellerBid = types.BuildMsgConfirmSellerBid
+	BuildMsgChangeBuyerBid    = types.BuildMsgChangeBuyerBid
+	BuildMsgChangeSellerBid   = types.BuildMsgChangeSellerBid
+	BuildMsgConfirmBuyerBid   = types.BuildMsgConfirmBuyerBid
+	BuildMsgConfirmSellerBid  = types.BuildMsgConfirmSellerBid
+	BuildMsgCancelNegotiation = types.BuildMsgCancelNegotiation
 
 	GetNegotiationIDFromString = types.GetNegotiationIDFromString
 )
@@ -60,13 +76,15 @@ type (
 
 	BaseNegotiation = types.BaseNegotiation
 
-	MsgChangeBuyerBids   = types.MsgChangeBuyerBids
-	MsgChangeSellerBids  = types.MsgChangeSellerBids
-	MsgConfirmBuyerBids  = types.MsgConfirmBuyerBids
-	MsgConfirmSellerBids = types.MsgConfirmSellerBids
+	MsgChangeBuyerBids    = types.MsgChangeBuyerBids
+	MsgChangeSellerBids   = types.MsgChangeSellerBids
+	MsgConfirmBuyerBids   = types.MsgConfirmBuyerBids
+	MsgConfirmSellerBids  = types.MsgConfirmSellerBids
+	MsgCancelNegotiations = types.MsgCancelNegotiations
 
-	ChangeBid  = types.ChangeBid
-	ConfirmBid = types.ConfirmBid
+	ChangeBid         = types.ChangeBid
+	ConfirmBid        = types.ConfirmBid
+	CancelNegotiation = types.CancelNegotiation
 
 	Signature = types.Signature
 )
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	negotiationTypes "github.com/commitHub/commitBlockchain/modules/negotiation/internal/types"
+)
+
+func CancelNegotiationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-negotiation",
+		Short: "Withdraw the signature from a negotiation the counterparty has not signed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			negotiationID, err := negotiationTypes.GetNegotiationIDFromString(viper.GetString(FlagNegotiationID))
+			if err != nil {
+				return err
+			}
+
+			msg := negotiationTypes.BuildMsgCancelNegotiation(cliCtx.GetFromAddress(), negotiationID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsNegotiationID)
+	return cmd
+}
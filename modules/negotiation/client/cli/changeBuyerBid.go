@@ -37,15 +37,26 @@ func ChangeBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 			time := viper.GetInt64(FlagTime)
 			hashStr := viper.GetString(FlagPegHash)
 			pegHashHex, err := types.GetAssetPegHashHex(hashStr)
+			pegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagPegHashes))
+			if err != nil {
+				return err
+			}
+			swapPegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagSwapPegHashes))
+			if err != nil {
+				return err
+			}
 			negotiationID := negotiationTypes.NegotiationID(append(append(cliCtx.GetFromAddress().Bytes(), to.Bytes()...), pegHashHex...))
 
 			proposedNegotiation := &negotiationTypes.BaseNegotiation{
-				NegotiationID: negotiationID,
-				BuyerAddress:  cliCtx.GetFromAddress(),
-				SellerAddress: to,
-				PegHash:       pegHashHex,
-				Bid:           bid,
-				Time:          time,
+				NegotiationID:   negotiationID,
+				BuyerAddress:    cliCtx.GetFromAddress(),
+				SellerAddress:   to,
+				PegHash:         pegHashHex,
+				PegHashes:       pegHashes,
+				SwapPegHashes:   swapPegHashes,
+				SettlementDenom: viper.GetString(FlagSettlementDenom),
+				Bid:             bid,
+				Time:            time,
 			}
 
 			msg := negotiationTypes.BuildMsgChangeBuyerBid(proposedNegotiation)
@@ -55,6 +66,9 @@ func ChangeBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsPegHashes)
+	cmd.Flags().AddFlagSet(fsSwapPegHashes)
+	cmd.Flags().AddFlagSet(fsSettlementDenom)
 	cmd.Flags().AddFlagSet(fsBid)
 	cmd.Flags().AddFlagSet(fsTime)
 	return cmd
@@ -36,15 +36,26 @@ func ChangeSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 			time := viper.GetInt64(FlagTime)
 			hashStr := viper.GetString(FlagPegHash)
 			pegHashHex, err := types.GetAssetPegHashHex(hashStr)
+			pegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagPegHashes))
+			if err != nil {
+				return err
+			}
+			swapPegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagSwapPegHashes))
+			if err != nil {
+				return err
+			}
 			negotiationID := negotiationTypes.NegotiationID(append(append(to.Bytes(), cliCtx.GetFromAddress().Bytes()...), pegHashHex...))
 
 			proposedNegotiation := &negotiationTypes.BaseNegotiation{
-				NegotiationID: negotiationID,
-				BuyerAddress:  to,
-				SellerAddress: cliCtx.GetFromAddress(),
-				PegHash:       pegHashHex,
-				Bid:           bid,
-				Time:          time,
+				NegotiationID:   negotiationID,
+				BuyerAddress:    to,
+				SellerAddress:   cliCtx.GetFromAddress(),
+				PegHash:         pegHashHex,
+				PegHashes:       pegHashes,
+				SwapPegHashes:   swapPegHashes,
+				SettlementDenom: viper.GetString(FlagSettlementDenom),
+				Bid:             bid,
+				Time:            time,
 			}
 
 			msg := negotiationTypes.BuildMsgChangeSellerBid(proposedNegotiation)
@@ -53,6 +64,9 @@ func ChangeSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsPegHashes)
+	cmd.Flags().AddFlagSet(fsSwapPegHashes)
+	cmd.Flags().AddFlagSet(fsSettlementDenom)
 	cmd.Flags().AddFlagSet(fsBid)
 	cmd.Flags().AddFlagSet(fsTime)
 	return cmd
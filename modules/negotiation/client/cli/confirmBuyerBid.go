@@ -37,6 +37,14 @@ func ConfirmBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 			time := viper.GetInt64(FlagTime)
 			hashStr := viper.GetString(FlagPegHash)
 			pegHashHex, err := types.GetAssetPegHashHex(hashStr)
+			pegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagPegHashes))
+			if err != nil {
+				return err
+			}
+			swapPegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagSwapPegHashes))
+			if err != nil {
+				return err
+			}
 			buyerContractHash := viper.GetString(FlagBuyerContractHash)
 			negotiationID := negotiationTypes.NegotiationID(append(append(cliCtx.GetFromAddress().Bytes(), to.Bytes()...), pegHashHex...))
 
@@ -50,7 +58,7 @@ func ConfirmBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			SignBytes := negotiationTypes.NewSignNegotiationBody(cliCtx.GetFromAddress(), to, pegHashHex, bid, time)
+			SignBytes := negotiationTypes.NewSignNegotiationBody(cliCtx.GetFromAddress(), to, pegHashHex, pegHashes, swapPegHashes, viper.GetString(FlagSettlementDenom), bid, time)
 			signature, _, err := kb.Sign(cliCtx.GetFromName(), passphrase, SignBytes.GetSignBytes())
 			if err != nil {
 				return err
@@ -61,6 +69,9 @@ func ConfirmBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 				BuyerAddress:      cliCtx.GetFromAddress(),
 				SellerAddress:     to,
 				PegHash:           pegHashHex,
+				PegHashes:         pegHashes,
+				SwapPegHashes:     swapPegHashes,
+				SettlementDenom:   viper.GetString(FlagSettlementDenom),
 				Bid:               bid,
 				Time:              time,
 				BuyerContractHash: buyerContractHash,
@@ -75,6 +86,9 @@ func ConfirmBuyerBidCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsPegHashes)
+	cmd.Flags().AddFlagSet(fsSwapPegHashes)
+	cmd.Flags().AddFlagSet(fsSettlementDenom)
 	cmd.Flags().AddFlagSet(fsBid)
 	cmd.Flags().AddFlagSet(fsTime)
 	cmd.Flags().AddFlagSet(fsBuyerContractHash)
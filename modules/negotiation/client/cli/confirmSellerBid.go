@@ -38,6 +38,14 @@ func ConfirmSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 			time := viper.GetInt64(FlagTime)
 			hashStr := viper.GetString(FlagPegHash)
 			pegHashHex, err := types.GetAssetPegHashHex(hashStr)
+			pegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagPegHashes))
+			if err != nil {
+				return err
+			}
+			swapPegHashes, err := types.GetAssetPegHashesHex(viper.GetString(FlagSwapPegHashes))
+			if err != nil {
+				return err
+			}
 			sellerContractHash := viper.GetString(FlagSellerContractHash)
 			negotiationID := negotiationTypes.NegotiationID(append(append(to.Bytes(), cliCtx.GetFromAddress().Bytes()...), pegHashHex...))
 
@@ -51,7 +59,7 @@ func ConfirmSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			SignBytes := negotiationTypes.NewSignNegotiationBody(to, cliCtx.GetFromAddress(), pegHashHex, bid, time)
+			SignBytes := negotiationTypes.NewSignNegotiationBody(to, cliCtx.GetFromAddress(), pegHashHex, pegHashes, swapPegHashes, viper.GetString(FlagSettlementDenom), bid, time)
 			signature, _, err := kb.Sign(cliCtx.GetFromName(), passphrase, SignBytes.GetSignBytes())
 			if err != nil {
 				return err
@@ -62,6 +70,9 @@ func ConfirmSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 				BuyerAddress:       to,
 				SellerAddress:      cliCtx.GetFromAddress(),
 				PegHash:            pegHashHex,
+				PegHashes:          pegHashes,
+				SwapPegHashes:      swapPegHashes,
+				SettlementDenom:    viper.GetString(FlagSettlementDenom),
 				Bid:                bid,
 				Time:               time,
 				SellerContractHash: sellerContractHash,
@@ -76,6 +87,9 @@ func ConfirmSellerBidCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsTo)
 	cmd.Flags().AddFlagSet(fsPegHash)
+	cmd.Flags().AddFlagSet(fsPegHashes)
+	cmd.Flags().AddFlagSet(fsSwapPegHashes)
+	cmd.Flags().AddFlagSet(fsSettlementDenom)
 	cmd.Flags().AddFlagSet(fsBid)
 	cmd.Flags().AddFlagSet(fsTime)
 	cmd.Flags().AddFlagSet(fsSellerContractHash)
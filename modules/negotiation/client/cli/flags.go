@@ -9,6 +9,9 @@ const (
 	FlagTo                 = "to"
 	FlagFrom               = "from"
 	FlagPegHash            = "peg-hash"
+	FlagPegHashes          = "peg-hashes"
+	FlagSwapPegHashes      = "swap-peg-hashes"
+	FlagSettlementDenom    = "settlement-denom"
 	FlagBid                = "bid"
 	FlagTime               = "time"
 	FlagNegotiationID      = "negotiation-id"
@@ -19,6 +22,9 @@ const (
 var (
 	fsTo                 = flag.NewFlagSet("", flag.ContinueOnError)
 	fsPegHash            = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPegHashes          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsSwapPegHashes      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsSettlementDenom    = flag.NewFlagSet("", flag.ContinueOnError)
 	fsBid                = flag.NewFlagSet("", flag.ContinueOnError)
 	fsTime               = flag.NewFlagSet("", flag.ContinueOnError)
 	fsFrom               = flag.NewFlagSet("", flag.ContinueOnError)
@@ -30,6 +36,9 @@ var (
 func init() {
 	fsTo.String(FlagTo, "", "Address to send coins")
 	fsPegHash.String(FlagPegHash, "", "Peg Hash to be negotiated ")
+	fsPegHashes.String(FlagPegHashes, "", "Comma separated peg hashes of a basket, including peg-hash")
+	fsSwapPegHashes.String(FlagSwapPegHashes, "", "Comma separated peg hashes the buyer swaps for the asset")
+	fsSettlementDenom.String(FlagSettlementDenom, "", "Coin denomination the bid is settled in, fiat pegs if empty")
 	fsBid.String(FlagBid, "", "Amount of fiat to bid against asset")
 	fsTime.String(FlagTime, "", "Time to be assumed for contract confirmation")
 	fsFrom.String(FlagFrom, "", "address of buyer account")
@@ -5,6 +5,7 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/commitHub/commitBlockchain/kafka"
 	"net/http"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	cTypes "github.com/cosmos/cosmos-sdk/types"
@@ -18,13 +19,16 @@ import (
 )
 
 type changeSellerBidBody struct {
-	BaseReq  rest.BaseReq `json:"base_req"`
-	To       string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
-	Bid      int64        `json:"bid" valid:"required~Enter the Valid Bid,matches(^[1-9]{1}[0-9]*$)~Invalid Bid"`
-	Time     int64        `json:"time" valid:"required~Enter the Valid Time,matches(^[1-9]{1}[0-9]*$)~Invalid Time"`
-	PegHash  string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[0-9]+$)~Invalid PegHash,length(2|40)~PegHash length between 2-40"`
-	Password string       `json:"password" valid:"required~Enter the Password"`
-	Mode     string       `json:"mode"`
+	BaseReq         rest.BaseReq `json:"base_req"`
+	To              string       `json:"to" valid:"required~Enter the ToAddress,matches(^commit[a-z0-9]{39}$)~ToAddress is Invalid"`
+	Bid             int64        `json:"bid" valid:"required~Enter the Valid Bid,matches(^[1-9]{1}[0-9]*$)~Invalid Bid"`
+	Time            int64        `json:"time" valid:"required~Enter the Valid Time,matches(^[1-9]{1}[0-9]*$)~Invalid Time"`
+	PegHash         string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[0-9]+$)~Invalid PegHash,length(2|40)~PegHash length between 2-40"`
+	PegHashes       []string     `json:"pegHashes"`
+	SwapPegHashes   []string     `json:"swapPegHashes"`
+	SettlementDenom string       `json:"settlementDenom"`
+	Password        string       `json:"password" valid:"required~Enter the Password"`
+	Mode            string       `json:"mode"`
 }
 
 func ChangeSellerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
@@ -81,15 +85,28 @@ func ChangeSellerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 		}
 
 		pegHashHex, err := types2.GetAssetPegHashHex(req.PegHash)
+		pegHashes, err := types2.GetAssetPegHashesHex(strings.Join(req.PegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		swapPegHashes, err := types2.GetAssetPegHashesHex(strings.Join(req.SwapPegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		negotiationID := negotiationTypes.NegotiationID(append(append(to.Bytes(), fromAddr.Bytes()...), pegHashHex...))
 
 		proposedNegotiation := &negotiationTypes.BaseNegotiation{
-			NegotiationID: negotiationID,
-			BuyerAddress:  to,
-			SellerAddress: fromAddr,
-			PegHash:       pegHashHex,
-			Bid:           req.Bid,
-			Time:          req.Time,
+			NegotiationID:   negotiationID,
+			BuyerAddress:    to,
+			SellerAddress:   fromAddr,
+			PegHash:         pegHashHex,
+			PegHashes:       pegHashes,
+			SwapPegHashes:   swapPegHashes,
+			SettlementDenom: req.SettlementDenom,
+			Bid:             req.Bid,
+			Time:            req.Time,
 		}
 
 		msg := negotiationTypes.BuildMsgChangeSellerBid(proposedNegotiation)
@@ -5,6 +5,7 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/commitHub/commitBlockchain/kafka"
 	"net/http"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -24,6 +25,9 @@ type confirmBuyerBidReq struct {
 	Bid               int64        `json:"bid" valid:"required~Enter the Bid,matches(^[1-9]{1}[0-9]*$)~Enter valid Bid"`
 	Time              int64        `json:"time" valid:"required~Enter the Time,matches(^[1-9]{1}[0-9]*$)~Enter valid Time"`
 	PegHash           string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[0-9]+$)~Invalid PegHash,length(2|40)~PegHash length between 2-40"`
+	PegHashes         []string     `json:"pegHashes"`
+	SwapPegHashes     []string     `json:"swapPegHashes"`
+	SettlementDenom   string       `json:"settlementDenom"`
 	BuyerContractHash string       `json:"buyerContractHash" valid:"required~Enter the BuyerContractHash, matches(^.*$)~Invalid BuyerContractHash,length(1|1000)~BuyerContractHash length should be 1 to 1000"`
 	Password          string       `json:"password" valid:"required~Enter the Password"`
 	Mode              string       `json:"mode"`
@@ -90,6 +94,17 @@ func ConfirmBuyerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			return
 		}
 
+		pegHashes, err := types.GetAssetPegHashesHex(strings.Join(req.PegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		swapPegHashes, err := types.GetAssetPegHashesHex(strings.Join(req.SwapPegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		negotiationID := negotiationTypes.NegotiationID(append(append(fromAddr.Bytes(), to.Bytes()...), pegHashHex.Bytes()...))
 
 		kb, err := keys.NewKeyBaseFromHomeFlag()
@@ -98,7 +113,7 @@ func ConfirmBuyerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			return
 		}
 
-		SignBytes := negotiationTypes.NewSignNegotiationBody(fromAddr, to, pegHashHex, req.Bid, req.Time)
+		SignBytes := negotiationTypes.NewSignNegotiationBody(fromAddr, to, pegHashHex, pegHashes, swapPegHashes, req.SettlementDenom, req.Bid, req.Time)
 		signature, _, err := kb.Sign(name, req.Password, SignBytes.GetSignBytes())
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -110,6 +125,9 @@ func ConfirmBuyerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			BuyerAddress:      fromAddr,
 			SellerAddress:     to,
 			PegHash:           pegHashHex,
+			PegHashes:         pegHashes,
+			SwapPegHashes:     swapPegHashes,
+			SettlementDenom:   req.SettlementDenom,
 			Bid:               req.Bid,
 			Time:              req.Time,
 			BuyerContractHash: req.BuyerContractHash,
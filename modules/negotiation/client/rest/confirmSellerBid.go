@@ -5,6 +5,7 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/commitHub/commitBlockchain/kafka"
 	"net/http"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -24,6 +25,9 @@ type confirmSellerBidReq struct {
 	Bid                int64        `json:"bid" valid:"required~Enter the Bid,matches(^[1-9]{1}[0-9]*$)~Enter valid Bid"`
 	Time               int64        `json:"time" valid:"required~Enter the Time,matches(^[1-9]{1}[0-9]*$)~Enter valid Time"`
 	PegHash            string       `json:"pegHash" valid:"required~Enter the PegHash,matches(^[0-9]+$)~Invalid PegHash,length(2|40)~PegHash length between 2-40"`
+	PegHashes          []string     `json:"pegHashes"`
+	SwapPegHashes      []string     `json:"swapPegHashes"`
+	SettlementDenom    string       `json:"settlementDenom"`
 	SellerContractHash string       `json:"sellerContractHash" valid:"required~Enter the SellerContractHash, matches(^.*$)~Invalid SellerContractHash,length(1|1000)~SellerContractHash length should be 1 to 1000"`
 	Password           string       `json:"password" valid:"required~Enter the Password"`
 	Mode               string       `json:"mode"`
@@ -90,6 +94,17 @@ func ConfirmSellerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			return
 		}
 
+		pegHashes, err := types.GetAssetPegHashesHex(strings.Join(req.PegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		swapPegHashes, err := types.GetAssetPegHashesHex(strings.Join(req.SwapPegHashes, ","))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		negotiationID := negotiationTypes.NegotiationID(append(append(to.Bytes(), fromAddr.Bytes()...), pegHashHex.Bytes()...))
 		kb, err := keys.NewKeyBaseFromHomeFlag()
 		if err != nil {
@@ -97,7 +112,7 @@ func ConfirmSellerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			return
 		}
 
-		SignBytes := negotiationTypes.NewSignNegotiationBody(to, fromAddr, pegHashHex, req.Bid, req.Time)
+		SignBytes := negotiationTypes.NewSignNegotiationBody(to, fromAddr, pegHashHex, pegHashes, swapPegHashes, req.SettlementDenom, req.Bid, req.Time)
 		signature, _, err := kb.Sign(name, req.Password, SignBytes.GetSignBytes())
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -109,6 +124,9 @@ func ConfirmSellerBidRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool,
 			BuyerAddress:       to,
 			SellerAddress:      fromAddr,
 			PegHash:            pegHashHex,
+			PegHashes:          pegHashes,
+			SwapPegHashes:      swapPegHashes,
+			SettlementDenom:    req.SettlementDenom,
 			Bid:                req.Bid,
 			Time:               req.Time,
 			SellerContractHash: req.SellerContractHash,
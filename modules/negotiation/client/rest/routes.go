@@ -13,4 +13,5 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, ka
 	r.HandleFunc("/changeSellerBid", ChangeSellerBidRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/confirmBuyerBid", ConfirmBuyerBidRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 	r.HandleFunc("/confirmSellerBid", ConfirmSellerBidRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/cancelNegotiation", CancelNegotiationRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
 }
@@ -0,0 +1,10 @@
+package negotiation
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker : release negotiations the counterparty did not sign in time
+func EndBlocker(ctx cTypes.Context, keeper Keeper) {
+	keeper.ExpireNegotiations(ctx)
+}
@@ -5,35 +5,47 @@ import (
 
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
 )
 
 func NewHandler(k Keeper) cTypes.Handler { // TODO AclKeeper, ReputationKeeper
 	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
 		ctx = ctx.WithEventManager(cTypes.NewEventManager())
 
-		switch msg := msg.(type) {
-		case MsgChangeBuyerBids:
-			return handleMsgChangeBids(ctx, k, msg.ChangeBids)
-		case MsgChangeSellerBids:
-			return handleMsgChangeBids(ctx, k, msg.ChangeBids)
-		case MsgConfirmSellerBids:
-			return handleMsgConfirmBids(ctx, k, msg.ConfirmBids)
-		case MsgConfirmBuyerBids:
-			return handleMsgConfirmBids(ctx, k, msg.ConfirmBids)
-
-		default:
-			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
-			return cTypes.ErrUnknownRequest(errMsg).Result()
-
+		result := handleMsg(ctx, k, msg)
+		if !result.IsOK() {
+			result.Events = acl.RejectionEvents(ctx.EventManager().Events())
 		}
+		return result
+	}
+}
+
+func handleMsg(ctx cTypes.Context, k Keeper, msg cTypes.Msg) cTypes.Result {
+	switch msg := msg.(type) {
+	case MsgChangeBuyerBids:
+		return handleMsgChangeBids(ctx, k, msg.ChangeBids, Negotiation.GetBuyerAddress)
+	case MsgChangeSellerBids:
+		return handleMsgChangeBids(ctx, k, msg.ChangeBids, Negotiation.GetSellerAddress)
+	case MsgConfirmSellerBids:
+		return handleMsgConfirmBids(ctx, k, msg.ConfirmBids, Negotiation.GetSellerAddress)
+	case MsgConfirmBuyerBids:
+		return handleMsgConfirmBids(ctx, k, msg.ConfirmBids, Negotiation.GetBuyerAddress)
+	case MsgCancelNegotiations:
+		return handleMsgCancelNegotiations(ctx, k, msg)
+
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+		return cTypes.ErrUnknownRequest(errMsg).Result()
 
 	}
 }
 
-func handleMsgChangeBids(ctx cTypes.Context, negotiationKeeper Keeper, changeBids []ChangeBid) cTypes.Result {
+func handleMsgChangeBids(ctx cTypes.Context, negotiationKeeper Keeper, changeBids []ChangeBid,
+	getParty func(Negotiation) cTypes.AccAddress) cTypes.Result {
 
 	for _, changeBid := range changeBids {
-		err := changeNegotiationBidWithACL(ctx, negotiationKeeper, changeBid)
+		err := changeNegotiationBidWithACL(ctx, negotiationKeeper, changeBid, getParty(changeBid.Negotiation))
 		if err != nil {
 			return err.Result()
 		}
@@ -44,8 +56,17 @@ func handleMsgChangeBids(ctx cTypes.Context, negotiationKeeper Keeper, changeBid
 	}
 }
 
-func changeNegotiationBidWithACL(ctx cTypes.Context, negotiationKeeper Keeper, changeBid ChangeBid) cTypes.Error {
+func changeNegotiationBidWithACL(ctx cTypes.Context, negotiationKeeper Keeper, changeBid ChangeBid, party cTypes.AccAddress) cTypes.Error {
 	// TODO  ACLImplementation
+	if err := negotiationKeeper.CheckKYC(ctx, changeBid.Negotiation); err != nil {
+		return err
+	}
+	if err := negotiationKeeper.CheckCounterparty(ctx, changeBid.Negotiation); err != nil {
+		return err
+	}
+	if err := negotiationKeeper.CheckBid(ctx, party, changeBid.Negotiation); err != nil {
+		return err
+	}
 	err := createOrChangeNegotiationBid(ctx, negotiationKeeper, changeBid.Negotiation)
 	if err != nil {
 		return err
@@ -67,6 +88,9 @@ func createOrChangeNegotiationBid(ctx cTypes.Context, negotiationKeeper Keeper,
 
 	oldNegotiation.SetBid(negotiation.GetBid())
 	oldNegotiation.SetTime(negotiation.GetTime())
+	oldNegotiation.SetPegHashes(negotiation.GetPegHashes())
+	oldNegotiation.SetSwapPegHashes(negotiation.GetSwapPegHashes())
+	oldNegotiation.SetSettlementDenom(negotiation.GetSettlementDenom())
 
 	negotiationKeeper.SetNegotiation(ctx, oldNegotiation)
 
@@ -81,9 +105,10 @@ func createOrChangeNegotiationBid(ctx cTypes.Context, negotiationKeeper Keeper,
 	return nil
 }
 
-func handleMsgConfirmBids(ctx cTypes.Context, negotitationKeeper Keeper, confirmBids []ConfirmBid) cTypes.Result {
+func handleMsgConfirmBids(ctx cTypes.Context, negotitationKeeper Keeper, confirmBids []ConfirmBid,
+	getParty func(Negotiation) cTypes.AccAddress) cTypes.Result {
 	for _, confirmBid := range confirmBids {
-		err := confirmNegotiationBidWithACL(ctx, negotitationKeeper, confirmBid)
+		err := confirmNegotiationBidWithACL(ctx, negotitationKeeper, confirmBid, getParty(confirmBid.Negotiation))
 		if err != nil {
 			return err.Result()
 		}
@@ -95,8 +120,32 @@ func handleMsgConfirmBids(ctx cTypes.Context, negotitationKeeper Keeper, confirm
 
 }
 
-func confirmNegotiationBidWithACL(ctx cTypes.Context, negotiationKeeper Keeper, confirmBid ConfirmBid) cTypes.Error {
+func handleMsgCancelNegotiations(ctx cTypes.Context, negotiationKeeper Keeper, msg MsgCancelNegotiations) cTypes.Result {
+	for _, cancelNegotiation := range msg.CancelNegotiations {
+		if err := negotiationKeeper.CancelNegotiation(ctx, cancelNegotiation); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func confirmNegotiationBidWithACL(ctx cTypes.Context, negotiationKeeper Keeper, confirmBid ConfirmBid, party cTypes.AccAddress) cTypes.Error {
 	// TODO ACLImplementation
+	if err := negotiationKeeper.CheckKYC(ctx, confirmBid.Negotiation); err != nil {
+		return err
+	}
+	if err := negotiationKeeper.CheckCounterparty(ctx, confirmBid.Negotiation); err != nil {
+		return err
+	}
+	if err := negotiationKeeper.CheckBid(ctx, party, confirmBid.Negotiation); err != nil {
+		return err
+	}
+	if err := negotiationKeeper.OpenNegotiation(ctx, confirmBid.Negotiation); err != nil {
+		return err
+	}
 
 	err := confirmNegotiationBid(ctx, negotiationKeeper, confirmBid.Negotiation)
 	if err != nil {
@@ -110,6 +159,9 @@ func confirmNegotiationBid(ctx cTypes.Context, negotiationKeeper Keeper, negotia
 	if oldNegotiation == nil {
 		oldNegotiation = NewNegotiation(negotiation.GetBuyerAddress(), negotiation.GetSellerAddress(), negotiation.GetPegHash())
 		oldNegotiation.SetBid(negotiation.GetBid())
+		oldNegotiation.SetPegHashes(negotiation.GetPegHashes())
+		oldNegotiation.SetSwapPegHashes(negotiation.GetSwapPegHashes())
+		oldNegotiation.SetSettlementDenom(negotiation.GetSettlementDenom())
 	}
 
 	if oldNegotiation.GetSellerSignature() != nil && oldNegotiation.GetBuyerSignature() != nil {
@@ -120,12 +172,24 @@ func confirmNegotiationBid(ctx cTypes.Context, negotiationKeeper Keeper, negotia
 		return ErrCodeInvalidBid(DefaultCodeSpace, "Buyer and Seller must confirm with same bid amount")
 	}
 
+	if !EqualPegHashes(oldNegotiation.GetPegHashes(), negotiation.GetPegHashes()) {
+		return ErrInvalidBasket(DefaultCodeSpace, "Buyer and Seller must confirm the same basket")
+	}
+
+	if !EqualPegHashes(oldNegotiation.GetSwapPegHashes(), negotiation.GetSwapPegHashes()) {
+		return ErrInvalidBasket(DefaultCodeSpace, "Buyer and Seller must confirm the same swap")
+	}
+
+	if oldNegotiation.GetSettlementDenom() != negotiation.GetSettlementDenom() {
+		return ErrInvalidSettlement(DefaultCodeSpace, "Buyer and Seller must confirm the same settlement denomination")
+	}
+
 	oldNegotiation.SetTime(negotiation.GetTime())
 
 	if negotiation.GetSellerSignature() != nil {
 		oldNegotiation.SetSellerBlockHeight(ctx.BlockHeight())
 		oldNegotiation.SetSellerContractHash(negotiation.GetSellerContractHash())
-		signBytes := NewSignNegotiationBody(negotiation.GetBuyerAddress(), negotiation.GetSellerAddress(), negotiation.GetPegHash(), negotiation.GetBid(), negotiation.GetTime()).GetSignBytes()
+		signBytes := NewSignNegotiationBody(negotiation.GetBuyerAddress(), negotiation.GetSellerAddress(), negotiation.GetPegHash(), negotiation.GetPegHashes(), negotiation.GetSwapPegHashes(), negotiation.GetSettlementDenom(), negotiation.GetBid(), negotiation.GetTime()).GetSignBytes()
 		account := negotiationKeeper.GetNegotiatorAccount(ctx, negotiation.GetSellerAddress())
 
 		if !VerifySignature(account.GetPubKey(), negotiation.GetSellerSignature(), signBytes) {
@@ -137,7 +201,7 @@ func confirmNegotiationBid(ctx cTypes.Context, negotiationKeeper Keeper, negotia
 	if negotiation.GetBuyerSignature() != nil {
 		oldNegotiation.SetBuyerBlockHeight(ctx.BlockHeight())
 		oldNegotiation.SetBuyerContractHash(negotiation.GetBuyerContractHash())
-		signBytes := NewSignNegotiationBody(negotiation.GetBuyerAddress(), negotiation.GetSellerAddress(), negotiation.GetPegHash(), negotiation.GetBid(), negotiation.GetTime()).GetSignBytes()
+		signBytes := NewSignNegotiationBody(negotiation.GetBuyerAddress(), negotiation.GetSellerAddress(), negotiation.GetPegHash(), negotiation.GetPegHashes(), negotiation.GetSwapPegHashes(), negotiation.GetSettlementDenom(), negotiation.GetBid(), negotiation.GetTime()).GetSignBytes()
 		account := negotiationKeeper.GetNegotiatorAccount(ctx, negotiation.GetBuyerAddress())
 
 		if !VerifySignature(account.GetPubKey(), negotiation.GetBuyerSignature(), signBytes) {
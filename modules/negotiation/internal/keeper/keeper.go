@@ -15,24 +15,139 @@ import (
 type Keeper struct {
 	storeKey      cTypes.StoreKey
 	accountKeeper auth.AccountKeeper
+	aclKeeper     negTypes.ACLKeeper
 	cdc           *codec.Codec
 }
 
-func NewKeeper(storeKey cTypes.StoreKey, ak auth.AccountKeeper, cdc *codec.Codec) Keeper {
+func NewKeeper(storeKey cTypes.StoreKey, ak auth.AccountKeeper, aclKeeper negTypes.ACLKeeper, cdc *codec.Codec) Keeper {
 	return Keeper{
 		storeKey:      storeKey,
 		accountKeeper: ak,
+		aclKeeper:     aclKeeper,
 		cdc:           cdc,
 	}
 }
 
+// CheckKYC : fails when the kyc of the buyer or the seller of the negotiation expired
+func (k Keeper) CheckKYC(ctx cTypes.Context, negotiation negTypes.Negotiation) cTypes.Error {
+	if err := k.aclKeeper.CheckKYC(ctx, negotiation.GetBuyerAddress()); err != nil {
+		return err
+	}
+	return k.aclKeeper.CheckKYC(ctx, negotiation.GetSellerAddress())
+}
+
+// CheckCounterparty : fails when the buyer or the seller of the negotiation is blocked from trading with the other
+func (k Keeper) CheckCounterparty(ctx cTypes.Context, negotiation negTypes.Negotiation) cTypes.Error {
+	return k.aclKeeper.CheckCounterparty(ctx, negotiation.GetBuyerAddress(), negotiation.GetSellerAddress())
+}
+
+// CheckBid : fails when the bid of the negotiation exceeds the maximum bid of the party changing or confirming it,
+// the limits of the counterparty are checked on its own side
+func (k Keeper) CheckBid(ctx cTypes.Context, party cTypes.AccAddress, negotiation negTypes.Negotiation) cTypes.Error {
+	return k.aclKeeper.CheckBid(ctx, party, negotiation.GetBid())
+}
+
+// OpenNegotiation : counts the negotiation against the open negotiations of a party once it signs, until the other
+// party signs it too
+func (k Keeper) OpenNegotiation(ctx cTypes.Context, negotiation negTypes.Negotiation) cTypes.Error {
+	var buyerSigned, sellerSigned bool
+	if oldNegotiation, err := k.GetNegotiation(ctx, negotiation.GetNegotiationID()); err == nil {
+		buyerSigned, sellerSigned = oldNegotiation.GetBuyerSignature() != nil, oldNegotiation.GetSellerSignature() != nil
+	}
+	if buyerSigned && sellerSigned {
+		return nil
+	}
+
+	buyerSigns := !buyerSigned && negotiation.GetBuyerSignature() != nil
+	sellerSigns := !sellerSigned && negotiation.GetSellerSignature() != nil
+	switch {
+	case (buyerSigned || buyerSigns) && (sellerSigned || sellerSigns):
+		if buyerSigned {
+			k.aclKeeper.CloseNegotiation(ctx, negotiation.GetBuyerAddress())
+		}
+		if sellerSigned {
+			k.aclKeeper.CloseNegotiation(ctx, negotiation.GetSellerAddress())
+		}
+	case buyerSigns:
+		return k.aclKeeper.OpenNegotiation(ctx, negotiation.GetBuyerAddress())
+	case sellerSigns:
+		return k.aclKeeper.OpenNegotiation(ctx, negotiation.GetSellerAddress())
+	}
+	return nil
+}
+
+// ReleaseNegotiation : withdraws the signature of a negotiation signed by one party only and stops counting it against
+// the open negotiations of that party, a negotiation both parties signed counts against neither
+func (k Keeper) ReleaseNegotiation(ctx cTypes.Context, negotiation negTypes.Negotiation) {
+	party, _ := negTypes.GetSoleSigner(negotiation)
+	if party == nil {
+		return
+	}
+
+	k.aclKeeper.CloseNegotiation(ctx, party)
+	negTypes.WithdrawSignature(negotiation, party)
+	k.SetNegotiation(ctx, negotiation)
+}
+
+// CancelNegotiation : the party withdraws its signature from a negotiation its counterparty has not signed yet
+func (k Keeper) CancelNegotiation(ctx cTypes.Context, cancelNegotiation negTypes.CancelNegotiation) cTypes.Error {
+	negotiation, err := k.GetNegotiation(ctx, cancelNegotiation.NegotiationID)
+	if err != nil {
+		return err
+	}
+
+	if party, _ := negTypes.GetSoleSigner(negotiation); party == nil || !party.Equals(cancelNegotiation.Party) {
+		return negTypes.ErrUnauthorized(negTypes.DefaultCodeSpace)
+	}
+	k.ReleaseNegotiation(ctx, negotiation)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(negTypes.EventTypeCancelNegotiation,
+			cTypes.NewAttribute(negTypes.AttributeKeyNegotiationID, negotiation.GetNegotiationID().String()),
+			cTypes.NewAttribute(negTypes.AttributeKeyParty, cancelNegotiation.Party.String()),
+		))
+	return nil
+}
+
+// ExpireNegotiations : releases negotiations whose only signature expired before the counterparty signed
+func (k Keeper) ExpireNegotiations(ctx cTypes.Context) {
+	var expiredNegotiations []negTypes.Negotiation
+	k.IterateExpiredNegotiations(ctx, ctx.BlockHeight(), func(negotiation negTypes.Negotiation) (stop bool) {
+		expiredNegotiations = append(expiredNegotiations, negotiation)
+		return false
+	},
+	)
+
+	for _, negotiation := range expiredNegotiations {
+		party, _ := negTypes.GetSoleSigner(negotiation)
+		k.ReleaseNegotiation(ctx, negotiation)
+
+		ctx.EventManager().EmitEvent(
+			cTypes.NewEvent(negTypes.EventTypeExpireNegotiation,
+				cTypes.NewAttribute(negTypes.AttributeKeyNegotiationID, negotiation.GetNegotiationID().String()),
+				cTypes.NewAttribute(negTypes.AttributeKeyParty, party.String()),
+			))
+	}
+}
+
 // negotiation/{0x01}/{buyerAddress+sellerAddress+pegHash} => negotiation
+// negotiation/{0x02}/{expiryHeight}/{negotiationID} => nil, while one party only signed
 func (k Keeper) SetNegotiation(ctx cTypes.Context, negotiation negTypes.Negotiation) {
 	store := ctx.KVStore(k.storeKey)
 
+	if oldNegotiation, err := k.GetNegotiation(ctx, negotiation.GetNegotiationID()); err == nil {
+		if party, expiryHeight := negTypes.GetSoleSigner(oldNegotiation); party != nil {
+			store.Delete(negTypes.GetNegotiationExpiryKey(expiryHeight, oldNegotiation.GetNegotiationID()))
+		}
+	}
+
 	negotiationKey := negTypes.GetNegotiationKey(negotiation.GetNegotiationID())
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(negotiation)
 	store.Set(negotiationKey, bz)
+
+	if party, expiryHeight := negTypes.GetSoleSigner(negotiation); party != nil {
+		store.Set(negTypes.GetNegotiationExpiryKey(expiryHeight, negotiation.GetNegotiationID()), []byte{})
+	}
 }
 
 // returns negotiation by negotiationID
@@ -74,6 +189,28 @@ func (k Keeper) IterateNegotiations(ctx cTypes.Context, handler func(negotiation
 	}
 }
 
+// IterateExpiredNegotiations : negotiations signed by one party only whose signature expired before the height,
+// soonest first
+func (k Keeper) IterateExpiredNegotiations(ctx cTypes.Context, height int64,
+	handler func(negotiation negTypes.Negotiation) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(negTypes.NegotiationExpiryKey, negTypes.GetNegotiationExpiryHeightKey(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		negotiationID := negTypes.NegotiationID(iterator.Key()[len(negTypes.NegotiationExpiryKey)+8:])
+		negotiation, err := k.GetNegotiation(ctx, negotiationID)
+		if err != nil {
+			continue
+		}
+		if handler(negotiation) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetNegotiatorAccount(ctx cTypes.Context, address cTypes.AccAddress) auth.Account {
 	account := k.accountKeeper.GetAccount(ctx, address)
 	return account
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/types"
+
+	negTypes "github.com/commitHub/commitBlockchain/modules/negotiation/internal/types"
+)
+
+// testACLKeeper : open negotiations and maximum bids of accounts
+type testACLKeeper struct {
+	openNegotiations map[string]int64
+	maxBids          map[string]int64
+}
+
+func (k testACLKeeper) CheckKYC(_ cTypes.Context, _ cTypes.AccAddress) cTypes.Error { return nil }
+
+func (k testACLKeeper) CheckCounterparty(_ cTypes.Context, _ cTypes.AccAddress, _ cTypes.AccAddress) cTypes.Error {
+	return nil
+}
+
+func (k testACLKeeper) CheckBid(_ cTypes.Context, address cTypes.AccAddress, bid int64) cTypes.Error {
+	if maxBid, found := k.maxBids[address.String()]; found && bid > maxBid {
+		return cTypes.ErrInternal("bid exceeds the maximum bid")
+	}
+	return nil
+}
+
+func (k testACLKeeper) OpenNegotiation(_ cTypes.Context, address cTypes.AccAddress) cTypes.Error {
+	k.openNegotiations[address.String()]++
+	return nil
+}
+
+func (k testACLKeeper) CloseNegotiation(_ cTypes.Context, address cTypes.AccAddress) {
+	k.openNegotiations[address.String()]--
+}
+
+func setupTestInput(t *testing.T) (cTypes.Context, Keeper, testACLKeeper) {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(negTypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	negTypes.RegisterCodec(cdc)
+
+	aclKeeper := testACLKeeper{map[string]int64{}, map[string]int64{}}
+	k := NewKeeper(key, auth.AccountKeeper{}, aclKeeper, cdc)
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	return ctx, k, aclKeeper
+}
+
+func TestOpenNegotiationCountsSigningParties(t *testing.T) {
+	ctx, k, aclKeeper := setupTestInput(t)
+
+	buyer, seller := cTypes.AccAddress([]byte("buyer")), cTypes.AccAddress([]byte("seller"))
+	negotiation := negTypes.NewNegotiation(buyer, seller, types.PegHash([]byte("asset")))
+	_ = negotiation.SetBid(100)
+
+	// changing the bid is not signing it
+	require.Nil(t, k.OpenNegotiation(ctx, negotiation))
+	k.SetNegotiation(ctx, negotiation)
+	require.Empty(t, aclKeeper.openNegotiations)
+
+	// the seller signs first, only the seller waits on the counterparty
+	sellerConfirmation := negTypes.NewNegotiation(buyer, seller, types.PegHash([]byte("asset")))
+	_ = sellerConfirmation.SetSellerSignature(negTypes.Signature("seller"))
+	require.Nil(t, k.OpenNegotiation(ctx, sellerConfirmation))
+	require.Equal(t, map[string]int64{seller.String(): 1}, aclKeeper.openNegotiations)
+	_ = negotiation.SetSellerSignature(negTypes.Signature("seller"))
+	k.SetNegotiation(ctx, negotiation)
+
+	// the buyer signs too, the negotiation is no longer open for either
+	buyerConfirmation := negTypes.NewNegotiation(buyer, seller, types.PegHash([]byte("asset")))
+	_ = buyerConfirmation.SetBuyerSignature(negTypes.Signature("buyer"))
+	require.Nil(t, k.OpenNegotiation(ctx, buyerConfirmation))
+	require.Equal(t, map[string]int64{seller.String(): 0}, aclKeeper.openNegotiations)
+}
+
+func TestCheckBidOfTheParty(t *testing.T) {
+	ctx, k, aclKeeper := setupTestInput(t)
+
+	buyer, seller := cTypes.AccAddress([]byte("buyer")), cTypes.AccAddress([]byte("seller"))
+	aclKeeper.maxBids[seller.String()] = 50
+	negotiation := negTypes.NewNegotiation(buyer, seller, types.PegHash([]byte("asset")))
+	_ = negotiation.SetBid(100)
+
+	// the limit of the seller does not stop the buyer from bidding
+	require.Nil(t, k.CheckBid(ctx, buyer, negotiation))
+	require.NotNil(t, k.CheckBid(ctx, seller, negotiation))
+}
+
+func TestReleaseNegotiationsNotCountersigned(t *testing.T) {
+	ctx, k, aclKeeper := setupTestInput(t)
+
+	buyer, seller := cTypes.AccAddress([]byte("buyer")), cTypes.AccAddress([]byte("seller"))
+	sign := func(pegHash string, height int64) negTypes.Negotiation {
+		negotiation := negTypes.NewNegotiation(buyer, seller, types.PegHash([]byte(pegHash)))
+		_ = negotiation.SetTime(10)
+		_ = negotiation.SetBuyerSignature(negTypes.Signature("buyer"))
+		require.Nil(t, k.OpenNegotiation(ctx.WithBlockHeight(height), negotiation))
+		_ = negotiation.SetBuyerBlockHeight(height)
+		k.SetNegotiation(ctx, negotiation)
+		return negotiation
+	}
+	expiring, cancelled := sign("expiring", 1), sign("cancelled", 5)
+	require.Equal(t, int64(2), aclKeeper.openNegotiations[buyer.String()])
+
+	// only the party that signed alone cancels
+	require.NotNil(t, k.CancelNegotiation(ctx, negTypes.NewCancelNegotiation(seller, cancelled.GetNegotiationID())))
+	require.Nil(t, k.CancelNegotiation(ctx, negTypes.NewCancelNegotiation(buyer, cancelled.GetNegotiationID())))
+	require.Equal(t, int64(1), aclKeeper.openNegotiations[buyer.String()])
+	negotiation, err := k.GetNegotiation(ctx, cancelled.GetNegotiationID())
+	require.Nil(t, err)
+	require.Nil(t, negotiation.GetBuyerSignature())
+	require.NotNil(t, k.CancelNegotiation(ctx, negTypes.NewCancelNegotiation(buyer, cancelled.GetNegotiationID())))
+
+	// the signature holds until the height its time runs out after
+	k.ExpireNegotiations(ctx.WithBlockHeight(11))
+	require.Equal(t, int64(1), aclKeeper.openNegotiations[buyer.String()])
+	k.ExpireNegotiations(ctx.WithBlockHeight(12))
+	require.Equal(t, int64(0), aclKeeper.openNegotiations[buyer.String()])
+	negotiation, err = k.GetNegotiation(ctx, expiring.GetNegotiationID())
+	require.Nil(t, err)
+	require.Nil(t, negotiation.GetBuyerSignature())
+
+	// a countersigned negotiation neither expires nor releases again
+	countersigned := sign("countersigned", 1)
+	_ = countersigned.SetSellerSignature(negTypes.Signature("seller"))
+	require.Nil(t, k.OpenNegotiation(ctx, countersigned))
+	k.SetNegotiation(ctx, countersigned)
+	require.Equal(t, int64(0), aclKeeper.openNegotiations[buyer.String()])
+	k.ExpireNegotiations(ctx.WithBlockHeight(100))
+	k.ReleaseNegotiation(ctx, countersigned)
+	require.Equal(t, int64(0), aclKeeper.openNegotiations[buyer.String()])
+	negotiation, err = k.GetNegotiation(ctx, countersigned.GetNegotiationID())
+	require.Nil(t, err)
+	require.NotNil(t, negotiation.GetBuyerSignature())
+}
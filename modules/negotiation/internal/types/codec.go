@@ -9,6 +9,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgChangeSellerBids{}, "commit-blockchain/MsgChangeSellerBids", nil)
 	cdc.RegisterConcrete(MsgConfirmBuyerBids{}, "commit-blockchain/MsgConfirmBuyerBids", nil)
 	cdc.RegisterConcrete(MsgConfirmSellerBids{}, "commit-blockchain/MsgConfirmSellerBids", nil)
+	cdc.RegisterConcrete(MsgCancelNegotiations{}, "commit-blockchain/MsgCancelNegotiations", nil)
 }
 
 var ModuleCdc *codec.Codec
@@ -13,6 +13,8 @@ const (
 	CodeUnauthorized         cTypes.CodeType = 603
 	CodeInvalidInputsOutputs cTypes.CodeType = 604
 	CodeNegativeAmount       cTypes.CodeType = 605
+	CodeInvalidBasket        cTypes.CodeType = 606
+	CodeInvalidSettlement    cTypes.CodeType = 607
 )
 
 func ErrInvalidNegotiationID(codespace cTypes.CodespaceType, msg string) cTypes.Error {
@@ -51,3 +53,17 @@ func ErrNegativeAmount(codeSpace cTypes.CodespaceType, msg string) cTypes.Error
 	}
 	return cTypes.NewError(codeSpace, CodeNegativeAmount, "Amount should not be zero")
 }
+
+func ErrInvalidBasket(codeSpace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codeSpace, CodeInvalidBasket, msg)
+	}
+	return cTypes.NewError(codeSpace, CodeInvalidBasket, "invalid basket")
+}
+
+func ErrInvalidSettlement(codeSpace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codeSpace, CodeInvalidSettlement, msg)
+	}
+	return cTypes.NewError(codeSpace, CodeInvalidSettlement, "invalid settlement denomination")
+}
@@ -3,6 +3,8 @@ package types
 var (
 	EventTypeChangeNegotiationBid  = "changeNegotiation"
 	EventTypeConfirmNegotiationBid = "confirmNegotiation"
+	EventTypeCancelNegotiation     = "cancelNegotiation"
+	EventTypeExpireNegotiation     = "expireNegotiation"
 
 	AttributeKeyNegotiationID = "negotiationID"
 
@@ -10,4 +12,6 @@ var (
 	AttributeKeySellerAddress = "sellerAddress"
 
 	AttributeKeyPegHash = "pegHash"
+
+	AttributeKeyParty = "party"
 )
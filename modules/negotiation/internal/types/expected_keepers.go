@@ -0,0 +1,13 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ACLKeeper interface {
+	CheckKYC(ctx cTypes.Context, address cTypes.AccAddress) cTypes.Error
+	CheckCounterparty(ctx cTypes.Context, address cTypes.AccAddress, counterparty cTypes.AccAddress) cTypes.Error
+	CheckBid(ctx cTypes.Context, address cTypes.AccAddress, bid int64) cTypes.Error
+	OpenNegotiation(ctx cTypes.Context, address cTypes.AccAddress) cTypes.Error
+	CloseNegotiation(ctx cTypes.Context, address cTypes.AccAddress)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	ModuleName   = "negotiation"
 	StoreKey     = ModuleName
@@ -8,9 +10,21 @@ const (
 )
 
 var (
-	NegotiationKey = []byte{0x01}
+	NegotiationKey       = []byte{0x01}
+	NegotiationExpiryKey = []byte{0x02}
 )
 
 func GetNegotiationKey(id NegotiationID) NegotiationID {
 	return append(NegotiationKey, id.Bytes()...)
 }
+
+// GetNegotiationExpiryKey : negotiations signed by one party only, by the height the signature expires after
+func GetNegotiationExpiryKey(expiryHeight int64, id NegotiationID) []byte {
+	return append(GetNegotiationExpiryHeightKey(expiryHeight), id.Bytes()...)
+}
+
+func GetNegotiationExpiryHeightKey(expiryHeight int64) []byte {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(expiryHeight))
+	return append(NegotiationExpiryKey, height...)
+}
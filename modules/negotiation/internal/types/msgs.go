@@ -39,7 +39,13 @@ func (in ChangeBid) ValidateBasic() cTypes.Error {
 	} else if in.Negotiation.GetBid() < 0 {
 		return ErrNegativeAmount(DefaultCodeSpace, "Bid should not e negative.")
 	}
-	return nil
+	if err := ValidateBasket(in.Negotiation.GetPegHash(), in.Negotiation.GetPegHashes()); err != nil {
+		return err
+	}
+	if err := ValidateSwap(GetNegotiationPegHashes(in.Negotiation), in.Negotiation.GetSwapPegHashes()); err != nil {
+		return err
+	}
+	return ValidateSettlementDenom(in.Negotiation.GetSettlementDenom(), in.Negotiation.GetSwapPegHashes())
 }
 
 // MsgChangeBuyerBids : high level change bid of negotiation module
@@ -206,7 +212,13 @@ func (in ConfirmBid) ValidateBasic() cTypes.Error {
 	} else if in.Negotiation.GetBid() < 0 {
 		return ErrNegativeAmount(DefaultCodeSpace, "Bid should not e negative.")
 	}
-	return nil
+	if err := ValidateBasket(in.Negotiation.GetPegHash(), in.Negotiation.GetPegHashes()); err != nil {
+		return err
+	}
+	if err := ValidateSwap(GetNegotiationPegHashes(in.Negotiation), in.Negotiation.GetSwapPegHashes()); err != nil {
+		return err
+	}
+	return ValidateSettlementDenom(in.Negotiation.GetSettlementDenom(), in.Negotiation.GetSwapPegHashes())
 }
 
 // MsgConfirmBuyerBids :
@@ -346,3 +358,100 @@ func BuildMsgConfirmSellerBid(negotiation Negotiation) cTypes.Msg {
 }
 
 // #####MsgSellerBids
+
+// #######MsgCancelNegotiations
+
+// CancelNegotiation : the party withdraws its signature from a negotiation its counterparty has not signed
+type CancelNegotiation struct {
+	Party         cTypes.AccAddress `json:"party"`
+	NegotiationID NegotiationID     `json:"negotiationID"`
+}
+
+// NewCancelNegotiation : initializer
+func NewCancelNegotiation(party cTypes.AccAddress, negotiationID NegotiationID) CancelNegotiation {
+	return CancelNegotiation{party, negotiationID}
+}
+
+// GetSignBytes : get bytes to sign
+func (in CancelNegotiation) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(in)
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in CancelNegotiation) ValidateBasic() cTypes.Error {
+	if len(in.Party) == 0 {
+		return cTypes.ErrInvalidAddress(in.Party.String())
+	} else if len(in.NegotiationID) == 0 {
+		return ErrInvalidNegotiationID(DefaultCodeSpace, "NegotiationID should not be empty.")
+	}
+	return nil
+}
+
+// MsgCancelNegotiations : withdraws signatures from negotiations not yet countersigned
+type MsgCancelNegotiations struct {
+	CancelNegotiations []CancelNegotiation `json:"cancelNegotiations"`
+}
+
+// NewMsgCancelNegotiations : initializer
+func NewMsgCancelNegotiations(cancelNegotiations []CancelNegotiation) MsgCancelNegotiations {
+	return MsgCancelNegotiations{cancelNegotiations}
+}
+
+var _ cTypes.Msg = MsgCancelNegotiations{}
+
+// Type : implements msg
+func (msg MsgCancelNegotiations) Type() string { return "cancelNegotiations" }
+
+// ValidateBasic : implements msg
+func (msg MsgCancelNegotiations) ValidateBasic() cTypes.Error {
+	if len(msg.CancelNegotiations) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.CancelNegotiations {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgCancelNegotiations) GetSignBytes() []byte {
+	var cancelNegotiations []json.RawMessage
+	for _, cancelNegotiation := range msg.CancelNegotiations {
+		cancelNegotiations = append(cancelNegotiations, cancelNegotiation.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		CancelNegotiations []json.RawMessage `json:"cancelNegotiations"`
+	}{
+		CancelNegotiations: cancelNegotiations,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgCancelNegotiations) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.CancelNegotiations))
+	for i, in := range msg.CancelNegotiations {
+		addrs[i] = in.Party
+	}
+	return addrs
+}
+
+func (msg MsgCancelNegotiations) Route() string {
+	return RouterKey
+}
+
+// BuildMsgCancelNegotiation : build the MsgCancelNegotiations
+func BuildMsgCancelNegotiation(party cTypes.AccAddress, negotiationID NegotiationID) cTypes.Msg {
+	return NewMsgCancelNegotiations([]CancelNegotiation{NewCancelNegotiation(party, negotiationID)})
+}
+
+// #####MsgCancelNegotiations
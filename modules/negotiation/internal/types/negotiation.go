@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"regexp"
 
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/common"
@@ -27,6 +28,15 @@ type Negotiation interface {
 	GetPegHash() types.PegHash
 	SetPegHash(types.PegHash) error
 
+	GetPegHashes() []types.PegHash
+	SetPegHashes([]types.PegHash) error
+
+	GetSwapPegHashes() []types.PegHash
+	SetSwapPegHashes([]types.PegHash) error
+
+	GetSettlementDenom() string
+	SetSettlementDenom(string) error
+
 	GetBid() int64
 	SetBid(int64) error
 
@@ -60,6 +70,9 @@ type BaseNegotiation struct {
 	BuyerAddress       cTypes.AccAddress `json:"buyerAddress" `
 	SellerAddress      cTypes.AccAddress `json:"sellerAddress" `
 	PegHash            types.PegHash     `json:"pegHash"`
+	PegHashes          []types.PegHash   `json:"pegHashes"`
+	SwapPegHashes      []types.PegHash   `json:"swapPegHashes"`
+	SettlementDenom    string            `json:"settlementDenom"`
 	Bid                int64             `json:"bid"`
 	Time               int64             `json:"time"`
 	BuyerSignature     Signature         `json:"buyerSignature"`
@@ -149,6 +162,39 @@ func (baseNegotiation *BaseNegotiation) SetPegHash(pegHash types.PegHash) error
 	return nil
 }
 
+// GetPegHashes : getter
+func (baseNegotiation BaseNegotiation) GetPegHashes() []types.PegHash {
+	return baseNegotiation.PegHashes
+}
+
+// SetPegHashes : setter
+func (baseNegotiation *BaseNegotiation) SetPegHashes(pegHashes []types.PegHash) error {
+	baseNegotiation.PegHashes = pegHashes
+	return nil
+}
+
+// GetSwapPegHashes : getter
+func (baseNegotiation BaseNegotiation) GetSwapPegHashes() []types.PegHash {
+	return baseNegotiation.SwapPegHashes
+}
+
+// SetSwapPegHashes : setter
+func (baseNegotiation *BaseNegotiation) SetSwapPegHashes(swapPegHashes []types.PegHash) error {
+	baseNegotiation.SwapPegHashes = swapPegHashes
+	return nil
+}
+
+// GetSettlementDenom : getter
+func (baseNegotiation BaseNegotiation) GetSettlementDenom() string {
+	return baseNegotiation.SettlementDenom
+}
+
+// SetSettlementDenom : setter
+func (baseNegotiation *BaseNegotiation) SetSettlementDenom(settlementDenom string) error {
+	baseNegotiation.SettlementDenom = settlementDenom
+	return nil
+}
+
 // GetBid : getter
 func (baseNegotiation BaseNegotiation) GetBid() int64 { return baseNegotiation.Bid }
 
@@ -245,21 +291,128 @@ func NewNegotiation(buyerAddress, sellerAddress cTypes.AccAddress, pegHash types
 	}
 }
 
+// GetNegotiationPegHashes : asset pegs traded by the negotiation, the basket if there is one
+func GetNegotiationPegHashes(negotiation Negotiation) []types.PegHash {
+	if len(negotiation.GetPegHashes()) != 0 {
+		return negotiation.GetPegHashes()
+	}
+	return []types.PegHash{negotiation.GetPegHash()}
+}
+
+// IsBasket : true if the negotiation trades a basket of asset pegs at one price
+func IsBasket(negotiation Negotiation) bool {
+	return len(negotiation.GetPegHashes()) > 1
+}
+
+// ValidateBasket : a basket lists its key peg hash and at least one more, without duplicates
+func ValidateBasket(pegHash types.PegHash, pegHashes []types.PegHash) cTypes.Error {
+	if len(pegHashes) == 0 {
+		return nil
+	}
+	if len(pegHashes) < 2 {
+		return ErrInvalidBasket(DefaultCodeSpace, "Basket should have at least two peg hashes.")
+	}
+	containsPegHash := false
+	for i, basketPegHash := range pegHashes {
+		if len(basketPegHash) == 0 {
+			return ErrInvalidBasket(DefaultCodeSpace, "Basket peg hash should not be empty.")
+		}
+		if basketPegHash.String() == pegHash.String() {
+			containsPegHash = true
+		}
+		for _, otherPegHash := range pegHashes[i+1:] {
+			if basketPegHash.String() == otherPegHash.String() {
+				return ErrInvalidBasket(DefaultCodeSpace, fmt.Sprintf("Duplicate peg hash %s in basket.", basketPegHash.String()))
+			}
+		}
+	}
+	if !containsPegHash {
+		return ErrInvalidBasket(DefaultCodeSpace, "Basket should contain the negotiation peg hash.")
+	}
+	return nil
+}
+
+// IsSwap : true if the buyer pays with asset pegs, the bid being an optional fiat balancing payment
+func IsSwap(negotiation Negotiation) bool {
+	return len(negotiation.GetSwapPegHashes()) != 0
+}
+
+// ValidateSwap : swap peg hashes are unique and not part of the asset pegs sold
+func ValidateSwap(pegHashes []types.PegHash, swapPegHashes []types.PegHash) cTypes.Error {
+	for i, swapPegHash := range swapPegHashes {
+		if len(swapPegHash) == 0 {
+			return ErrInvalidBasket(DefaultCodeSpace, "Swap peg hash should not be empty.")
+		}
+		for _, pegHash := range pegHashes {
+			if swapPegHash.String() == pegHash.String() {
+				return ErrInvalidBasket(DefaultCodeSpace, fmt.Sprintf("Peg hash %s is on both sides of the swap.", swapPegHash.String()))
+			}
+		}
+		for _, otherPegHash := range swapPegHashes[i+1:] {
+			if swapPegHash.String() == otherPegHash.String() {
+				return ErrInvalidBasket(DefaultCodeSpace, fmt.Sprintf("Duplicate peg hash %s in swap.", swapPegHash.String()))
+			}
+		}
+	}
+	return nil
+}
+
+var reSettlementDenom = regexp.MustCompile(`^[a-z][a-z0-9]{2,15}$`)
+
+// IsCoinSettled : true if the bid is paid in coins of the settlement denomination instead of fiat pegs
+func IsCoinSettled(negotiation Negotiation) bool {
+	return negotiation.GetSettlementDenom() != ""
+}
+
+// ValidateSettlementDenom : an empty denomination settles in fiat pegs, swap balancing payments are always fiat
+func ValidateSettlementDenom(settlementDenom string, swapPegHashes []types.PegHash) cTypes.Error {
+	if settlementDenom == "" {
+		return nil
+	}
+	if !reSettlementDenom.MatchString(settlementDenom) {
+		return ErrInvalidSettlement(DefaultCodeSpace, fmt.Sprintf("Invalid settlement denomination %s.", settlementDenom))
+	}
+	if len(swapPegHashes) != 0 {
+		return ErrInvalidSettlement(DefaultCodeSpace, "Swap balancing payments are settled in fiat pegs.")
+	}
+	return nil
+}
+
+// EqualPegHashes : true if both lists hold the same peg hashes in the same order
+func EqualPegHashes(pegHashes, otherPegHashes []types.PegHash) bool {
+	if len(pegHashes) != len(otherPegHashes) {
+		return false
+	}
+	for i := range pegHashes {
+		if pegHashes[i].String() != otherPegHashes[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
 // SignNegotiationBody :
 type SignNegotiationBody struct {
 	BuyerAddress  cTypes.AccAddress `json:"buyerAddress"`
 	SellerAddress cTypes.AccAddress `json:"sellerAddress"`
 	PegHash       types.PegHash     `json:"pegHash"`
+	PegHashes     []types.PegHash   `json:"pegHashes,omitempty"`
+	SwapPegHashes []types.PegHash   `json:"swapPegHashes,omitempty"`
+	Denom         string            `json:"settlementDenom,omitempty"`
 	Bid           int64             `json:"bid"`
 	Time          int64             `json:"time"`
 }
 
 // NewSignNegotiationBody :
-func NewSignNegotiationBody(buyerAddress, sellerAddress cTypes.AccAddress, peghash types.PegHash, bid, time int64) *SignNegotiationBody {
+func NewSignNegotiationBody(buyerAddress, sellerAddress cTypes.AccAddress, peghash types.PegHash, pegHashes []types.PegHash,
+	swapPegHashes []types.PegHash, settlementDenom string, bid, time int64) *SignNegotiationBody {
 	return &SignNegotiationBody{
 		BuyerAddress:  buyerAddress,
 		SellerAddress: sellerAddress,
 		PegHash:       peghash,
+		PegHashes:     pegHashes,
+		SwapPegHashes: swapPegHashes,
+		Denom:         settlementDenom,
 		Bid:           bid,
 		Time:          time,
 	}
@@ -282,3 +435,30 @@ func GetNegotiationIDFromString(negotiationIDStr string) (negotiationID Negotiat
 	}
 	return NegotiationID(bz), nil
 }
+
+// GetSoleSigner : the party that signed the negotiation while its counterparty has not, and the height its signature
+// expires after, nil if neither or both signed
+func GetSoleSigner(negotiation Negotiation) (cTypes.AccAddress, int64) {
+	buyerSigned, sellerSigned := negotiation.GetBuyerSignature() != nil, negotiation.GetSellerSignature() != nil
+	switch {
+	case buyerSigned && !sellerSigned:
+		return negotiation.GetBuyerAddress(), negotiation.GetTime() + negotiation.GetBuyerBlockHeight()
+	case sellerSigned && !buyerSigned:
+		return negotiation.GetSellerAddress(), negotiation.GetTime() + negotiation.GetSellerBlockHeight()
+	}
+	return nil, 0
+}
+
+// WithdrawSignature : drops the signature of the party, leaving the negotiation open to change again
+func WithdrawSignature(negotiation Negotiation, party cTypes.AccAddress) {
+	if party.Equals(negotiation.GetBuyerAddress()) {
+		_ = negotiation.SetBuyerSignature(nil)
+		_ = negotiation.SetBuyerBlockHeight(0)
+		_ = negotiation.SetBuyerContractHash("")
+	}
+	if party.Equals(negotiation.GetSellerAddress()) {
+		_ = negotiation.SetSellerSignature(nil)
+		_ = negotiation.SetSellerBlockHeight(0)
+		_ = negotiation.SetSellerContractHash("")
+	}
+}
@@ -61,6 +61,7 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		cli.ChangeSellerBidCmd(cdc),
 		cli.ConfirmBuyerBidCmd(cdc),
 		cli.ConfirmSellerBidCmd(cdc),
+		cli.CancelNegotiationCmd(cdc),
 	)...)
 
 	return negotiationTxCmd
@@ -127,6 +128,7 @@ func (am AppModule) ExportGenesis(ctx cTypes.Context) json.RawMessage {
 
 func (AppModule) BeginBlock(cTypes.Context, abci.RequestBeginBlock) {}
 
-func (AppModule) EndBlock(_ cTypes.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx cTypes.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
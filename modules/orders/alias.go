@@ -27,6 +27,11 @@ var (
 	NewKeeper         = keeper.NewKeeper
 
 	ErrUnauthorized = types.ErrUnauthorized
+
+	NewTranche            = types.NewTranche
+	GetDeliveredQuantity  = types.GetDeliveredQuantity
+	GetReleasedFiatAmount = types.GetReleasedFiatAmount
+	HasTranche            = types.HasTranche
 )
 
 type (
@@ -34,6 +39,7 @@ type (
 	Keeper       = keeper.Keeper
 	Order        = types.Order
 	BaseOrder    = types.BaseOrder
+	Tranche      = types.Tranche
 
 	ACLKeeper     = types.ACLKeeper
 	AccountKeeper = types.AccountKeeper
@@ -91,6 +91,22 @@ func (keeper Keeper) SendAssetsToOrder(ctx cTypes.Context, fromAddress cTypes.Ac
 	return nil
 }
 
+// SendBasketAssetsToOrder : escrow every asset peg of a basket into the order of its negotiation peg hash
+func (keeper Keeper) SendBasketAssetsToOrder(ctx cTypes.Context, fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, pegHash types.PegHash, assetPegWallet types.AssetPegWallet) cTypes.Error {
+	negotiationID := negotiation.NegotiationID(append(append(toAddress.Bytes(), fromAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		order = keeper.NewOrder(toAddress, fromAddress, pegHash)
+	}
+	orderAssetPegWallet := order.GetAssetPegWallet()
+	for i := range assetPegWallet {
+		orderAssetPegWallet = types.AddAssetPegToWallet(&assetPegWallet[i], orderAssetPegWallet)
+	}
+	order.SetAssetPegWallet(orderAssetPegWallet)
+	keeper.SetOrder(ctx, order)
+	return nil
+}
+
 // SendFiatsToOrder fiat pegs to order
 func (keeper Keeper) SendFiatsToOrder(ctx cTypes.Context, fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, pegHash types.PegHash, fiatPegWallet types.FiatPegWallet) cTypes.Error {
 	negotiationID := negotiation.NegotiationID(append(append(fromAddress.Bytes(), toAddress.Bytes()...), pegHash.Bytes()...))
@@ -139,6 +155,19 @@ func (keeper Keeper) SendAssetFromOrder(ctx cTypes.Context, fromAddress cTypes.A
 	return updatedAssetPegWallet
 }
 
+// SendAssetsFromOrder : release asset pegs from the order of the negotiation peg hash
+func (keeper Keeper) SendAssetsFromOrder(ctx cTypes.Context, fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, pegHash types.PegHash, assetPegWallet types.AssetPegWallet) types.AssetPegWallet {
+	negotiationID := negotiation.NegotiationID(append(append(fromAddress.Bytes(), toAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	updatedAssetPegWallet := order.GetAssetPegWallet()
+	for _, assetPeg := range assetPegWallet {
+		_, updatedAssetPegWallet = types.SubtractAssetPegFromWallet(assetPeg.GetPegHash(), updatedAssetPegWallet)
+	}
+	order.SetAssetPegWallet(updatedAssetPegWallet)
+	keeper.SetOrder(ctx, order)
+	return updatedAssetPegWallet
+}
+
 // SendFiatsFromOrder fiat pegs to seller
 func (keeper Keeper) SendFiatsFromOrder(ctx cTypes.Context, fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress, pegHash types.PegHash, fiatPegWallet types.FiatPegWallet) types.FiatPegWallet {
 	negotiationID := negotiation.NegotiationID(append(append(fromAddress.Bytes(), toAddress.Bytes()...), pegHash.Bytes()...))
@@ -148,3 +177,119 @@ func (keeper Keeper) SendFiatsFromOrder(ctx cTypes.Context, fromAddress cTypes.A
 	keeper.SetOrder(ctx, order)
 	return updatedFiatPegWallet
 }
+
+// GetOrderTranches : get the delivered tranches of an order
+func (keeper Keeper) GetOrderTranches(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash) []orderTypes.Tranche {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		return nil
+	}
+	return order.GetTranches()
+}
+
+// AddOrderTranche : record a delivered tranche on the order
+func (keeper Keeper) AddOrderTranche(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, tranche orderTypes.Tranche) {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	order.SetTranches(append(order.GetTranches(), tranche))
+	keeper.SetOrder(ctx, order)
+}
+
+// SendSwapAssetsToOrder : escrow the asset pegs the buyer swaps into the order
+func (keeper Keeper) SendSwapAssetsToOrder(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, assetPegWallet types.AssetPegWallet) cTypes.Error {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		order = keeper.NewOrder(buyerAddress, sellerAddress, pegHash)
+	}
+	swapAssetPegWallet := order.GetSwapAssetPegWallet()
+	for i := range assetPegWallet {
+		swapAssetPegWallet = types.AddAssetPegToWallet(&assetPegWallet[i], swapAssetPegWallet)
+	}
+	order.SetSwapAssetPegWallet(swapAssetPegWallet)
+	keeper.SetOrder(ctx, order)
+	return nil
+}
+
+// SendSwapAssetsFromOrder : release the swapped asset pegs from the order
+func (keeper Keeper) SendSwapAssetsFromOrder(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, assetPegWallet types.AssetPegWallet) types.AssetPegWallet {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	updatedAssetPegWallet := order.GetSwapAssetPegWallet()
+	for _, assetPeg := range assetPegWallet {
+		_, updatedAssetPegWallet = types.SubtractAssetPegFromWallet(assetPeg.GetPegHash(), updatedAssetPegWallet)
+	}
+	order.SetSwapAssetPegWallet(updatedAssetPegWallet)
+	keeper.SetOrder(ctx, order)
+	return updatedAssetPegWallet
+}
+
+// GetOrderSwapDetails : get the swapped asset pegs and the buyer's awb proof of a swap order
+func (keeper Keeper) GetOrderSwapDetails(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash) (types.AssetPegWallet, string) {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		return nil, ""
+	}
+	return order.GetSwapAssetPegWallet(), order.GetSwapAWBProofHash()
+}
+
+// SetOrderSwapAWBProofHash : Set the buyer's awb proof of a swap order
+func (keeper Keeper) SetOrderSwapAWBProofHash(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, swapAWBProofHash string) {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	order.SetSwapAWBProofHash(swapAWBProofHash)
+	keeper.SetOrder(ctx, order)
+}
+
+// SendCoinsToOrder : record the settlement coins the buyer escrowed into the order
+func (keeper Keeper) SendCoinsToOrder(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, coins cTypes.Coins) cTypes.Error {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		order = keeper.NewOrder(buyerAddress, sellerAddress, pegHash)
+	}
+	order.SetCoinWallet(order.GetCoinWallet().Add(coins))
+	keeper.SetOrder(ctx, order)
+	return nil
+}
+
+// SendCoinsFromOrder : release settlement coins from the order
+func (keeper Keeper) SendCoinsFromOrder(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash, coins cTypes.Coins) cTypes.Coins {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	updatedCoinWallet := order.GetCoinWallet().Sub(coins)
+	order.SetCoinWallet(updatedCoinWallet)
+	keeper.SetOrder(ctx, order)
+	return updatedCoinWallet
+}
+
+// GetOrderCoins : get the settlement coins escrowed in the order
+func (keeper Keeper) GetOrderCoins(ctx cTypes.Context, buyerAddress cTypes.AccAddress, sellerAddress cTypes.AccAddress, pegHash types.PegHash) cTypes.Coins {
+	negotiationID := negotiation.NegotiationID(append(append(buyerAddress.Bytes(), sellerAddress.Bytes()...), pegHash.Bytes()...))
+	order := keeper.GetOrder(ctx, negotiationID)
+	if order == nil {
+		return nil
+	}
+	return order.GetCoinWallet()
+}
+
+// GetEscrowedFiatPegWallet : fiat pegs escrowed in all orders
+func (k Keeper) GetEscrowedFiatPegWallet(ctx cTypes.Context) (fiatPegWallet types.FiatPegWallet) {
+	k.IterateOrders(ctx, func(order orderTypes.Order) (stop bool) {
+		fiatPegWallet = append(fiatPegWallet, order.GetFiatPegWallet()...)
+		return false
+	})
+	return
+}
+
+// GetEscrowedAssetPegWallet : asset pegs escrowed in all orders, swap legs included
+func (k Keeper) GetEscrowedAssetPegWallet(ctx cTypes.Context) (assetPegWallet types.AssetPegWallet) {
+	k.IterateOrders(ctx, func(order orderTypes.Order) (stop bool) {
+		assetPegWallet = append(assetPegWallet, order.GetAssetPegWallet()...)
+		assetPegWallet = append(assetPegWallet, order.GetSwapAssetPegWallet()...)
+		return false
+	})
+	return
+}
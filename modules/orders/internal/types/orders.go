@@ -1,6 +1,8 @@
 package types
 
 import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/commitHub/commitBlockchain/types"
 
 	"github.com/commitHub/commitBlockchain/modules/negotiation"
@@ -21,6 +23,18 @@ type Order interface {
 
 	GetAWBProofHash() string
 	SetAWBProofHash(string)
+
+	GetTranches() []Tranche
+	SetTranches([]Tranche)
+
+	GetSwapAssetPegWallet() types.AssetPegWallet
+	SetSwapAssetPegWallet(types.AssetPegWallet)
+
+	GetSwapAWBProofHash() string
+	SetSwapAWBProofHash(string)
+
+	GetCoinWallet() cTypes.Coins
+	SetCoinWallet(cTypes.Coins)
 }
 
 type BaseOrder struct {
@@ -29,6 +43,12 @@ type BaseOrder struct {
 	AssetPegWallet types.AssetPegWallet      `json:"asset_peg_wallet"`
 	FiatProofHash  string                    `json:"fiat_proof_hash"`
 	AWBProofHash   string                    `json:"awb_proof_hash"`
+	Tranches       []Tranche                 `json:"tranches"`
+
+	SwapAssetPegWallet types.AssetPegWallet `json:"swap_asset_peg_wallet"`
+	SwapAWBProofHash   string               `json:"swap_awb_proof_hash"`
+
+	CoinWallet cTypes.Coins `json:"coin_wallet"`
 }
 
 var _ Order = (*BaseOrder)(nil)
@@ -77,4 +97,36 @@ func (baseOrder *BaseOrder) SetAWBProofHash(awbProofHash string) {
 	baseOrder.AWBProofHash = awbProofHash
 }
 
+func (baseOrder BaseOrder) GetTranches() []Tranche {
+	return baseOrder.Tranches
+}
+
+func (baseOrder *BaseOrder) SetTranches(tranches []Tranche) {
+	baseOrder.Tranches = tranches
+}
+
+func (baseOrder BaseOrder) GetSwapAssetPegWallet() types.AssetPegWallet {
+	return baseOrder.SwapAssetPegWallet
+}
+
+func (baseOrder *BaseOrder) SetSwapAssetPegWallet(swapAssetPegWallet types.AssetPegWallet) {
+	baseOrder.SwapAssetPegWallet = swapAssetPegWallet
+}
+
+func (baseOrder BaseOrder) GetSwapAWBProofHash() string {
+	return baseOrder.SwapAWBProofHash
+}
+
+func (baseOrder *BaseOrder) SetSwapAWBProofHash(swapAWBProofHash string) {
+	baseOrder.SwapAWBProofHash = swapAWBProofHash
+}
+
+func (baseOrder BaseOrder) GetCoinWallet() cTypes.Coins {
+	return baseOrder.CoinWallet
+}
+
+func (baseOrder *BaseOrder) SetCoinWallet(coinWallet cTypes.Coins) {
+	baseOrder.CoinWallet = coinWallet
+}
+
 type OrderDecoder func(orderBytes []byte) (Order, error)
@@ -0,0 +1,46 @@
+package types
+
+// Tranche : one consignment of a split shipment, delivered against its own AWB proof
+type Tranche struct {
+	AWBProofHash string `json:"awb_proof_hash"`
+	Quantity     int64  `json:"quantity"`
+	FiatAmount   int64  `json:"fiat_amount"`
+	BlockHeight  int64  `json:"block_height"`
+}
+
+func NewTranche(awbProofHash string, quantity int64, fiatAmount int64, blockHeight int64) Tranche {
+	return Tranche{
+		AWBProofHash: awbProofHash,
+		Quantity:     quantity,
+		FiatAmount:   fiatAmount,
+		BlockHeight:  blockHeight,
+	}
+}
+
+// GetDeliveredQuantity : total quantity delivered over all tranches
+func GetDeliveredQuantity(tranches []Tranche) int64 {
+	var quantity int64
+	for _, tranche := range tranches {
+		quantity += tranche.Quantity
+	}
+	return quantity
+}
+
+// GetReleasedFiatAmount : total fiat released to the seller over all tranches
+func GetReleasedFiatAmount(tranches []Tranche) int64 {
+	var amount int64
+	for _, tranche := range tranches {
+		amount += tranche.FiatAmount
+	}
+	return amount
+}
+
+// HasTranche : true if a tranche was already delivered against the AWB proof
+func HasTranche(tranches []Tranche, awbProofHash string) bool {
+	for _, tranche := range tranches {
+		if tranche.AWBProofHash == awbProofHash {
+			return true
+		}
+	}
+	return false
+}
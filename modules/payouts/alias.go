@@ -0,0 +1,61 @@
+package payouts
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/payouts/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	StatusPending   = types.StatusPending
+	StatusConfirmed = types.StatusConfirmed
+	StatusRejected  = types.StatusRejected
+
+	QueryPayout  = keeper.QueryPayout
+	QueryPayouts = keeper.QueryPayouts
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewPayout     = types.NewPayout
+	GetPayoutKey  = types.GetPayoutKey
+	IsValidStatus = types.IsValidStatus
+
+	ErrInvalidPayout = types.ErrInvalidPayout
+	ErrPayoutSettled = types.ErrPayoutSettled
+	ErrUnauthorized  = types.ErrUnauthorized
+
+	BuildMsgConfirmPayout = types.BuildMsgConfirmPayout
+	BuildMsgRejectPayout  = types.BuildMsgRejectPayout
+
+	EventTypeRequestPayout = types.EventTypeRequestPayout
+	EventTypeConfirmPayout = types.EventTypeConfirmPayout
+	EventTypeRejectPayout  = types.EventTypeRejectPayout
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+
+	Payout = types.Payout
+
+	MsgConfirmPayouts = types.MsgConfirmPayouts
+	MsgRejectPayouts  = types.MsgRejectPayouts
+
+	ConfirmPayout = types.ConfirmPayout
+	RejectPayout  = types.RejectPayout
+)
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+func ConfirmPayoutCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Confirm a pending payout with the reference of the bank payment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := payoutTypes.BuildMsgConfirmPayout(cliCtx.GetFromAddress(), uint64(viper.GetInt64(FlagPayoutID)), viper.GetString(FlagPaymentReference))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPayoutID)
+	cmd.Flags().AddFlagSet(fsPaymentReference)
+	return cmd
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagPayoutID         = "payout-id"
+	FlagPaymentReference = "payment-reference"
+	FlagReason           = "reason"
+)
+
+var (
+	fsPayoutID         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPaymentReference = flag.NewFlagSet("", flag.ContinueOnError)
+	fsReason           = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsPayoutID.Uint64(FlagPayoutID, 0, "PayoutID of the pending payout")
+	fsPaymentReference.String(FlagPaymentReference, "", "Reference of the bank payment made to the redeemer")
+	fsReason.String(FlagReason, "", "Reason the payout is rejected")
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+func GetPayoutCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payout [payoutID]",
+		Short: "Query payout details and status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", payoutTypes.QuerierRoute, "queryPayout", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var payout payoutTypes.Payout
+			cdc.MustUnmarshalJSON(res, &payout)
+			return cliCtx.PrintOutput(payout)
+		},
+	}
+
+	return cmd
+}
+
+func GetPayoutsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payouts [status]",
+		Short: "Query payouts, optionally only the pending, confirmed or rejected ones",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", payoutTypes.QuerierRoute, "queryPayouts")
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var payouts []payoutTypes.Payout
+			cdc.MustUnmarshalJSON(res, &payouts)
+
+			output, err := cdc.MarshalJSONIndent(payouts, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
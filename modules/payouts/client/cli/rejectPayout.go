@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+func RejectPayoutCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject",
+		Short: "Reject a pending payout and return the redeemed fiat pegs to the redeemer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := payoutTypes.BuildMsgRejectPayout(cliCtx.GetFromAddress(), uint64(viper.GetInt64(FlagPayoutID)), viper.GetString(FlagReason))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPayoutID)
+	cmd.Flags().AddFlagSet(fsReason)
+	return cmd
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+func QueryPayoutRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", payoutTypes.QuerierRoute, "queryPayout", vars["payoutID"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Payout. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var payout payoutTypes.Payout
+		cliCtx.Codec.MustUnmarshalJSON(res, &payout)
+
+		rest.PostProcessResponse(w, cliCtx, payout)
+	}
+}
+
+// QueryPayoutsRequestHandlerFn : payouts, filtered by the status query parameter when given
+func QueryPayoutsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		route := fmt.Sprintf("custom/%s/%s", payoutTypes.QuerierRoute, "queryPayouts")
+		if status := r.URL.Query().Get("status"); status != "" {
+			route = fmt.Sprintf("%s/%s", route, status)
+		}
+
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Payouts. Error: %s", err.Error()))
+			return
+		}
+
+		var payouts []payoutTypes.Payout
+		cliCtx.Codec.MustUnmarshalJSON(res, &payouts)
+
+		rest.PostProcessResponse(w, cliCtx, payouts)
+	}
+}
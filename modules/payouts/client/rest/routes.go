@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/payout/{payoutID}", QueryPayoutRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/payouts", QueryPayoutsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/confirmPayout", ConfirmPayoutRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/rejectPayout", RejectPayoutRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
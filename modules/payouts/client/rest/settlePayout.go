@@ -0,0 +1,94 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+type settlePayoutReq struct {
+	BaseReq          rest.BaseReq `json:"base_req"`
+	PayoutID         string       `json:"payoutID" valid:"required~Enter the PayoutID,matches(^[1-9]{1}[0-9]*$)~Invalid PayoutID"`
+	PaymentReference string       `json:"paymentReference"`
+	Reason           string       `json:"reason"`
+	Password         string       `json:"password" valid:"required~Enter the Password"`
+	Mode             string       `json:"mode"`
+}
+
+func ConfirmPayoutRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return settlePayoutRequestHandlerFn(cliCtx, kafkaBool, kafkaState, "CFPO",
+		func(fromAddress cTypes.AccAddress, payoutID uint64, req settlePayoutReq) cTypes.Msg {
+			return payoutTypes.BuildMsgConfirmPayout(fromAddress, payoutID, req.PaymentReference)
+		})
+}
+
+func RejectPayoutRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return settlePayoutRequestHandlerFn(cliCtx, kafkaBool, kafkaState, "RJPO",
+		func(fromAddress cTypes.AccAddress, payoutID uint64, req settlePayoutReq) cTypes.Msg {
+			return payoutTypes.BuildMsgRejectPayout(fromAddress, payoutID, req.Reason)
+		})
+}
+
+func settlePayoutRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState, ticketPrefix string,
+	buildMsg func(cTypes.AccAddress, uint64, settlePayoutReq) cTypes.Msg) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req settlePayoutReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(payoutTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		payoutID, err := strconv.ParseUint(req.PayoutID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		msg := buildMsg(fromAddr, payoutID, req)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator(ticketPrefix)
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package payouts
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	nextPayoutID := uint64(1)
+	for _, payout := range data.Payouts {
+		keeper.SetPayout(ctx, payout)
+		if payout.PayoutID >= nextPayoutID {
+			nextPayoutID = payout.PayoutID + 1
+		}
+	}
+	keeper.SetNextPayoutID(ctx, nextPayoutID)
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	payouts := keeper.GetPayouts(ctx, "")
+
+	return GenesisState{Payouts: payouts}
+}
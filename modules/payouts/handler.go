@@ -0,0 +1,48 @@
+package payouts
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgConfirmPayouts:
+			return handleMsgConfirmPayouts(ctx, k, msg)
+		case MsgRejectPayouts:
+			return handleMsgRejectPayouts(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgConfirmPayouts(ctx cTypes.Context, k Keeper, msg MsgConfirmPayouts) cTypes.Result {
+	for _, confirmPayout := range msg.ConfirmPayouts {
+		if err := k.ConfirmPayout(ctx, confirmPayout); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRejectPayouts(ctx cTypes.Context, k Keeper, msg MsgRejectPayouts) cTypes.Result {
+	for _, rejectPayout := range msg.RejectPayouts {
+		if err := k.RejectPayout(ctx, rejectPayout); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+type Keeper struct {
+	storeKey      cTypes.StoreKey
+	cdc           *codec.Codec
+	accountKeeper payoutTypes.AccountKeeper
+	aclKeeper     payoutTypes.ACLKeeper
+	reserveKeeper payoutTypes.ReserveKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, accountKeeper payoutTypes.AccountKeeper,
+	aclKeeper payoutTypes.ACLKeeper, reserveKeeper payoutTypes.ReserveKeeper) Keeper {
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		aclKeeper:     aclKeeper,
+		reserveKeeper: reserveKeeper,
+	}
+}
+
+// payouts/{0x01}/{payoutID} => payout
+func (k Keeper) SetPayout(ctx cTypes.Context, payout payoutTypes.Payout) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(payout)
+	store.Set(payoutTypes.GetPayoutKey(payout.PayoutID), bz)
+}
+
+// returns payout by payoutID
+func (k Keeper) GetPayout(ctx cTypes.Context, payoutID uint64) (payout payoutTypes.Payout, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(payoutTypes.GetPayoutKey(payoutID))
+	if bz == nil {
+		return payout, payoutTypes.ErrInvalidPayout(payoutTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &payout)
+	return payout, nil
+}
+
+// get all payouts with status, or all payouts if status is empty => []Payout from store
+func (k Keeper) GetPayouts(ctx cTypes.Context, status string) (payouts []payoutTypes.Payout) {
+	k.IteratePayouts(ctx, func(payout payoutTypes.Payout) (stop bool) {
+		if status == "" || payout.Status == status {
+			payouts = append(payouts, payout)
+		}
+		return false
+	},
+	)
+	return
+}
+
+func (k Keeper) IteratePayouts(ctx cTypes.Context, handler func(payout payoutTypes.Payout) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, payoutTypes.PayoutKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var payout payoutTypes.Payout
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &payout)
+		if handler(payout) {
+			break
+		}
+	}
+}
+
+// payouts/{0x02} => next payoutID
+func (k Keeper) SetNextPayoutID(ctx cTypes.Context, payoutID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(payoutTypes.NextPayoutIDKey, payoutTypes.GetPayoutIDBytes(payoutID))
+}
+
+func (k Keeper) GetNextPayoutID(ctx cTypes.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(payoutTypes.NextPayoutIDKey)
+	if bz == nil {
+		return 1
+	}
+	return payoutTypes.GetPayoutIDFromBytes(bz)
+}
+
+// GetEscrowedFiatPegWallet : fiat pegs held by pending payouts
+func (k Keeper) GetEscrowedFiatPegWallet(ctx cTypes.Context) (fiatPegWallet types.FiatPegWallet) {
+	for _, payout := range k.GetPayouts(ctx, payoutTypes.StatusPending) {
+		fiatPegWallet = append(fiatPegWallet, payout.FiatPegWallet...)
+	}
+	return
+}
+
+// GetEscrowedAssetPegWallet : payouts hold no asset pegs
+func (k Keeper) GetEscrowedAssetPegWallet(ctx cTypes.Context) types.AssetPegWallet {
+	return nil
+}
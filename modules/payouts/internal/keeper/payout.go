@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+)
+
+// AddPayout : records a pending payout for fiat pegs redeemed with the zone, the pegs stay with the payout until it is settled
+func (k Keeper) AddPayout(ctx cTypes.Context, zoneID acl.ZoneID, redeemerAddress cTypes.AccAddress, amount int64,
+	bankReference string, fiatPegWallet types.FiatPegWallet) payoutTypes.Payout {
+
+	payoutID := k.GetNextPayoutID(ctx)
+	k.SetNextPayoutID(ctx, payoutID+1)
+
+	payout := payoutTypes.NewPayout(payoutID, zoneID, redeemerAddress, amount, bankReference, fiatPegWallet, ctx.BlockHeight())
+	k.SetPayout(ctx, payout)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(payoutTypes.EventTypeRequestPayout,
+			cTypes.NewAttribute(payoutTypes.AttributeKeyPayoutID, strconv.FormatUint(payout.PayoutID, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyZoneID, payout.ZoneID.String()),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyRedeemerAddress, payout.RedeemerAddress.String()),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyAmount, strconv.FormatInt(payout.Amount, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyBankReference, payout.BankReference),
+		))
+
+	return payout
+}
+
+// ConfirmPayout : zone confirms the bank payment of a pending payout, the redeemed fiat pegs are retired with it and
+// counted as redeemed by the zone that issued each of them
+func (k Keeper) ConfirmPayout(ctx cTypes.Context, confirmPayout payoutTypes.ConfirmPayout) cTypes.Error {
+	payout, err := k.getPendingPayout(ctx, confirmPayout.FromAddress, confirmPayout.PayoutID)
+	if err != nil {
+		return err
+	}
+
+	for i, fiatPeg := range payout.FiatPegWallet {
+		for _, redeemed := range types.GetFiatPegWalletCoins(types.FiatPegWallet{fiatPeg}) {
+			k.reserveKeeper.AddRedeemedFiat(ctx, acl.ZoneID(fiatPeg.GetZoneID()), redeemed)
+		}
+
+		payout.FiatPegWallet[i].RedeemedAmount += fiatPeg.TransactionAmount
+		payout.FiatPegWallet[i].TransactionAmount = 0
+	}
+
+	payout.Status = payoutTypes.StatusConfirmed
+	payout.PaymentReference = confirmPayout.PaymentReference
+	payout.SettledHeight = ctx.BlockHeight()
+	k.SetPayout(ctx, payout)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(payoutTypes.EventTypeConfirmPayout,
+			cTypes.NewAttribute(payoutTypes.AttributeKeyPayoutID, strconv.FormatUint(payout.PayoutID, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyRedeemerAddress, payout.RedeemerAddress.String()),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyAmount, strconv.FormatInt(payout.Amount, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyPaymentReference, payout.PaymentReference),
+		))
+
+	return nil
+}
+
+// RejectPayout : zone rejects a pending payout and the redeemed fiat pegs go back to the redeemer
+func (k Keeper) RejectPayout(ctx cTypes.Context, rejectPayout payoutTypes.RejectPayout) cTypes.Error {
+	payout, err := k.getPendingPayout(ctx, rejectPayout.FromAddress, rejectPayout.PayoutID)
+	if err != nil {
+		return err
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, payout.RedeemerAddress)
+	if account == nil {
+		return cTypes.ErrUnknownAddress(payout.RedeemerAddress.String())
+	}
+	_ = account.SetFiatPegWallet(types.AddFiatPegToWallet(account.GetFiatPegWallet(), payout.FiatPegWallet).Sort())
+	k.accountKeeper.SetAccount(ctx, account)
+
+	payout.Status = payoutTypes.StatusRejected
+	payout.Reason = rejectPayout.Reason
+	payout.SettledHeight = ctx.BlockHeight()
+	k.SetPayout(ctx, payout)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(payoutTypes.EventTypeRejectPayout,
+			cTypes.NewAttribute(payoutTypes.AttributeKeyPayoutID, strconv.FormatUint(payout.PayoutID, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyRedeemerAddress, payout.RedeemerAddress.String()),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyAmount, strconv.FormatInt(payout.Amount, 10)),
+			cTypes.NewAttribute(payoutTypes.AttributeKeyReason, payout.Reason),
+		))
+
+	return nil
+}
+
+func (k Keeper) getPendingPayout(ctx cTypes.Context, fromAddress cTypes.AccAddress, payoutID uint64) (payoutTypes.Payout, cTypes.Error) {
+	payout, err := k.GetPayout(ctx, payoutID)
+	if err != nil {
+		return payout, err
+	}
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, payout.ZoneID, fromAddress) {
+		return payout, payoutTypes.ErrUnauthorized(payoutTypes.DefaultCodeSpace)
+	}
+	if !payout.IsPending() {
+		return payout, payoutTypes.ErrPayoutSettled(payoutTypes.DefaultCodeSpace, "")
+	}
+	return payout, nil
+}
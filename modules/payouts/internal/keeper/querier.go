@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	payoutTypes "github.com/commitHub/commitBlockchain/modules/payouts/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryPayout  = "queryPayout"
+	QueryPayouts = "queryPayouts"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryPayout:
+			return queryPayout(ctx, path[1:], k)
+		case QueryPayouts:
+			return queryPayouts(ctx, path[1:], k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown payouts query endpoint")
+		}
+	}
+}
+
+func queryPayout(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	payoutID, errRes := strconv.ParseUint(path[0], 10, 64)
+	if errRes != nil {
+		return nil, payoutTypes.ErrInvalidPayout(payoutTypes.DefaultCodeSpace, fmt.Sprintf("invalid payoutID %s", path[0]))
+	}
+
+	payout, err := k.GetPayout(ctx, payoutID)
+	if err != nil {
+		return nil, payoutTypes.ErrInvalidPayout(payoutTypes.DefaultCodeSpace, fmt.Sprintf("payout %s "+
+			" not found", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, payout)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+// queryPayouts : payouts with the status in path, all payouts without one
+func queryPayouts(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	status := ""
+	if len(path) > 0 {
+		status = path[0]
+	}
+	if status != "" && !payoutTypes.IsValidStatus(status) {
+		return nil, payoutTypes.ErrInvalidPayout(payoutTypes.DefaultCodeSpace, fmt.Sprintf("invalid status %s", status))
+	}
+
+	payouts := k.GetPayouts(ctx, status)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, payouts)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
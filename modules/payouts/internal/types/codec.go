@@ -0,0 +1,16 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgConfirmPayouts{}, "commit-blockchain/MsgConfirmPayouts", nil)
+	cdc.RegisterConcrete(MsgRejectPayouts{}, "commit-blockchain/MsgRejectPayouts", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,37 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidPayout        cTypes.CodeType = 1101
+	CodePayoutSettled        cTypes.CodeType = 1102
+	CodeUnauthorized         cTypes.CodeType = 1103
+	CodeInvalidInputsOutputs cTypes.CodeType = 1104
+)
+
+func ErrInvalidPayout(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidPayout, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidPayout, "payout doesn't exist")
+}
+
+func ErrPayoutSettled(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodePayoutSettled, msg)
+	}
+	return cTypes.NewError(codespace, CodePayoutSettled, "payout is already confirmed or rejected")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
@@ -0,0 +1,15 @@
+package types
+
+var (
+	EventTypeRequestPayout = "requestPayout"
+	EventTypeConfirmPayout = "confirmPayout"
+	EventTypeRejectPayout  = "rejectPayout"
+
+	AttributeKeyPayoutID         = "payoutID"
+	AttributeKeyZoneID           = "zoneID"
+	AttributeKeyRedeemerAddress  = "redeemerAddress"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyBankReference    = "bankReference"
+	AttributeKeyPaymentReference = "paymentReference"
+	AttributeKeyReason           = "reason"
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+)
+
+type AccountKeeper interface {
+	GetAccount(ctx cTypes.Context, address cTypes.AccAddress) exported.Account
+	SetAccount(ctx cTypes.Context, account exported.Account)
+}
+
+type ACLKeeper interface {
+	CheckValidZoneAddress(ctx cTypes.Context, id acl.ZoneID, address cTypes.AccAddress) bool
+}
+
+type ReserveKeeper interface {
+	AddRedeemedFiat(ctx cTypes.Context, zoneID acl.ZoneID, redeemed cTypes.Coin)
+}
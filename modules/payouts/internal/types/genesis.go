@@ -0,0 +1,25 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	Payouts []Payout `json:"payouts"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	payoutIDs := make(map[uint64]bool)
+	for _, payout := range data.Payouts {
+		if err := payout.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid payout in genesis: %s", err.Error())
+		}
+		if payoutIDs[payout.PayoutID] {
+			return fmt.Errorf("duplicate payout %d in genesis", payout.PayoutID)
+		}
+		payoutIDs[payout.PayoutID] = true
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import "encoding/binary"
+
+const (
+	ModuleName   = "payouts"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	PayoutKey       = []byte{0x01}
+	NextPayoutIDKey = []byte{0x02}
+)
+
+// payouts/{0x01}/{payoutID}
+func GetPayoutKey(payoutID uint64) []byte {
+	return append(PayoutKey, GetPayoutIDBytes(payoutID)...)
+}
+
+func GetPayoutIDBytes(payoutID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, payoutID)
+	return bz
+}
+
+func GetPayoutIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
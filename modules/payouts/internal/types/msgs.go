@@ -0,0 +1,219 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// *****ConfirmPayout
+
+// ConfirmPayout : zone confirms the bank payout of a pending payout with the reference of the payment
+type ConfirmPayout struct {
+	FromAddress      cTypes.AccAddress `json:"fromAddress"`
+	PayoutID         uint64            `json:"payoutID"`
+	PaymentReference string            `json:"paymentReference"`
+}
+
+// NewConfirmPayout : initializer
+func NewConfirmPayout(fromAddress cTypes.AccAddress, payoutID uint64, paymentReference string) ConfirmPayout {
+	return ConfirmPayout{fromAddress, payoutID, paymentReference}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ConfirmPayout) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress      string `json:"fromAddress"`
+		PayoutID         uint64 `json:"payoutID"`
+		PaymentReference string `json:"paymentReference"`
+	}{
+		FromAddress:      in.FromAddress.String(),
+		PayoutID:         in.PayoutID,
+		PaymentReference: in.PaymentReference,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ConfirmPayout) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.PaymentReference) == 0 {
+		return ErrInvalidPayout(DefaultCodeSpace, "PaymentReference should not be empty.")
+	}
+	return nil
+}
+
+// MsgConfirmPayouts : high level payout confirmation of payouts module
+type MsgConfirmPayouts struct {
+	ConfirmPayouts []ConfirmPayout `json:"confirmPayouts"`
+}
+
+// NewMsgConfirmPayouts : initializer
+func NewMsgConfirmPayouts(confirmPayouts []ConfirmPayout) MsgConfirmPayouts {
+	return MsgConfirmPayouts{confirmPayouts}
+}
+
+var _ cTypes.Msg = MsgConfirmPayouts{}
+
+// Type : implements msg
+func (msg MsgConfirmPayouts) Type() string { return "confirmPayouts" }
+
+func (msg MsgConfirmPayouts) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgConfirmPayouts) ValidateBasic() cTypes.Error {
+	if len(msg.ConfirmPayouts) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.ConfirmPayouts {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgConfirmPayouts) GetSignBytes() []byte {
+	var confirmPayouts []json.RawMessage
+	for _, confirmPayout := range msg.ConfirmPayouts {
+		confirmPayouts = append(confirmPayouts, confirmPayout.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ConfirmPayouts []json.RawMessage `json:"confirmPayouts"`
+	}{
+		ConfirmPayouts: confirmPayouts,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgConfirmPayouts) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.ConfirmPayouts))
+	for i, in := range msg.ConfirmPayouts {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgConfirmPayout : build the MsgConfirmPayouts
+func BuildMsgConfirmPayout(fromAddress cTypes.AccAddress, payoutID uint64, paymentReference string) cTypes.Msg {
+	confirmPayout := NewConfirmPayout(fromAddress, payoutID, paymentReference)
+	msg := NewMsgConfirmPayouts([]ConfirmPayout{confirmPayout})
+	return msg
+}
+
+// #####ConfirmPayout
+
+// *****RejectPayout
+
+// RejectPayout : zone rejects a pending payout, returning the redeemed fiat pegs to the redeemer
+type RejectPayout struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	PayoutID    uint64            `json:"payoutID"`
+	Reason      string            `json:"reason"`
+}
+
+// NewRejectPayout : initializer
+func NewRejectPayout(fromAddress cTypes.AccAddress, payoutID uint64, reason string) RejectPayout {
+	return RejectPayout{fromAddress, payoutID, reason}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RejectPayout) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		PayoutID    uint64 `json:"payoutID"`
+		Reason      string `json:"reason"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		PayoutID:    in.PayoutID,
+		Reason:      in.Reason,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RejectPayout) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.Reason) == 0 {
+		return ErrInvalidPayout(DefaultCodeSpace, "Reason should not be empty.")
+	}
+	return nil
+}
+
+// MsgRejectPayouts : high level payout rejection of payouts module
+type MsgRejectPayouts struct {
+	RejectPayouts []RejectPayout `json:"rejectPayouts"`
+}
+
+// NewMsgRejectPayouts : initializer
+func NewMsgRejectPayouts(rejectPayouts []RejectPayout) MsgRejectPayouts {
+	return MsgRejectPayouts{rejectPayouts}
+}
+
+var _ cTypes.Msg = MsgRejectPayouts{}
+
+// Type : implements msg
+func (msg MsgRejectPayouts) Type() string { return "rejectPayouts" }
+
+func (msg MsgRejectPayouts) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRejectPayouts) ValidateBasic() cTypes.Error {
+	if len(msg.RejectPayouts) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.RejectPayouts {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRejectPayouts) GetSignBytes() []byte {
+	var rejectPayouts []json.RawMessage
+	for _, rejectPayout := range msg.RejectPayouts {
+		rejectPayouts = append(rejectPayouts, rejectPayout.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RejectPayouts []json.RawMessage `json:"rejectPayouts"`
+	}{
+		RejectPayouts: rejectPayouts,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRejectPayouts) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.RejectPayouts))
+	for i, in := range msg.RejectPayouts {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgRejectPayout : build the MsgRejectPayouts
+func BuildMsgRejectPayout(fromAddress cTypes.AccAddress, payoutID uint64, reason string) cTypes.Msg {
+	rejectPayout := NewRejectPayout(fromAddress, payoutID, reason)
+	msg := NewMsgRejectPayouts([]RejectPayout{rejectPayout})
+	return msg
+}
+
+// #####RejectPayout
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusRejected  = "rejected"
+)
+
+// Payout : off chain bank payout owed by a zone for redeemed fiat, FiatPegWallet holds the redeemed pegs until it is settled
+type Payout struct {
+	PayoutID         uint64              `json:"payoutID"`
+	ZoneID           acl.ZoneID          `json:"zoneID"`
+	RedeemerAddress  cTypes.AccAddress   `json:"redeemerAddress"`
+	Amount           int64               `json:"amount"`
+	BankReference    string              `json:"bankReference"`
+	PaymentReference string              `json:"paymentReference"`
+	Reason           string              `json:"reason"`
+	Status           string              `json:"status"`
+	FiatPegWallet    types.FiatPegWallet `json:"fiatPegWallet"`
+	CreatedHeight    int64               `json:"createdHeight"`
+	SettledHeight    int64               `json:"settledHeight"`
+}
+
+func NewPayout(payoutID uint64, zoneID acl.ZoneID, redeemerAddress cTypes.AccAddress, amount int64,
+	bankReference string, fiatPegWallet types.FiatPegWallet, createdHeight int64) Payout {
+
+	return Payout{
+		PayoutID:        payoutID,
+		ZoneID:          zoneID,
+		RedeemerAddress: redeemerAddress,
+		Amount:          amount,
+		BankReference:   bankReference,
+		Status:          StatusPending,
+		FiatPegWallet:   fiatPegWallet,
+		CreatedHeight:   createdHeight,
+	}
+}
+
+func (payout Payout) String() string {
+	return fmt.Sprintf(`Payout:
+PayoutID: %d,
+ZoneID: %s,
+RedeemerAddress: %s,
+Amount: %d,
+BankReference: %s,
+PaymentReference: %s,
+Reason: %s,
+Status: %s,
+CreatedHeight: %d,
+SettledHeight: %d,
+`, payout.PayoutID, payout.ZoneID.String(), payout.RedeemerAddress.String(), payout.Amount, payout.BankReference,
+		payout.PaymentReference, payout.Reason, payout.Status, payout.CreatedHeight, payout.SettledHeight)
+}
+
+func (payout Payout) ValidateBasic() cTypes.Error {
+	if len(payout.ZoneID) == 0 {
+		return ErrInvalidPayout(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if len(payout.RedeemerAddress) == 0 {
+		return ErrInvalidPayout(DefaultCodeSpace, "RedeemerAddress should not be empty.")
+	} else if payout.Amount <= 0 {
+		return ErrInvalidPayout(DefaultCodeSpace, "Amount should be positive.")
+	} else if !IsValidStatus(payout.Status) {
+		return ErrInvalidPayout(DefaultCodeSpace, fmt.Sprintf("Invalid status %s.", payout.Status))
+	}
+	return nil
+}
+
+// IsPending : payout is neither confirmed nor rejected by the zone
+func (payout Payout) IsPending() bool {
+	return payout.Status == StatusPending
+}
+
+func IsValidStatus(status string) bool {
+	return status == StatusPending || status == StatusConfirmed || status == StatusRejected
+}
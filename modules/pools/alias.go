@@ -0,0 +1,74 @@
+package pools
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/pools/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	QueryPoolSpec  = keeper.QueryPoolSpec
+	QueryPoolSpecs = keeper.QueryPoolSpecs
+	QueryPool      = keeper.QueryPool
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewPoolSpec       = types.NewPoolSpec
+	GetPoolSpecKey    = types.GetPoolSpecKey
+	GetPooledAssetKey = types.GetPooledAssetKey
+
+	GetApprovedDepositKey = types.GetApprovedDepositKey
+
+	ErrInvalidPoolSpec = types.ErrInvalidPoolSpec
+	ErrPoolSpecExists  = types.ErrPoolSpecExists
+	ErrAssetMismatch   = types.ErrAssetMismatch
+	ErrAssetNotPooled  = types.ErrAssetNotPooled
+	ErrUnauthorized    = types.ErrUnauthorized
+
+	ErrDepositNotApproved = types.ErrDepositNotApproved
+
+	BuildMsgRegisterPoolSpec = types.BuildMsgRegisterPoolSpec
+	BuildMsgApproveDeposit   = types.BuildMsgApproveDeposit
+	BuildMsgDepositAsset     = types.BuildMsgDepositAsset
+	BuildMsgWithdrawAsset    = types.BuildMsgWithdrawAsset
+
+	EventTypeRegisterPoolSpec = types.EventTypeRegisterPoolSpec
+	EventTypeApproveDeposit   = types.EventTypeApproveDeposit
+	EventTypeDepositAsset     = types.EventTypeDepositAsset
+	EventTypeWithdrawAsset    = types.EventTypeWithdrawAsset
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+
+	PoolSpec    = types.PoolSpec
+	PooledAsset = types.PooledAsset
+
+	ApprovedDeposit = types.ApprovedDeposit
+
+	MsgRegisterPoolSpecs = types.MsgRegisterPoolSpecs
+	MsgApproveDeposits   = types.MsgApproveDeposits
+	MsgDepositAssets     = types.MsgDepositAssets
+	MsgWithdrawAssets    = types.MsgWithdrawAssets
+
+	RegisterPoolSpec = types.RegisterPoolSpec
+	ApproveDeposit   = types.ApproveDeposit
+	DepositAsset     = types.DepositAsset
+	WithdrawAsset    = types.WithdrawAsset
+)
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+func ApproveDepositCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-deposit",
+		Short: "Approve an asset peg of the grade and warehouse of a pool for deposit into the pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetAssetPegHashHex(viper.GetString(FlagPegHash))
+			if err != nil {
+				return err
+			}
+
+			msg := poolTypes.BuildMsgApproveDeposit(cliCtx.GetFromAddress(), viper.GetString(FlagDenom), pegHash)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDenom)
+	cmd.Flags().AddFlagSet(fsPegHash)
+	return cmd
+}
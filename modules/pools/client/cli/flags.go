@@ -0,0 +1,30 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagDenom        = "denom"
+	FlagZoneID       = "zone-id"
+	FlagAssetType    = "asset-type"
+	FlagQuantityUnit = "quantity-unit"
+	FlagPegHash      = "peg-hash"
+)
+
+var (
+	fsDenom        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsZoneID       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAssetType    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsQuantityUnit = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPegHash      = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsDenom.String(FlagDenom, "", "Coin denomination of the pool, e.g. wheatgrademt")
+	fsZoneID.String(FlagZoneID, "", "ZoneID registering the pool specification")
+	fsAssetType.String(FlagAssetType, "", "Asset type pooled asset pegs must have")
+	fsQuantityUnit.String(FlagQuantityUnit, "", "Quantity unit pooled asset pegs must have")
+	fsPegHash.String(FlagPegHash, "", "PegHash of the asset peg")
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+func GetPoolSpecCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-spec [denom]",
+		Short: "Query pool specification of a denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", poolTypes.QuerierRoute, "queryPoolSpec", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var poolSpec poolTypes.PoolSpec
+			cdc.MustUnmarshalJSON(res, &poolSpec)
+			return cliCtx.PrintOutput(poolSpec)
+		},
+	}
+
+	return cmd
+}
+
+func GetPoolSpecsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-specs",
+		Short: "Query all pool specifications",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", poolTypes.QuerierRoute, "queryPoolSpecs"), nil)
+			if err != nil {
+				return err
+			}
+
+			var poolSpecs []poolTypes.PoolSpec
+			cdc.MustUnmarshalJSON(res, &poolSpecs)
+
+			output, err := cdc.MarshalJSONIndent(poolSpecs, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetPoolCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool [denom]",
+		Short: "Query asset pegs pooled into a denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", poolTypes.QuerierRoute, "queryPool", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var pool types.AssetPegWallet
+			cdc.MustUnmarshalJSON(res, &pool)
+
+			output, err := cdc.MarshalJSONIndent(pool, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
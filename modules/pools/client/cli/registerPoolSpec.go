@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+func RegisterPoolSpecCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-pool-spec",
+		Short: "Register the specification asset pegs must match to be pooled into a denomination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			msg := poolTypes.BuildMsgRegisterPoolSpec(cliCtx.GetFromAddress(), viper.GetString(FlagDenom), zoneID,
+				viper.GetString(FlagAssetType), viper.GetString(FlagQuantityUnit))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDenom)
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsAssetType)
+	cmd.Flags().AddFlagSet(fsQuantityUnit)
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+func WithdrawAssetCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-asset",
+		Short: "Burn pool coins to withdraw a pooled asset peg of equal quantity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pegHash, err := types.GetAssetPegHashHex(viper.GetString(FlagPegHash))
+			if err != nil {
+				return err
+			}
+
+			msg := poolTypes.BuildMsgWithdrawAsset(cliCtx.GetFromAddress(), viper.GetString(FlagDenom), pegHash)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDenom)
+	cmd.Flags().AddFlagSet(fsPegHash)
+	return cmd
+}
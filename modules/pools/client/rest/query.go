@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+func QueryPoolSpecRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", poolTypes.QuerierRoute, "queryPoolSpec", vars["denom"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query PoolSpec. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var poolSpec poolTypes.PoolSpec
+		cliCtx.Codec.MustUnmarshalJSON(res, &poolSpec)
+
+		rest.PostProcessResponse(w, cliCtx, poolSpec)
+	}
+}
+
+func QueryPoolSpecsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", poolTypes.QuerierRoute, "queryPoolSpecs"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query PoolSpecs. Error: %s", err.Error()))
+			return
+		}
+
+		var poolSpecs []poolTypes.PoolSpec
+		cliCtx.Codec.MustUnmarshalJSON(res, &poolSpecs)
+
+		rest.PostProcessResponse(w, cliCtx, poolSpecs)
+	}
+}
+
+func QueryPoolRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", poolTypes.QuerierRoute, "queryPool", vars["denom"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Pool. Error: %s", err.Error()))
+			return
+		}
+
+		var pool types.AssetPegWallet
+		cliCtx.Codec.MustUnmarshalJSON(res, &pool)
+
+		rest.PostProcessResponse(w, cliCtx, pool)
+	}
+}
@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/poolSpec/{denom}", QueryPoolSpecRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/poolSpecs", QueryPoolSpecsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/pool/{denom}", QueryPoolRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/registerPoolSpec", RegisterPoolSpecRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/approveDeposit", ApproveDepositRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/depositAsset", DepositAssetRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/withdrawAsset", WithdrawAssetRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
@@ -0,0 +1,25 @@
+package pools
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, poolSpec := range data.PoolSpecs {
+		keeper.SetPoolSpec(ctx, poolSpec)
+	}
+	for _, pooledAsset := range data.PooledAssets {
+		keeper.SetPooledAsset(ctx, pooledAsset)
+	}
+	for _, approvedDeposit := range data.ApprovedDeposits {
+		keeper.SetApprovedDeposit(ctx, approvedDeposit)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	poolSpecs := keeper.GetPoolSpecs(ctx)
+	pooledAssets := keeper.GetPooledAssets(ctx)
+	approvedDeposits := keeper.GetApprovedDeposits(ctx)
+
+	return GenesisState{PoolSpecs: poolSpecs, PooledAssets: pooledAssets, ApprovedDeposits: approvedDeposits}
+}
@@ -0,0 +1,76 @@
+package pools
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgRegisterPoolSpecs:
+			return handleMsgRegisterPoolSpecs(ctx, k, msg)
+		case MsgApproveDeposits:
+			return handleMsgApproveDeposits(ctx, k, msg)
+		case MsgDepositAssets:
+			return handleMsgDepositAssets(ctx, k, msg)
+		case MsgWithdrawAssets:
+			return handleMsgWithdrawAssets(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgRegisterPoolSpecs(ctx cTypes.Context, k Keeper, msg MsgRegisterPoolSpecs) cTypes.Result {
+	for _, registerPoolSpec := range msg.RegisterPoolSpecs {
+		if err := k.RegisterPoolSpec(ctx, registerPoolSpec); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgApproveDeposits(ctx cTypes.Context, k Keeper, msg MsgApproveDeposits) cTypes.Result {
+	for _, approveDeposit := range msg.ApproveDeposits {
+		if err := k.ApproveDeposit(ctx, approveDeposit); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgDepositAssets(ctx cTypes.Context, k Keeper, msg MsgDepositAssets) cTypes.Result {
+	for _, depositAsset := range msg.DepositAssets {
+		if err := k.DepositAsset(ctx, depositAsset); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgWithdrawAssets(ctx cTypes.Context, k Keeper, msg MsgWithdrawAssets) cTypes.Result {
+	for _, withdrawAsset := range msg.WithdrawAssets {
+		if err := k.WithdrawAsset(ctx, withdrawAsset); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
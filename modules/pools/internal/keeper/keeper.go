@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+type Keeper struct {
+	storeKey      cTypes.StoreKey
+	cdc           *codec.Codec
+	accountKeeper poolTypes.AccountKeeper
+	aclKeeper     poolTypes.ACLKeeper
+	supplyKeeper  poolTypes.SupplyKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, accountKeeper poolTypes.AccountKeeper,
+	aclKeeper poolTypes.ACLKeeper, supplyKeeper poolTypes.SupplyKeeper) Keeper {
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		aclKeeper:     aclKeeper,
+		supplyKeeper:  supplyKeeper,
+	}
+}
+
+// pools/{0x01}/{denom} => poolSpec
+func (k Keeper) SetPoolSpec(ctx cTypes.Context, poolSpec poolTypes.PoolSpec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(poolSpec)
+	store.Set(poolTypes.GetPoolSpecKey(poolSpec.Denom), bz)
+}
+
+// returns pool specification by denom
+func (k Keeper) GetPoolSpec(ctx cTypes.Context, denom string) (poolSpec poolTypes.PoolSpec, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(poolTypes.GetPoolSpecKey(denom))
+	if bz == nil {
+		return poolSpec, poolTypes.ErrInvalidPoolSpec(poolTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &poolSpec)
+	return poolSpec, nil
+}
+
+// get all pool specifications => []PoolSpec from store
+func (k Keeper) GetPoolSpecs(ctx cTypes.Context) (poolSpecs []poolTypes.PoolSpec) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, poolTypes.PoolSpecKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var poolSpec poolTypes.PoolSpec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &poolSpec)
+		poolSpecs = append(poolSpecs, poolSpec)
+	}
+	return
+}
+
+// pools/{0x02}/{pegHash} => denom
+func (k Keeper) SetPooledAsset(ctx cTypes.Context, pooledAsset poolTypes.PooledAsset) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(poolTypes.GetPooledAssetKey(pooledAsset.PegHash), []byte(pooledAsset.Denom))
+}
+
+// returns the denom backed by a pooled asset peg
+func (k Keeper) GetPooledAssetDenom(ctx cTypes.Context, pegHash types.PegHash) (string, cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(poolTypes.GetPooledAssetKey(pegHash))
+	if bz == nil {
+		return "", poolTypes.ErrAssetNotPooled(poolTypes.DefaultCodeSpace, "")
+	}
+	return string(bz), nil
+}
+
+func (k Keeper) DeletePooledAsset(ctx cTypes.Context, pegHash types.PegHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(poolTypes.GetPooledAssetKey(pegHash))
+}
+
+// get all pooled asset pegs => []PooledAsset from store
+func (k Keeper) GetPooledAssets(ctx cTypes.Context) (pooledAssets []poolTypes.PooledAsset) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, poolTypes.PooledAssetKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pegHash := types.PegHash(iterator.Key()[len(poolTypes.PooledAssetKey):])
+		pooledAssets = append(pooledAssets, poolTypes.PooledAsset{PegHash: pegHash, Denom: string(iterator.Value())})
+	}
+	return
+}
+
+// pools/{0x03}/{pegHash} => denom
+func (k Keeper) SetApprovedDeposit(ctx cTypes.Context, approvedDeposit poolTypes.ApprovedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(poolTypes.GetApprovedDepositKey(approvedDeposit.PegHash), []byte(approvedDeposit.Denom))
+}
+
+// returns the denom the zone approved the asset peg to be deposited into
+func (k Keeper) GetApprovedDepositDenom(ctx cTypes.Context, pegHash types.PegHash) (string, cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(poolTypes.GetApprovedDepositKey(pegHash))
+	if bz == nil {
+		return "", poolTypes.ErrDepositNotApproved(poolTypes.DefaultCodeSpace, "")
+	}
+	return string(bz), nil
+}
+
+func (k Keeper) DeleteApprovedDeposit(ctx cTypes.Context, pegHash types.PegHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(poolTypes.GetApprovedDepositKey(pegHash))
+}
+
+// get all approved deposits => []ApprovedDeposit from store
+func (k Keeper) GetApprovedDeposits(ctx cTypes.Context) (approvedDeposits []poolTypes.ApprovedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, poolTypes.ApprovedDepositKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pegHash := types.PegHash(iterator.Key()[len(poolTypes.ApprovedDepositKey):])
+		approvedDeposits = append(approvedDeposits, poolTypes.ApprovedDeposit{PegHash: pegHash, Denom: string(iterator.Value())})
+	}
+	return
+}
+
+// GetPool : asset pegs held by the pools module account for a denom
+func (k Keeper) GetPool(ctx cTypes.Context, denom string) (pool types.AssetPegWallet) {
+	moduleAccount := k.supplyKeeper.GetModuleAccount(ctx, poolTypes.ModuleName)
+	for _, assetPeg := range moduleAccount.GetAssetPegWallet() {
+		if pooledDenom, err := k.GetPooledAssetDenom(ctx, assetPeg.GetPegHash()); err == nil && pooledDenom == denom {
+			pool = append(pool, assetPeg)
+		}
+	}
+	return
+}
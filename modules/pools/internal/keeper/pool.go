@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+)
+
+// RegisterPoolSpec : zone registers the specification of a new pooled denomination
+func (k Keeper) RegisterPoolSpec(ctx cTypes.Context, registerPoolSpec poolTypes.RegisterPoolSpec) cTypes.Error {
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, registerPoolSpec.ZoneID, registerPoolSpec.FromAddress) {
+		return poolTypes.ErrUnauthorized(poolTypes.DefaultCodeSpace)
+	}
+	if _, err := k.GetPoolSpec(ctx, registerPoolSpec.Denom); err == nil {
+		return poolTypes.ErrPoolSpecExists(poolTypes.DefaultCodeSpace, "")
+	}
+	if !k.supplyKeeper.GetSupply(ctx).Total.AmountOf(registerPoolSpec.Denom).IsZero() {
+		return poolTypes.ErrPoolSpecExists(poolTypes.DefaultCodeSpace, "denomination is already in supply")
+	}
+
+	poolSpec := poolTypes.NewPoolSpec(registerPoolSpec.Denom, registerPoolSpec.ZoneID, registerPoolSpec.AssetType,
+		registerPoolSpec.QuantityUnit)
+	k.SetPoolSpec(ctx, poolSpec)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(poolTypes.EventTypeRegisterPoolSpec,
+			cTypes.NewAttribute(poolTypes.AttributeKeyDenom, poolSpec.Denom),
+			cTypes.NewAttribute(poolTypes.AttributeKeyZoneID, poolSpec.ZoneID.String()),
+		))
+
+	return nil
+}
+
+// ApproveDeposit : zone of the pool approves an asset peg of matching grade and warehouse for deposit into the pool
+func (k Keeper) ApproveDeposit(ctx cTypes.Context, approveDeposit poolTypes.ApproveDeposit) cTypes.Error {
+	poolSpec, err := k.GetPoolSpec(ctx, approveDeposit.Denom)
+	if err != nil {
+		return err
+	}
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, poolSpec.ZoneID, approveDeposit.FromAddress) {
+		return poolTypes.ErrUnauthorized(poolTypes.DefaultCodeSpace)
+	}
+	if _, err := k.GetPooledAssetDenom(ctx, approveDeposit.PegHash); err == nil {
+		return poolTypes.ErrAssetMismatch(poolTypes.DefaultCodeSpace, "Asset peg is already pooled.")
+	}
+
+	k.SetApprovedDeposit(ctx, poolTypes.ApprovedDeposit{PegHash: approveDeposit.PegHash, Denom: poolSpec.Denom})
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(poolTypes.EventTypeApproveDeposit,
+			cTypes.NewAttribute(poolTypes.AttributeKeyFromAddress, approveDeposit.FromAddress.String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyPegHash, approveDeposit.PegHash.String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyDenom, poolSpec.Denom),
+		))
+
+	return nil
+}
+
+// DepositAsset : move a matching asset peg into the pools module account and mint its quantity in pool coins, once the
+// zone of the pool approved the peg
+func (k Keeper) DepositAsset(ctx cTypes.Context, depositAsset poolTypes.DepositAsset) cTypes.Error {
+	poolSpec, err := k.GetPoolSpec(ctx, depositAsset.Denom)
+	if err != nil {
+		return err
+	}
+
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, depositAsset.FromAddress)
+	if err != nil {
+		return err
+	}
+	if !aclAccount.GetACL().SendAsset || aclAccount.GetZoneID().String() != poolSpec.ZoneID.String() {
+		return poolTypes.ErrUnauthorized(poolTypes.DefaultCodeSpace)
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, depositAsset.FromAddress)
+	if account == nil {
+		return cTypes.ErrUnknownAddress(depositAsset.FromAddress.String())
+	}
+
+	assetPeg, assetPegWallet := types.SubtractAssetPegFromWallet(depositAsset.PegHash,
+		append(types.AssetPegWallet{}, account.GetAssetPegWallet()...))
+	if assetPeg == nil {
+		return cTypes.ErrInsufficientCoins("Asset peg not found.")
+	}
+	if assetPeg.GetLocked() {
+		return poolTypes.ErrAssetMismatch(poolTypes.DefaultCodeSpace, "Asset peg is locked.")
+	}
+	if err := k.aclKeeper.CheckFrozen(ctx, depositAsset.FromAddress, acl.PegTypeAsset, depositAsset.PegHash); err != nil {
+		return err
+	}
+	if !poolSpec.Matches(assetPeg) {
+		return poolTypes.ErrAssetMismatch(poolTypes.DefaultCodeSpace, "")
+	}
+	if denom, err := k.GetApprovedDepositDenom(ctx, depositAsset.PegHash); err != nil || denom != poolSpec.Denom {
+		return poolTypes.ErrDepositNotApproved(poolTypes.DefaultCodeSpace, "")
+	}
+	if assetPeg.GetAssetQuantity() <= 0 {
+		return poolTypes.ErrAssetMismatch(poolTypes.DefaultCodeSpace, "Asset quantity should be positive.")
+	}
+
+	_ = account.SetAssetPegWallet(assetPegWallet)
+	k.accountKeeper.SetAccount(ctx, account)
+
+	moduleAccount := k.supplyKeeper.GetModuleAccount(ctx, poolTypes.ModuleName)
+	_ = moduleAccount.SetAssetPegWallet(types.AddAssetPegToWallet(assetPeg, moduleAccount.GetAssetPegWallet()))
+	k.supplyKeeper.SetModuleAccount(ctx, moduleAccount)
+	k.SetPooledAsset(ctx, poolTypes.PooledAsset{PegHash: assetPeg.GetPegHash(), Denom: poolSpec.Denom})
+	k.DeleteApprovedDeposit(ctx, assetPeg.GetPegHash())
+
+	coins := poolSpec.PoolCoins(assetPeg)
+	if err := k.supplyKeeper.MintCoins(ctx, poolTypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, poolTypes.ModuleName, depositAsset.FromAddress, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(poolTypes.EventTypeDepositAsset,
+			cTypes.NewAttribute(poolTypes.AttributeKeyFromAddress, depositAsset.FromAddress.String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyPegHash, assetPeg.GetPegHash().String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyDenom, poolSpec.Denom),
+			cTypes.NewAttribute(poolTypes.AttributeKeyAmount, strconv.FormatInt(assetPeg.GetAssetQuantity(), 10)),
+		))
+
+	return nil
+}
+
+// WithdrawAsset : burn pool coins equal to the quantity of a pooled asset peg and hand the peg to the withdrawer
+func (k Keeper) WithdrawAsset(ctx cTypes.Context, withdrawAsset poolTypes.WithdrawAsset) cTypes.Error {
+	poolSpec, err := k.GetPoolSpec(ctx, withdrawAsset.Denom)
+	if err != nil {
+		return err
+	}
+
+	aclAccount, err := k.aclKeeper.GetAccountACLDetails(ctx, withdrawAsset.FromAddress)
+	if err != nil {
+		return err
+	}
+	if !aclAccount.GetACL().SendAsset {
+		return poolTypes.ErrUnauthorized(poolTypes.DefaultCodeSpace)
+	}
+
+	denom, err := k.GetPooledAssetDenom(ctx, withdrawAsset.PegHash)
+	if err != nil {
+		return err
+	}
+	if denom != poolSpec.Denom {
+		return poolTypes.ErrAssetNotPooled(poolTypes.DefaultCodeSpace, "Asset peg backs a different denomination.")
+	}
+
+	moduleAccount := k.supplyKeeper.GetModuleAccount(ctx, poolTypes.ModuleName)
+	assetPeg, _ := types.SubtractAssetPegFromWallet(withdrawAsset.PegHash,
+		append(types.AssetPegWallet{}, moduleAccount.GetAssetPegWallet()...))
+	if assetPeg == nil {
+		return poolTypes.ErrAssetNotPooled(poolTypes.DefaultCodeSpace, "")
+	}
+
+	coins := poolSpec.PoolCoins(assetPeg)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, withdrawAsset.FromAddress, poolTypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, poolTypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	moduleAccount = k.supplyKeeper.GetModuleAccount(ctx, poolTypes.ModuleName)
+	_, moduleAssetPegWallet := types.SubtractAssetPegFromWallet(withdrawAsset.PegHash,
+		append(types.AssetPegWallet{}, moduleAccount.GetAssetPegWallet()...))
+	_ = moduleAccount.SetAssetPegWallet(moduleAssetPegWallet)
+	k.supplyKeeper.SetModuleAccount(ctx, moduleAccount)
+	k.DeletePooledAsset(ctx, withdrawAsset.PegHash)
+
+	account := k.accountKeeper.GetAccount(ctx, withdrawAsset.FromAddress)
+	_ = account.SetAssetPegWallet(types.AddAssetPegToWallet(assetPeg, account.GetAssetPegWallet()))
+	k.accountKeeper.SetAccount(ctx, account)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(poolTypes.EventTypeWithdrawAsset,
+			cTypes.NewAttribute(poolTypes.AttributeKeyFromAddress, withdrawAsset.FromAddress.String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyPegHash, assetPeg.GetPegHash().String()),
+			cTypes.NewAttribute(poolTypes.AttributeKeyDenom, poolSpec.Denom),
+			cTypes.NewAttribute(poolTypes.AttributeKeyAmount, strconv.FormatInt(assetPeg.GetAssetQuantity(), 10)),
+		))
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	poolTypes "github.com/commitHub/commitBlockchain/modules/pools/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryPoolSpec  = "queryPoolSpec"
+	QueryPoolSpecs = "queryPoolSpecs"
+	QueryPool      = "queryPool"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryPoolSpec:
+			return queryPoolSpec(ctx, path[1:], k)
+		case QueryPoolSpecs:
+			return queryPoolSpecs(ctx, k)
+		case QueryPool:
+			return queryPool(ctx, path[1:], k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown pools query endpoint")
+		}
+	}
+}
+
+func queryPoolSpec(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	poolSpec, err := k.GetPoolSpec(ctx, path[0])
+	if err != nil {
+		return nil, poolTypes.ErrInvalidPoolSpec(poolTypes.DefaultCodeSpace, fmt.Sprintf("pool specification for %s "+
+			" not found", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, poolSpec)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPoolSpecs(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	poolSpecs := k.GetPoolSpecs(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, poolSpecs)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPool(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	if _, err := k.GetPoolSpec(ctx, path[0]); err != nil {
+		return nil, poolTypes.ErrInvalidPoolSpec(poolTypes.DefaultCodeSpace, fmt.Sprintf("pool specification for %s "+
+			" not found", path[0]))
+	}
+	pool := k.GetPool(ctx, path[0])
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, pool)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
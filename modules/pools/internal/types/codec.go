@@ -0,0 +1,18 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterPoolSpecs{}, "commit-blockchain/MsgRegisterPoolSpecs", nil)
+	cdc.RegisterConcrete(MsgApproveDeposits{}, "commit-blockchain/MsgApproveDeposits", nil)
+	cdc.RegisterConcrete(MsgDepositAssets{}, "commit-blockchain/MsgDepositAssets", nil)
+	cdc.RegisterConcrete(MsgWithdrawAssets{}, "commit-blockchain/MsgWithdrawAssets", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
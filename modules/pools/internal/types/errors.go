@@ -0,0 +1,61 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidPoolSpec      cTypes.CodeType = 901
+	CodePoolSpecExists       cTypes.CodeType = 902
+	CodeAssetMismatch        cTypes.CodeType = 903
+	CodeAssetNotPooled       cTypes.CodeType = 904
+	CodeUnauthorized         cTypes.CodeType = 905
+	CodeInvalidInputsOutputs cTypes.CodeType = 906
+	CodeDepositNotApproved   cTypes.CodeType = 907
+)
+
+func ErrInvalidPoolSpec(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidPoolSpec, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidPoolSpec, "pool specification doesn't exist")
+}
+
+func ErrPoolSpecExists(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodePoolSpecExists, msg)
+	}
+	return cTypes.NewError(codespace, CodePoolSpecExists, "pool specification already exists for denomination")
+}
+
+func ErrAssetMismatch(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeAssetMismatch, msg)
+	}
+	return cTypes.NewError(codespace, CodeAssetMismatch, "asset peg doesn't match the pool specification")
+}
+
+func ErrAssetNotPooled(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeAssetNotPooled, msg)
+	}
+	return cTypes.NewError(codespace, CodeAssetNotPooled, "asset peg is not in the pool")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
+
+func ErrDepositNotApproved(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeDepositNotApproved, msg)
+	}
+	return cTypes.NewError(codespace, CodeDepositNotApproved, "deposit of the asset peg is not approved by the zone of the pool")
+}
@@ -0,0 +1,14 @@
+package types
+
+var (
+	EventTypeRegisterPoolSpec = "registerPoolSpec"
+	EventTypeApproveDeposit   = "approveDeposit"
+	EventTypeDepositAsset     = "depositAsset"
+	EventTypeWithdrawAsset    = "withdrawAsset"
+
+	AttributeKeyDenom       = "denom"
+	AttributeKeyZoneID      = "zoneID"
+	AttributeKeyFromAddress = "fromAddress"
+	AttributeKeyPegHash     = "pegHash"
+	AttributeKeyAmount      = "amount"
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	"github.com/commitHub/commitBlockchain/modules/supply"
+	supplyExported "github.com/commitHub/commitBlockchain/modules/supply/exported"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type AccountKeeper interface {
+	GetAccount(ctx cTypes.Context, address cTypes.AccAddress) exported.Account
+	SetAccount(ctx cTypes.Context, account exported.Account)
+}
+
+type ACLKeeper interface {
+	GetAccountACLDetails(ctx cTypes.Context, fromAddress cTypes.AccAddress) (acl.ACLAccount, cTypes.Error)
+	CheckValidZoneAddress(ctx cTypes.Context, id acl.ZoneID, address cTypes.AccAddress) bool
+	CheckFrozen(ctx cTypes.Context, address cTypes.AccAddress, pegType string, pegHashes ...types.PegHash) cTypes.Error
+}
+
+type SupplyKeeper interface {
+	GetSupply(ctx cTypes.Context) supply.Supply
+	GetModuleAccount(ctx cTypes.Context, moduleName string) supplyExported.ModuleAccountI
+	SetModuleAccount(ctx cTypes.Context, macc supplyExported.ModuleAccountI)
+	MintCoins(ctx cTypes.Context, moduleName string, amt cTypes.Coins) cTypes.Error
+	BurnCoins(ctx cTypes.Context, moduleName string, amt cTypes.Coins) cTypes.Error
+	SendCoinsFromAccountToModule(ctx cTypes.Context, senderAddr cTypes.AccAddress, recipientModule string, amt cTypes.Coins) cTypes.Error
+	SendCoinsFromModuleToAccount(ctx cTypes.Context, senderModule string, recipientAddr cTypes.AccAddress, amt cTypes.Coins) cTypes.Error
+}
@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	PoolSpecs        []PoolSpec        `json:"poolSpecs"`
+	PooledAssets     []PooledAsset     `json:"pooledAssets"`
+	ApprovedDeposits []ApprovedDeposit `json:"approvedDeposits"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	denoms := make(map[string]bool)
+	for _, spec := range data.PoolSpecs {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pool specification in genesis: %s", err.Error())
+		}
+		if denoms[spec.Denom] {
+			return fmt.Errorf("duplicate pool specification %s in genesis", spec.Denom)
+		}
+		denoms[spec.Denom] = true
+	}
+	for _, pooledAsset := range data.PooledAssets {
+		if !denoms[pooledAsset.Denom] {
+			return fmt.Errorf("pooled asset %s references unknown denomination %s", pooledAsset.PegHash.String(), pooledAsset.Denom)
+		}
+	}
+	for _, approvedDeposit := range data.ApprovedDeposits {
+		if !denoms[approvedDeposit.Denom] {
+			return fmt.Errorf("approved deposit %s references unknown denomination %s", approvedDeposit.PegHash.String(),
+				approvedDeposit.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+const (
+	ModuleName   = "pools"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	PoolSpecKey    = []byte{0x01}
+	PooledAssetKey = []byte{0x02}
+
+	ApprovedDepositKey = []byte{0x03}
+)
+
+// pools/{0x01}/{denom}
+func GetPoolSpecKey(denom string) []byte {
+	return append(PoolSpecKey, []byte(denom)...)
+}
+
+// pools/{0x02}/{pegHash}
+func GetPooledAssetKey(pegHash types.PegHash) []byte {
+	return append(PooledAssetKey, pegHash.Bytes()...)
+}
+
+// pools/{0x03}/{pegHash}
+func GetApprovedDepositKey(pegHash types.PegHash) []byte {
+	return append(ApprovedDepositKey, pegHash.Bytes()...)
+}
@@ -0,0 +1,449 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+// *****RegisterPoolSpec
+
+// RegisterPoolSpec : zone registers the specification asset pegs must match to be pooled into Denom
+type RegisterPoolSpec struct {
+	FromAddress  cTypes.AccAddress `json:"fromAddress"`
+	Denom        string            `json:"denom"`
+	ZoneID       acl.ZoneID        `json:"zoneID"`
+	AssetType    string            `json:"assetType"`
+	QuantityUnit string            `json:"quantityUnit"`
+}
+
+// NewRegisterPoolSpec : initializer
+func NewRegisterPoolSpec(fromAddress cTypes.AccAddress, denom string, zoneID acl.ZoneID, assetType string,
+	quantityUnit string) RegisterPoolSpec {
+
+	return RegisterPoolSpec{fromAddress, denom, zoneID, assetType, quantityUnit}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RegisterPoolSpec) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress  string `json:"fromAddress"`
+		Denom        string `json:"denom"`
+		ZoneID       string `json:"zoneID"`
+		AssetType    string `json:"assetType"`
+		QuantityUnit string `json:"quantityUnit"`
+	}{
+		FromAddress:  in.FromAddress.String(),
+		Denom:        in.Denom,
+		ZoneID:       in.ZoneID.String(),
+		AssetType:    in.AssetType,
+		QuantityUnit: in.QuantityUnit,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RegisterPoolSpec) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	}
+	return NewPoolSpec(in.Denom, in.ZoneID, in.AssetType, in.QuantityUnit).ValidateBasic()
+}
+
+// MsgRegisterPoolSpecs : high level pool specification registration of pools module
+type MsgRegisterPoolSpecs struct {
+	RegisterPoolSpecs []RegisterPoolSpec `json:"registerPoolSpecs"`
+}
+
+// NewMsgRegisterPoolSpecs : initializer
+func NewMsgRegisterPoolSpecs(registerPoolSpecs []RegisterPoolSpec) MsgRegisterPoolSpecs {
+	return MsgRegisterPoolSpecs{registerPoolSpecs}
+}
+
+var _ cTypes.Msg = MsgRegisterPoolSpecs{}
+
+// Type : implements msg
+func (msg MsgRegisterPoolSpecs) Type() string { return "registerPoolSpecs" }
+
+func (msg MsgRegisterPoolSpecs) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRegisterPoolSpecs) ValidateBasic() cTypes.Error {
+	if len(msg.RegisterPoolSpecs) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.RegisterPoolSpecs {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRegisterPoolSpecs) GetSignBytes() []byte {
+	var registerPoolSpecs []json.RawMessage
+	for _, registerPoolSpec := range msg.RegisterPoolSpecs {
+		registerPoolSpecs = append(registerPoolSpecs, registerPoolSpec.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RegisterPoolSpecs []json.RawMessage `json:"registerPoolSpecs"`
+	}{
+		RegisterPoolSpecs: registerPoolSpecs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRegisterPoolSpecs) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.RegisterPoolSpecs))
+	for i, in := range msg.RegisterPoolSpecs {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgRegisterPoolSpec : build the MsgRegisterPoolSpecs
+func BuildMsgRegisterPoolSpec(fromAddress cTypes.AccAddress, denom string, zoneID acl.ZoneID, assetType string,
+	quantityUnit string) cTypes.Msg {
+
+	registerPoolSpec := NewRegisterPoolSpec(fromAddress, denom, zoneID, assetType, quantityUnit)
+	msg := NewMsgRegisterPoolSpecs([]RegisterPoolSpec{registerPoolSpec})
+	return msg
+}
+
+// #####RegisterPoolSpec
+
+// *****ApproveDeposit
+
+// ApproveDeposit : zone of the pool approves an asset peg, checked for the grade and warehouse of the pool, to be
+// deposited into the pool of Denom
+type ApproveDeposit struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	PegHash     types.PegHash     `json:"pegHash"`
+}
+
+// NewApproveDeposit : initializer
+func NewApproveDeposit(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) ApproveDeposit {
+	return ApproveDeposit{fromAddress, denom, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in ApproveDeposit) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		PegHash     string `json:"pegHash"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		PegHash:     in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in ApproveDeposit) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if !reDenom.MatchString(in.Denom) {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "Invalid denomination.")
+	} else if len(in.PegHash) == 0 {
+		return cTypes.ErrUnknownRequest("PegHash should not be empty.")
+	}
+	return nil
+}
+
+// MsgApproveDeposits : high level deposit approval of pools module
+type MsgApproveDeposits struct {
+	ApproveDeposits []ApproveDeposit `json:"approveDeposits"`
+}
+
+// NewMsgApproveDeposits : initializer
+func NewMsgApproveDeposits(approveDeposits []ApproveDeposit) MsgApproveDeposits {
+	return MsgApproveDeposits{approveDeposits}
+}
+
+var _ cTypes.Msg = MsgApproveDeposits{}
+
+// Type : implements msg
+func (msg MsgApproveDeposits) Type() string { return "approveDeposits" }
+
+func (msg MsgApproveDeposits) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgApproveDeposits) ValidateBasic() cTypes.Error {
+	if len(msg.ApproveDeposits) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.ApproveDeposits {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgApproveDeposits) GetSignBytes() []byte {
+	var approveDeposits []json.RawMessage
+	for _, approveDeposit := range msg.ApproveDeposits {
+		approveDeposits = append(approveDeposits, approveDeposit.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		ApproveDeposits []json.RawMessage `json:"approveDeposits"`
+	}{
+		ApproveDeposits: approveDeposits,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgApproveDeposits) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.ApproveDeposits))
+	for i, in := range msg.ApproveDeposits {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgApproveDeposit : build the MsgApproveDeposits
+func BuildMsgApproveDeposit(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) cTypes.Msg {
+	approveDeposit := NewApproveDeposit(fromAddress, denom, pegHash)
+	msg := NewMsgApproveDeposits([]ApproveDeposit{approveDeposit})
+	return msg
+}
+
+// #####ApproveDeposit
+
+// *****DepositAsset
+
+// DepositAsset : move an asset peg into the pool of Denom, minting coins for its quantity
+type DepositAsset struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	PegHash     types.PegHash     `json:"pegHash"`
+}
+
+// NewDepositAsset : initializer
+func NewDepositAsset(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) DepositAsset {
+	return DepositAsset{fromAddress, denom, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in DepositAsset) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		PegHash     string `json:"pegHash"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		PegHash:     in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in DepositAsset) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if !reDenom.MatchString(in.Denom) {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "Invalid denomination.")
+	} else if len(in.PegHash) == 0 {
+		return cTypes.ErrUnknownRequest("PegHash should not be empty.")
+	}
+	return nil
+}
+
+// MsgDepositAssets : high level asset deposit of pools module
+type MsgDepositAssets struct {
+	DepositAssets []DepositAsset `json:"depositAssets"`
+}
+
+// NewMsgDepositAssets : initializer
+func NewMsgDepositAssets(depositAssets []DepositAsset) MsgDepositAssets {
+	return MsgDepositAssets{depositAssets}
+}
+
+var _ cTypes.Msg = MsgDepositAssets{}
+
+// Type : implements msg
+func (msg MsgDepositAssets) Type() string { return "depositAssets" }
+
+func (msg MsgDepositAssets) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgDepositAssets) ValidateBasic() cTypes.Error {
+	if len(msg.DepositAssets) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.DepositAssets {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgDepositAssets) GetSignBytes() []byte {
+	var depositAssets []json.RawMessage
+	for _, depositAsset := range msg.DepositAssets {
+		depositAssets = append(depositAssets, depositAsset.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		DepositAssets []json.RawMessage `json:"depositAssets"`
+	}{
+		DepositAssets: depositAssets,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgDepositAssets) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.DepositAssets))
+	for i, in := range msg.DepositAssets {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgDepositAsset : build the MsgDepositAssets
+func BuildMsgDepositAsset(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) cTypes.Msg {
+	depositAsset := NewDepositAsset(fromAddress, denom, pegHash)
+	msg := NewMsgDepositAssets([]DepositAsset{depositAsset})
+	return msg
+}
+
+// #####DepositAsset
+
+// *****WithdrawAsset
+
+// WithdrawAsset : burn coins of Denom to take a pooled asset peg of equal quantity out of the pool
+type WithdrawAsset struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	Denom       string            `json:"denom"`
+	PegHash     types.PegHash     `json:"pegHash"`
+}
+
+// NewWithdrawAsset : initializer
+func NewWithdrawAsset(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) WithdrawAsset {
+	return WithdrawAsset{fromAddress, denom, pegHash}
+}
+
+// GetSignBytes : get bytes to sign
+func (in WithdrawAsset) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress string `json:"fromAddress"`
+		Denom       string `json:"denom"`
+		PegHash     string `json:"pegHash"`
+	}{
+		FromAddress: in.FromAddress.String(),
+		Denom:       in.Denom,
+		PegHash:     in.PegHash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in WithdrawAsset) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if !reDenom.MatchString(in.Denom) {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "Invalid denomination.")
+	} else if len(in.PegHash) == 0 {
+		return cTypes.ErrUnknownRequest("PegHash should not be empty.")
+	}
+	return nil
+}
+
+// MsgWithdrawAssets : high level asset withdrawal of pools module
+type MsgWithdrawAssets struct {
+	WithdrawAssets []WithdrawAsset `json:"withdrawAssets"`
+}
+
+// NewMsgWithdrawAssets : initializer
+func NewMsgWithdrawAssets(withdrawAssets []WithdrawAsset) MsgWithdrawAssets {
+	return MsgWithdrawAssets{withdrawAssets}
+}
+
+var _ cTypes.Msg = MsgWithdrawAssets{}
+
+// Type : implements msg
+func (msg MsgWithdrawAssets) Type() string { return "withdrawAssets" }
+
+func (msg MsgWithdrawAssets) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgWithdrawAssets) ValidateBasic() cTypes.Error {
+	if len(msg.WithdrawAssets) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.WithdrawAssets {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgWithdrawAssets) GetSignBytes() []byte {
+	var withdrawAssets []json.RawMessage
+	for _, withdrawAsset := range msg.WithdrawAssets {
+		withdrawAssets = append(withdrawAssets, withdrawAsset.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		WithdrawAssets []json.RawMessage `json:"withdrawAssets"`
+	}{
+		WithdrawAssets: withdrawAssets,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgWithdrawAssets) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.WithdrawAssets))
+	for i, in := range msg.WithdrawAssets {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgWithdrawAsset : build the MsgWithdrawAssets
+func BuildMsgWithdrawAsset(fromAddress cTypes.AccAddress, denom string, pegHash types.PegHash) cTypes.Msg {
+	withdrawAsset := NewWithdrawAsset(fromAddress, denom, pegHash)
+	msg := NewMsgWithdrawAssets([]WithdrawAsset{withdrawAsset})
+	return msg
+}
+
+// #####WithdrawAsset
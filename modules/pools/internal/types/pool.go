@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+var reDenom = regexp.MustCompile(`^[a-z][a-z0-9]{2,15}$`)
+
+// PoolSpec : zone registered specification of interchangeable asset pegs, pooled into one coin denomination
+type PoolSpec struct {
+	Denom        string     `json:"denom"`
+	ZoneID       acl.ZoneID `json:"zoneID"`
+	AssetType    string     `json:"assetType"`
+	QuantityUnit string     `json:"quantityUnit"`
+}
+
+func NewPoolSpec(denom string, zoneID acl.ZoneID, assetType string, quantityUnit string) PoolSpec {
+	return PoolSpec{
+		Denom:        denom,
+		ZoneID:       zoneID,
+		AssetType:    assetType,
+		QuantityUnit: quantityUnit,
+	}
+}
+
+func (spec PoolSpec) String() string {
+	return fmt.Sprintf(`PoolSpec:
+Denom: %s,
+ZoneID: %s,
+AssetType: %s,
+QuantityUnit: %s,
+`, spec.Denom, spec.ZoneID.String(), spec.AssetType, spec.QuantityUnit)
+}
+
+func (spec PoolSpec) ValidateBasic() cTypes.Error {
+	if !reDenom.MatchString(spec.Denom) {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, fmt.Sprintf("Invalid denomination %s.", spec.Denom))
+	} else if len(spec.ZoneID) == 0 {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if spec.AssetType == "" {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "AssetType should not be empty.")
+	} else if spec.QuantityUnit == "" {
+		return ErrInvalidPoolSpec(DefaultCodeSpace, "QuantityUnit should not be empty.")
+	}
+	return nil
+}
+
+// Matches : true if the asset peg is interchangeable with the other pegs of the pool
+func (spec PoolSpec) Matches(assetPeg types.AssetPeg) bool {
+	return assetPeg.GetAssetType() == spec.AssetType && assetPeg.GetQuantityUnit() == spec.QuantityUnit
+}
+
+// PoolCoins : coins minted for, or burned to withdraw, an asset peg of the pool
+func (spec PoolSpec) PoolCoins(assetPeg types.AssetPeg) cTypes.Coins {
+	return cTypes.NewCoins(cTypes.NewInt64Coin(spec.Denom, assetPeg.GetAssetQuantity()))
+}
+
+// PooledAsset : asset peg held by the pools module account and the denomination it backs
+type PooledAsset struct {
+	PegHash types.PegHash `json:"pegHash"`
+	Denom   string        `json:"denom"`
+}
+
+// ApprovedDeposit : asset peg the zone of the pool checked for grade and warehouse, and the denomination it may back
+type ApprovedDeposit struct {
+	PegHash types.PegHash `json:"pegHash"`
+	Denom   string        `json:"denom"`
+}
@@ -0,0 +1,62 @@
+package reserves
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/reserves/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+const (
+	StoreKey     = types.StoreKey
+	ModuleName   = types.ModuleName
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	QueryReserveAttestations = keeper.QueryReserveAttestations
+	QueryReserveRatio        = keeper.QueryReserveRatio
+	QueryReserveRatios       = keeper.QueryReserveRatios
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewReserveAttestation = types.NewReserveAttestation
+	NewZoneReserve        = types.NewZoneReserve
+	NewReserveRatio       = types.NewReserveRatio
+	AttestationSignBytes  = types.AttestationSignBytes
+
+	ErrInvalidAttestation  = types.ErrInvalidAttestation
+	ErrReserveExceeded     = types.ErrReserveExceeded
+	ErrUnauthorized        = types.ErrUnauthorized
+	ErrUnregisteredAuditor = types.ErrUnregisteredAuditor
+
+	BuildMsgAttestReserves  = types.BuildMsgAttestReserves
+	BuildMsgRegisterAuditor = types.BuildMsgRegisterAuditor
+
+	EventTypeAttestReserves  = types.EventTypeAttestReserves
+	EventTypeRegisterAuditor = types.EventTypeRegisterAuditor
+)
+
+type (
+	GenesisState    = types.GenesisState
+	Keeper          = keeper.Keeper
+	AccountKeeper   = types.AccountKeeper
+	PegEscrowKeeper = types.PegEscrowKeeper
+
+	ReserveAttestation = types.ReserveAttestation
+	ZoneReserve        = types.ZoneReserve
+	ReserveRatio       = types.ReserveRatio
+
+	MsgAttestReserves   = types.MsgAttestReserves
+	AttestReserves      = types.AttestReserves
+	MsgRegisterAuditors = types.MsgRegisterAuditors
+	RegisterAuditor     = types.RegisterAuditor
+)
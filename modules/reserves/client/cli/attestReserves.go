@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+func AttestReservesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest",
+		Short: "Post the reserves of the zone signed by its auditor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			reserves, err := cTypes.ParseCoins(viper.GetString(FlagReserves))
+			if err != nil {
+				return err
+			}
+
+			auditorPubKey, err := cTypes.GetAccPubKeyBech32(viper.GetString(FlagAuditorPubKey))
+			if err != nil {
+				return err
+			}
+
+			auditorSignature, err := base64.StdEncoding.DecodeString(viper.GetString(FlagAuditorSignature))
+			if err != nil {
+				return err
+			}
+
+			msg := reserveTypes.BuildMsgAttestReserves(cliCtx.GetFromAddress(), zoneID, uint64(viper.GetInt64(FlagSequence)), reserves,
+				viper.GetString(FlagReportHash), auditorPubKey, auditorSignature)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsSequence)
+	cmd.Flags().AddFlagSet(fsReserves)
+	cmd.Flags().AddFlagSet(fsReportHash)
+	cmd.Flags().AddFlagSet(fsAuditorPubKey)
+	cmd.Flags().AddFlagSet(fsAuditorSignature)
+	return cmd
+}
+
+// SignAttestationCmd : auditor signs the reserves of a zone offline, the zone posts the signature with attest
+func SignAttestationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-attestation",
+		Short: "Sign the reserves of a zone as its auditor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			reserves, err := cTypes.ParseCoins(viper.GetString(FlagReserves))
+			if err != nil {
+				return err
+			}
+
+			kb, err := keys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := keys.GetPassphrase(cliCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			signBytes := reserveTypes.AttestationSignBytes(zoneID, uint64(viper.GetInt64(FlagSequence)), reserves, viper.GetString(FlagReportHash))
+			signature, pubKey, err := kb.Sign(cliCtx.GetFromName(), passphrase, signBytes)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s: %s\n%s: %s\n", FlagAuditorPubKey, cTypes.MustBech32ifyAccPub(pubKey),
+				FlagAuditorSignature, base64.StdEncoding.EncodeToString(signature))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsSequence)
+	cmd.Flags().AddFlagSet(fsReserves)
+	cmd.Flags().AddFlagSet(fsReportHash)
+	return cmd
+}
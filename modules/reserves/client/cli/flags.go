@@ -0,0 +1,39 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagZoneID           = "zone-id"
+	FlagSequence         = "sequence"
+	FlagReserves         = "reserves"
+	FlagReportHash       = "report-hash"
+	FlagAuditorPubKey    = "auditor-pubkey"
+	FlagAuditorSignature = "auditor-signature"
+	FlagAuditorAddress   = "auditor-address"
+	FlagRegistered       = "registered"
+)
+
+var (
+	fsZoneID           = flag.NewFlagSet("", flag.ContinueOnError)
+	fsSequence         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsReserves         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsReportHash       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAuditorPubKey    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAuditorSignature = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAuditorAddress   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRegistered       = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsZoneID.String(FlagZoneID, "", "ZoneID of the zone holding the reserves")
+	fsSequence.Uint64(FlagSequence, 0, "Sequence of the attestation, the number of attestations the zone posted before")
+	fsReserves.String(FlagReserves, "", "Reserves held per currency, e.g. 1000000usd,500000eur")
+	fsReportHash.String(FlagReportHash, "", "Hash of the audit report of the reserves")
+	fsAuditorPubKey.String(FlagAuditorPubKey, "", "Bech32 public key of the auditor")
+	fsAuditorSignature.String(FlagAuditorSignature, "", "Base64 signature of the auditor over the attestation")
+	fsAuditorAddress.String(FlagAuditorAddress, "", "Bech32 address of the auditor")
+	fsRegistered.Bool(FlagRegistered, true, "Register the auditor, or remove it with --registered=false")
+}
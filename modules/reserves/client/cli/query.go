@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+func GetReserveAttestationsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations [zoneID]",
+		Short: "Query the reserve attestations of a zone, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", reserveTypes.QuerierRoute, "queryReserveAttestations", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var attestations []reserveTypes.ReserveAttestation
+			cdc.MustUnmarshalJSON(res, &attestations)
+
+			output, err := cdc.MarshalJSONIndent(attestations, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetReserveRatioCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratio [zoneID]",
+		Short: "Query the latest attested reserve of a zone against its outstanding fiat",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", reserveTypes.QuerierRoute, "queryReserveRatio", args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var reserveRatio reserveTypes.ReserveRatio
+			cdc.MustUnmarshalJSON(res, &reserveRatio)
+			return cliCtx.PrintOutput(reserveRatio)
+		},
+	}
+
+	return cmd
+}
+
+func GetReserveRatiosCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratios",
+		Short: "Query the reserve ratios of all zones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", reserveTypes.QuerierRoute, "queryReserveRatios"), nil)
+			if err != nil {
+				return err
+			}
+
+			var reserveRatios []reserveTypes.ReserveRatio
+			cdc.MustUnmarshalJSON(res, &reserveRatios)
+
+			output, err := cdc.MarshalJSONIndent(reserveRatios, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+// RegisterAuditorCmd : genesis account registers an auditor of reserve attestations, or removes it
+func RegisterAuditorCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-auditor",
+		Short: "Register an auditor whose signatures are accepted on reserve attestations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			auditorAddress, err := cTypes.AccAddressFromBech32(viper.GetString(FlagAuditorAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := reserveTypes.BuildMsgRegisterAuditor(cliCtx.GetFromAddress(), auditorAddress, viper.GetBool(FlagRegistered))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAuditorAddress)
+	cmd.Flags().AddFlagSet(fsRegistered)
+	return cmd
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+type attestReservesReq struct {
+	BaseReq          rest.BaseReq `json:"base_req"`
+	ZoneID           string       `json:"zoneID" valid:"required~Enter the ZoneID,hexadecimal~Invalid ZoneID"`
+	Sequence         uint64       `json:"sequence"`
+	Reserves         cTypes.Coins `json:"reserves"`
+	ReportHash       string       `json:"reportHash" valid:"required~Enter the ReportHash"`
+	AuditorPubKey    string       `json:"auditorPubKey" valid:"required~Enter the AuditorPubKey"`
+	AuditorSignature string       `json:"auditorSignature" valid:"required~Enter the AuditorSignature,base64~Invalid AuditorSignature"`
+	Password         string       `json:"password" valid:"required~Enter the Password"`
+	Mode             string       `json:"mode"`
+}
+
+func AttestReservesRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req attestReservesReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(reserveTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		zoneID, err := acl.GetZoneIDFromString(req.ZoneID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		auditorPubKey, err := cTypes.GetAccPubKeyBech32(req.AuditorPubKey)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		auditorSignature, err := base64.StdEncoding.DecodeString(req.AuditorSignature)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryZone", zoneID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query zone. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("Unauthorized transaction. Zone is not defined"))
+			return
+		}
+
+		msg := reserveTypes.BuildMsgAttestReserves(fromAddr, zoneID, req.Sequence, req.Reserves, req.ReportHash,
+			auditorPubKey, auditorSignature)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("ATRS")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
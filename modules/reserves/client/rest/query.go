@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+func QueryReserveAttestationsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", reserveTypes.QuerierRoute, "queryReserveAttestations", vars["zoneID"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query ReserveAttestations. Error: %s", err.Error()))
+			return
+		}
+
+		var attestations []reserveTypes.ReserveAttestation
+		cliCtx.Codec.MustUnmarshalJSON(res, &attestations)
+
+		rest.PostProcessResponse(w, cliCtx, attestations)
+	}
+}
+
+func QueryReserveRatioRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		vars := mux.Vars(r)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", reserveTypes.QuerierRoute, "queryReserveRatio", vars["zoneID"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query ReserveRatio. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var reserveRatio reserveTypes.ReserveRatio
+		cliCtx.Codec.MustUnmarshalJSON(res, &reserveRatio)
+
+		rest.PostProcessResponse(w, cliCtx, reserveRatio)
+	}
+}
+
+func QueryReserveRatiosRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", reserveTypes.QuerierRoute, "queryReserveRatios"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query ReserveRatios. Error: %s", err.Error()))
+			return
+		}
+
+		var reserveRatios []reserveTypes.ReserveRatio
+		cliCtx.Codec.MustUnmarshalJSON(res, &reserveRatios)
+
+		rest.PostProcessResponse(w, cliCtx, reserveRatios)
+	}
+}
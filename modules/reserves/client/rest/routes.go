@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/reserveAttestations/{zoneID}", QueryReserveAttestationsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reserveRatio/{zoneID}", QueryReserveRatioRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reserveRatios", QueryReserveRatiosRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/attestReserves", AttestReservesRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+	r.HandleFunc("/registerAuditor", RegisterAuditorRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
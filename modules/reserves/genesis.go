@@ -0,0 +1,42 @@
+package reserves
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	cmTypes "github.com/commitHub/commitBlockchain/types"
+)
+
+// InitGenesis : the fiat pegs held in accounts and escrows at genesis are counted as issued by their zones where the
+// zone reserves of the genesis don't cover them
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, accountKeeper AccountKeeper,
+	escrowKeepers []PegEscrowKeeper) {
+
+	for _, attestation := range data.ReserveAttestations {
+		keeper.SetReserveAttestation(ctx, attestation)
+	}
+	for _, zoneReserve := range data.ZoneReserves {
+		keeper.SetZoneReserve(ctx, zoneReserve)
+	}
+	for _, auditorAddress := range data.Auditors {
+		keeper.SetAuditor(ctx, auditorAddress)
+	}
+
+	var fiatPegWallet cmTypes.FiatPegWallet
+	accountKeeper.IterateAccounts(ctx, func(account exported.Account) bool {
+		fiatPegWallet = append(fiatPegWallet, account.GetFiatPegWallet()...)
+		return false
+	})
+	for _, escrowKeeper := range escrowKeepers {
+		fiatPegWallet = append(fiatPegWallet, escrowKeeper.GetEscrowedFiatPegWallet(ctx)...)
+	}
+	keeper.SeedIssuedFiat(ctx, fiatPegWallet)
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	attestations := keeper.GetReserveAttestations(ctx, nil)
+	zoneReserves := keeper.GetZoneReserves(ctx)
+	auditors := keeper.GetAuditors(ctx)
+
+	return GenesisState{ReserveAttestations: attestations, ZoneReserves: zoneReserves, Auditors: auditors}
+}
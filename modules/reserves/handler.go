@@ -0,0 +1,48 @@
+package reserves
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgAttestReserves:
+			return handleMsgAttestReserves(ctx, k, msg)
+		case MsgRegisterAuditors:
+			return handleMsgRegisterAuditors(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgAttestReserves(ctx cTypes.Context, k Keeper, msg MsgAttestReserves) cTypes.Result {
+	for _, attestReserves := range msg.AttestReserves {
+		if err := k.AttestReserves(ctx, attestReserves); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRegisterAuditors(ctx cTypes.Context, k Keeper, msg MsgRegisterAuditors) cTypes.Result {
+	for _, registerAuditor := range msg.RegisterAuditors {
+		if err := k.RegisterAuditor(ctx, registerAuditor); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
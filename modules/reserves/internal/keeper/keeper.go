@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+type Keeper struct {
+	storeKey  cTypes.StoreKey
+	cdc       *codec.Codec
+	aclKeeper reserveTypes.ACLKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, aclKeeper reserveTypes.ACLKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		aclKeeper: aclKeeper,
+	}
+}
+
+// reserves/{0x01}/{zoneID}/{sequence} => reserveAttestation
+func (k Keeper) SetReserveAttestation(ctx cTypes.Context, attestation reserveTypes.ReserveAttestation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(attestation)
+	store.Set(reserveTypes.GetReserveAttestationKey(attestation.ZoneID, attestation.Sequence), bz)
+}
+
+// returns the latest reserve attestation of the zone
+func (k Keeper) GetLatestReserveAttestation(ctx cTypes.Context, zoneID acl.ZoneID) (attestation reserveTypes.ReserveAttestation, err cTypes.Error) {
+	zoneReserve := k.GetZoneReserve(ctx, zoneID)
+	if zoneReserve.AttestationCount == 0 {
+		return attestation, reserveTypes.ErrInvalidAttestation(reserveTypes.DefaultCodeSpace, "")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(reserveTypes.GetReserveAttestationKey(zoneID, zoneReserve.AttestationCount-1))
+	if bz == nil {
+		return attestation, reserveTypes.ErrInvalidAttestation(reserveTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &attestation)
+	return attestation, nil
+}
+
+// get all reserve attestations of the zone, or of all zones if zoneID is nil => []ReserveAttestation from store
+func (k Keeper) GetReserveAttestations(ctx cTypes.Context, zoneID acl.ZoneID) (attestations []reserveTypes.ReserveAttestation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, reserveTypes.GetReserveAttestationsKey(zoneID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation reserveTypes.ReserveAttestation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &attestation)
+		if zoneID == nil || attestation.ZoneID.String() == zoneID.String() {
+			attestations = append(attestations, attestation)
+		}
+	}
+	return
+}
+
+// reserves/{0x02}/{zoneID} => zoneReserve
+func (k Keeper) SetZoneReserve(ctx cTypes.Context, zoneReserve reserveTypes.ZoneReserve) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(zoneReserve)
+	store.Set(reserveTypes.GetZoneReserveKey(zoneReserve.ZoneID), bz)
+}
+
+// returns the zone reserve, an empty one for zones that didn't issue fiat or attest reserves yet
+func (k Keeper) GetZoneReserve(ctx cTypes.Context, zoneID acl.ZoneID) (zoneReserve reserveTypes.ZoneReserve) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(reserveTypes.GetZoneReserveKey(zoneID))
+	if bz == nil {
+		return reserveTypes.NewZoneReserve(zoneID)
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &zoneReserve)
+	return zoneReserve
+}
+
+// get all zone reserves => []ZoneReserve from store
+func (k Keeper) GetZoneReserves(ctx cTypes.Context) (zoneReserves []reserveTypes.ZoneReserve) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, reserveTypes.ZoneReserveKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var zoneReserve reserveTypes.ZoneReserve
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &zoneReserve)
+		zoneReserves = append(zoneReserves, zoneReserve)
+	}
+	return
+}
+
+// reserves/{0x03}/{auditorAddress} => registered
+func (k Keeper) SetAuditor(ctx cTypes.Context, auditorAddress cTypes.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(reserveTypes.GetAuditorKey(auditorAddress), k.cdc.MustMarshalBinaryLengthPrefixed(true))
+}
+
+func (k Keeper) DeleteAuditor(ctx cTypes.Context, auditorAddress cTypes.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(reserveTypes.GetAuditorKey(auditorAddress))
+}
+
+func (k Keeper) IsAuditor(ctx cTypes.Context, auditorAddress cTypes.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(reserveTypes.GetAuditorKey(auditorAddress))
+}
+
+// get all registered auditors => []AccAddress from store
+func (k Keeper) GetAuditors(ctx cTypes.Context) (auditors []cTypes.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := cTypes.KVStorePrefixIterator(store, reserveTypes.AuditorKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		auditors = append(auditors, cTypes.AccAddress(iterator.Key()[len(reserveTypes.AuditorKey):]))
+	}
+	return
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryReserveAttestations = "queryReserveAttestations"
+	QueryReserveRatio        = "queryReserveRatio"
+	QueryReserveRatios       = "queryReserveRatios"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryReserveAttestations:
+			return queryReserveAttestations(ctx, path[1:], k)
+		case QueryReserveRatio:
+			return queryReserveRatio(ctx, path[1:], k)
+		case QueryReserveRatios:
+			return queryReserveRatios(ctx, k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown reserves query endpoint")
+		}
+	}
+}
+
+// queryReserveAttestations : reserve attestations of the zone, oldest first
+func queryReserveAttestations(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	zoneID, errRes := acl.GetZoneIDFromString(path[0])
+	if errRes != nil {
+		return nil, reserveTypes.ErrInvalidAttestation(reserveTypes.DefaultCodeSpace, fmt.Sprintf("invalid zoneID %s", path[0]))
+	}
+
+	attestations := k.GetReserveAttestations(ctx, zoneID)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, attestations)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryReserveRatio(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	zoneID, errRes := acl.GetZoneIDFromString(path[0])
+	if errRes != nil {
+		return nil, reserveTypes.ErrInvalidAttestation(reserveTypes.DefaultCodeSpace, fmt.Sprintf("invalid zoneID %s", path[0]))
+	}
+
+	reserveRatio := k.GetReserveRatio(ctx, zoneID)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, reserveRatio)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryReserveRatios(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	reserveRatios := k.GetReserveRatios(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, reserveRatios)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
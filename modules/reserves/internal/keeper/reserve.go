@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+// AttestReserves : records the reserves of a zone signed by its auditor, it replaces the previous attestation as the issuance cap
+func (k Keeper) AttestReserves(ctx cTypes.Context, attestReserves reserveTypes.AttestReserves) cTypes.Error {
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, attestReserves.ZoneID, attestReserves.FromAddress) {
+		return reserveTypes.ErrUnauthorized(reserveTypes.DefaultCodeSpace)
+	}
+
+	if !k.IsAuditor(ctx, attestReserves.GetAuditorAddress()) {
+		return reserveTypes.ErrUnregisteredAuditor(reserveTypes.DefaultCodeSpace, attestReserves.GetAuditorAddress())
+	}
+
+	zoneReserve := k.GetZoneReserve(ctx, attestReserves.ZoneID)
+	if attestReserves.Sequence != zoneReserve.AttestationCount {
+		return reserveTypes.ErrInvalidAttestation(reserveTypes.DefaultCodeSpace,
+			fmt.Sprintf("expected attestation sequence %d, got %d", zoneReserve.AttestationCount, attestReserves.Sequence))
+	}
+
+	attestation := reserveTypes.NewReserveAttestation(attestReserves.ZoneID, attestReserves.Sequence, attestReserves.Reserves,
+		attestReserves.ReportHash, attestReserves.GetAuditorAddress(), attestReserves.AuditorSignature, ctx.BlockHeight())
+	k.SetReserveAttestation(ctx, attestation)
+
+	zoneReserve.AttestationCount++
+	k.SetZoneReserve(ctx, zoneReserve)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(reserveTypes.EventTypeAttestReserves,
+			cTypes.NewAttribute(reserveTypes.AttributeKeyZoneID, attestation.ZoneID.String()),
+			cTypes.NewAttribute(reserveTypes.AttributeKeySequence, strconv.FormatUint(attestation.Sequence, 10)),
+			cTypes.NewAttribute(reserveTypes.AttributeKeyReserves, attestation.Reserves.String()),
+			cTypes.NewAttribute(reserveTypes.AttributeKeyReportHash, attestation.ReportHash),
+			cTypes.NewAttribute(reserveTypes.AttributeKeyAuditorAddress, attestation.AuditorAddress.String()),
+		))
+
+	return nil
+}
+
+// RegisterAuditor : genesis account registers an auditor whose signatures are accepted on reserve attestations, or removes it
+func (k Keeper) RegisterAuditor(ctx cTypes.Context, registerAuditor reserveTypes.RegisterAuditor) cTypes.Error {
+	if !k.aclKeeper.CheckValidGenesisAddress(ctx, registerAuditor.FromAddress) {
+		return reserveTypes.ErrUnauthorized(reserveTypes.DefaultCodeSpace)
+	}
+
+	if registerAuditor.Registered {
+		k.SetAuditor(ctx, registerAuditor.AuditorAddress)
+	} else {
+		k.DeleteAuditor(ctx, registerAuditor.AuditorAddress)
+	}
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(reserveTypes.EventTypeRegisterAuditor,
+			cTypes.NewAttribute(reserveTypes.AttributeKeyAuditorAddress, registerAuditor.AuditorAddress.String()),
+			cTypes.NewAttribute(reserveTypes.AttributeKeyRegistered, strconv.FormatBool(registerAuditor.Registered)),
+		))
+
+	return nil
+}
+
+// AddIssuedFiat : counts fiat issued by the zone, refusing it when outstanding fiat of its currency would exceed the
+// reserve of that currency in the latest attestation. Zones that never attested their reserves can't issue.
+func (k Keeper) AddIssuedFiat(ctx cTypes.Context, zoneID acl.ZoneID, issued cTypes.Coin) cTypes.Error {
+	zoneReserve := k.GetZoneReserve(ctx, zoneID)
+	reserve := cTypes.ZeroInt()
+	if zoneReserve.AttestationCount > 0 {
+		attestation, err := k.GetLatestReserveAttestation(ctx, zoneID)
+		if err != nil {
+			return err
+		}
+		reserve = attestation.Reserves.AmountOf(issued.Denom)
+	}
+	outstanding := zoneReserve.Outstanding().AmountOf(issued.Denom).Add(issued.Amount)
+	if outstanding.GT(reserve) {
+		return reserveTypes.ErrReserveExceeded(reserveTypes.DefaultCodeSpace,
+			fmt.Sprintf("issuing %s would take outstanding fiat of zone %s to %s%s over its attested reserve of %s%s",
+				issued.String(), zoneID.String(), outstanding.String(), issued.Denom, reserve.String(), issued.Denom))
+	}
+
+	zoneReserve.Issued = zoneReserve.Issued.Add(cTypes.Coins{issued})
+	k.SetZoneReserve(ctx, zoneReserve)
+	return nil
+}
+
+// AddRedeemedFiat : counts fiat issued by the zone and paid out to its redeemers
+func (k Keeper) AddRedeemedFiat(ctx cTypes.Context, zoneID acl.ZoneID, redeemed cTypes.Coin) {
+	zoneReserve := k.GetZoneReserve(ctx, zoneID)
+	zoneReserve.Redeemed = zoneReserve.Redeemed.Add(cTypes.Coins{redeemed})
+	k.SetZoneReserve(ctx, zoneReserve)
+}
+
+// SeedIssuedFiat : counts the fiat pegs held at genesis as issued by their zones where the zone reserves don't cover
+// them yet, so fiat issued before the zone reserves were recorded stays conserved
+func (k Keeper) SeedIssuedFiat(ctx cTypes.Context, fiatPegWallet types.FiatPegWallet) {
+	var zoneIDs []string
+	zoneFiatPegWallets := make(map[string]types.FiatPegWallet)
+	for _, fiatPeg := range fiatPegWallet {
+		zoneID := fiatPeg.GetZoneID().String()
+		if _, found := zoneFiatPegWallets[zoneID]; !found {
+			zoneIDs = append(zoneIDs, zoneID)
+		}
+		zoneFiatPegWallets[zoneID] = append(zoneFiatPegWallets[zoneID], fiatPeg)
+	}
+	sort.Strings(zoneIDs)
+
+	for _, zoneID := range zoneIDs {
+		held := types.GetFiatPegWalletCoins(zoneFiatPegWallets[zoneID])
+		if held.Empty() {
+			continue
+		}
+
+		zoneReserve := k.GetZoneReserve(ctx, acl.ZoneID(zoneFiatPegWallets[zoneID][0].GetZoneID()))
+		unissued, _ := held.SafeSub(zoneReserve.Outstanding())
+		for _, coin := range unissued {
+			if coin.IsPositive() {
+				zoneReserve.Issued = zoneReserve.Issued.Add(cTypes.Coins{coin})
+			}
+		}
+		k.SetZoneReserve(ctx, zoneReserve)
+	}
+}
+
+// GetReserveRatio : latest attested reserves of the zone against its outstanding fiat, per currency
+func (k Keeper) GetReserveRatio(ctx cTypes.Context, zoneID acl.ZoneID) reserveTypes.ReserveRatio {
+	attestation, _ := k.GetLatestReserveAttestation(ctx, zoneID)
+	return reserveTypes.NewReserveRatio(k.GetZoneReserve(ctx, zoneID), attestation)
+}
+
+// GetReserveRatios : reserve ratios of all zones that issued fiat or attested reserves
+func (k Keeper) GetReserveRatios(ctx cTypes.Context) (reserveRatios []reserveTypes.ReserveRatio) {
+	for _, zoneReserve := range k.GetZoneReserves(ctx) {
+		attestation, _ := k.GetLatestReserveAttestation(ctx, zoneReserve.ZoneID)
+		reserveRatios = append(reserveRatios, reserveTypes.NewReserveRatio(zoneReserve, attestation))
+	}
+	return
+}
+
+// GetTotalOutstandingFiat : fiat issued minus redeemed over all zones, per currency
+func (k Keeper) GetTotalOutstandingFiat(ctx cTypes.Context) cTypes.Coins {
+	outstanding := cTypes.Coins{}
+	for _, zoneReserve := range k.GetZoneReserves(ctx) {
+		outstanding = outstanding.Add(zoneReserve.Outstanding())
+	}
+	return outstanding
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/types"
+
+	reserveTypes "github.com/commitHub/commitBlockchain/modules/reserves/internal/types"
+)
+
+type testACLKeeper struct {
+	zone    cTypes.AccAddress
+	genesis cTypes.AccAddress
+}
+
+func (ak testACLKeeper) CheckValidZoneAddress(_ cTypes.Context, _ acl.ZoneID, address cTypes.AccAddress) bool {
+	return address.Equals(ak.zone)
+}
+
+func (ak testACLKeeper) CheckValidGenesisAddress(_ cTypes.Context, address cTypes.AccAddress) bool {
+	return address.Equals(ak.genesis)
+}
+
+type testInput struct {
+	ctx       cTypes.Context
+	k         Keeper
+	aclKeeper testACLKeeper
+	zoneID    acl.ZoneID
+}
+
+func coins(coinsStr string) cTypes.Coins {
+	parsed, err := cTypes.ParseCoins(coinsStr)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func setupTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+	key := cTypes.NewKVStoreKey(reserveTypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, cTypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	reserveTypes.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	aclKeeper := testACLKeeper{zone: cTypes.AccAddress([]byte("zone")), genesis: cTypes.AccAddress([]byte("genesis"))}
+	k := NewKeeper(key, cdc, aclKeeper)
+	ctx := cTypes.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+
+	return testInput{ctx, k, aclKeeper, acl.ZoneID([]byte("zone"))}
+}
+
+// attest : the zone attests its reserves signed by a registered auditor
+func (input testInput) attest(t *testing.T, reserves cTypes.Coins) {
+	auditorPubKey := secp256k1.GenPrivKey().PubKey()
+	require.NoError(t, input.k.RegisterAuditor(input.ctx, reserveTypes.NewRegisterAuditor(input.aclKeeper.genesis,
+		cTypes.AccAddress(auditorPubKey.Address()), true)))
+
+	sequence := input.k.GetZoneReserve(input.ctx, input.zoneID).AttestationCount
+	require.NoError(t, input.k.AttestReserves(input.ctx, reserveTypes.NewAttestReserves(input.aclKeeper.zone, input.zoneID,
+		sequence, reserves, "report", auditorPubKey, []byte("signature"))))
+}
+
+func TestAttestReservesRequiresARegisteredAuditor(t *testing.T) {
+	input := setupTestInput(t)
+	auditorPubKey := secp256k1.GenPrivKey().PubKey()
+	auditorAddress := cTypes.AccAddress(auditorPubKey.Address())
+	attestReserves := reserveTypes.NewAttestReserves(input.aclKeeper.zone, input.zoneID, 0, coins("100usd"), "report",
+		auditorPubKey, []byte("signature"))
+
+	require.Error(t, input.k.AttestReserves(input.ctx, attestReserves))
+
+	// only the genesis account registers auditors
+	require.Error(t, input.k.RegisterAuditor(input.ctx, reserveTypes.NewRegisterAuditor(input.aclKeeper.zone, auditorAddress, true)))
+	require.NoError(t, input.k.RegisterAuditor(input.ctx, reserveTypes.NewRegisterAuditor(input.aclKeeper.genesis, auditorAddress, true)))
+	require.Equal(t, []cTypes.AccAddress{auditorAddress}, input.k.GetAuditors(input.ctx))
+	require.NoError(t, input.k.AttestReserves(input.ctx, attestReserves))
+
+	require.NoError(t, input.k.RegisterAuditor(input.ctx, reserveTypes.NewRegisterAuditor(input.aclKeeper.genesis, auditorAddress, false)))
+	attestReserves.Sequence = 1
+	require.Error(t, input.k.AttestReserves(input.ctx, attestReserves))
+}
+
+func TestIssuedFiatIsCappedPerCurrency(t *testing.T) {
+	input := setupTestInput(t)
+
+	// zones that never attested their reserves can't issue
+	require.Error(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 1)))
+
+	input.attest(t, coins("50eur,100usd"))
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 80)))
+
+	require.Error(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 30)))
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 20)))
+
+	// the reserve of one currency doesn't cover another
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("eur", 50)))
+	require.Error(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("eur", 1)))
+	require.Error(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("gbp", 1)))
+
+	// redeemed fiat frees the reserve of its currency only
+	input.k.AddRedeemedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 30))
+	require.Error(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("eur", 1)))
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 30)))
+
+	require.True(t, coins("50eur,100usd").IsEqual(input.k.GetTotalOutstandingFiat(input.ctx)))
+}
+
+func TestReserveRatioPerCurrency(t *testing.T) {
+	input := setupTestInput(t)
+	input.attest(t, coins("50eur,100usd"))
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 50)))
+
+	reserveRatio := input.k.GetReserveRatio(input.ctx, input.zoneID)
+	require.True(t, coins("50usd").IsEqual(reserveRatio.Outstanding))
+	require.Len(t, reserveRatio.Ratios, 1)
+	require.Equal(t, cTypes.NewDec(2), reserveRatio.Ratios.AmountOf("usd"))
+}
+
+func TestSeedIssuedFiatFromGenesisPegs(t *testing.T) {
+	input := setupTestInput(t)
+	otherZoneID := acl.ZoneID([]byte("other"))
+	input.attest(t, coins("100usd"))
+	require.NoError(t, input.k.AddIssuedFiat(input.ctx, input.zoneID, cTypes.NewInt64Coin("usd", 30)))
+
+	input.k.SeedIssuedFiat(input.ctx, types.FiatPegWallet{
+		{PegHash: types.PegHash([]byte("a")), TransactionAmount: 50, ZoneID: input.zoneID.Bytes(), Currency: "usd"},
+		{PegHash: types.PegHash([]byte("b")), TransactionAmount: 20, ZoneID: input.zoneID.Bytes(), Currency: "eur"},
+		{PegHash: types.PegHash([]byte("c")), TransactionAmount: 40, ZoneID: otherZoneID.Bytes(), Currency: "usd"},
+		{PegHash: types.PegHash([]byte("d")), TransactionAmount: 60, ZoneID: otherZoneID.Bytes()},
+	})
+
+	// fiat the zone reserve already counts is not seeded twice, pegs without a currency are not counted
+	require.True(t, coins("20eur,50usd").IsEqual(input.k.GetZoneReserve(input.ctx, input.zoneID).Issued))
+	require.True(t, coins("40usd").IsEqual(input.k.GetZoneReserve(input.ctx, otherZoneID).Issued))
+	require.True(t, coins("20eur,90usd").IsEqual(input.k.GetTotalOutstandingFiat(input.ctx)))
+}
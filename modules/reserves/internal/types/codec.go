@@ -0,0 +1,17 @@
+package types
+
+import "github.com/commitHub/commitBlockchain/codec"
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgAttestReserves{}, "commit-blockchain/MsgAttestReserves", nil)
+	cdc.RegisterConcrete(MsgRegisterAuditors{}, "commit-blockchain/MsgRegisterAuditors", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
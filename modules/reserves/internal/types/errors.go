@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidAttestation   cTypes.CodeType = 1301
+	CodeReserveExceeded      cTypes.CodeType = 1302
+	CodeUnauthorized         cTypes.CodeType = 1303
+	CodeInvalidInputsOutputs cTypes.CodeType = 1304
+	CodeUnregisteredAuditor  cTypes.CodeType = 1305
+)
+
+func ErrInvalidAttestation(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidAttestation, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidAttestation, "reserve attestation doesn't exist")
+}
+
+func ErrReserveExceeded(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeReserveExceeded, msg)
+	}
+	return cTypes.NewError(codespace, CodeReserveExceeded, "outstanding fiat would exceed the attested reserve")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
+
+func ErrUnregisteredAuditor(codeSpace cTypes.CodespaceType, auditorAddress cTypes.AccAddress) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnregisteredAuditor, fmt.Sprintf("auditor %s is not registered", auditorAddress.String()))
+}
@@ -0,0 +1,13 @@
+package types
+
+var (
+	EventTypeAttestReserves  = "attestReserves"
+	EventTypeRegisterAuditor = "registerAuditor"
+
+	AttributeKeyZoneID         = "zoneID"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyReserves       = "reserves"
+	AttributeKeyReportHash     = "reportHash"
+	AttributeKeyAuditorAddress = "auditorAddress"
+	AttributeKeyRegistered     = "registered"
+)
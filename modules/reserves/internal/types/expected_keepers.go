@@ -0,0 +1,24 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+	"github.com/commitHub/commitBlockchain/types"
+)
+
+type ACLKeeper interface {
+	CheckValidZoneAddress(ctx cTypes.Context, id acl.ZoneID, address cTypes.AccAddress) bool
+	CheckValidGenesisAddress(ctx cTypes.Context, address cTypes.AccAddress) bool
+}
+
+// AccountKeeper : accounts holding the fiat pegs at genesis
+type AccountKeeper interface {
+	IterateAccounts(ctx cTypes.Context, process func(exported.Account) bool)
+}
+
+// PegEscrowKeeper : keeper of a module holding fiat pegs outside of account wallets
+type PegEscrowKeeper interface {
+	GetEscrowedFiatPegWallet(ctx cTypes.Context) types.FiatPegWallet
+}
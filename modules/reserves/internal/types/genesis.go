@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisState struct {
+	ReserveAttestations []ReserveAttestation `json:"reserveAttestations"`
+	ZoneReserves        []ZoneReserve        `json:"zoneReserves"`
+	Auditors            []cTypes.AccAddress  `json:"auditors"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	attestationCounts := make(map[string]uint64)
+	for _, attestation := range data.ReserveAttestations {
+		if err := attestation.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid reserve attestation in genesis: %s", err.Error())
+		}
+		attestationCounts[attestation.ZoneID.String()]++
+	}
+
+	zoneIDs := make(map[string]bool)
+	for _, zoneReserve := range data.ZoneReserves {
+		if err := zoneReserve.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid zone reserve in genesis: %s", err.Error())
+		}
+		if zoneIDs[zoneReserve.ZoneID.String()] {
+			return fmt.Errorf("duplicate zone reserve %s in genesis", zoneReserve.ZoneID.String())
+		}
+		zoneIDs[zoneReserve.ZoneID.String()] = true
+		if attestationCounts[zoneReserve.ZoneID.String()] != zoneReserve.AttestationCount {
+			return fmt.Errorf("zone reserve %s doesn't match its reserve attestations in genesis", zoneReserve.ZoneID.String())
+		}
+	}
+	for _, auditorAddress := range data.Auditors {
+		if len(auditorAddress) == 0 {
+			return fmt.Errorf("empty auditor address in genesis")
+		}
+	}
+	for zoneID := range attestationCounts {
+		if !zoneIDs[zoneID] {
+			return fmt.Errorf("reserve attestations of zone %s have no zone reserve in genesis", zoneID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/binary"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+const (
+	ModuleName   = "reserves"
+	StoreKey     = ModuleName
+	RouterKey    = StoreKey
+	QuerierRoute = RouterKey
+)
+
+var (
+	ReserveAttestationKey = []byte{0x01}
+	ZoneReserveKey        = []byte{0x02}
+	AuditorKey            = []byte{0x03}
+)
+
+// reserves/{0x01}/{zoneID}/{sequence}
+func GetReserveAttestationKey(zoneID acl.ZoneID, sequence uint64) []byte {
+	return append(GetReserveAttestationsKey(zoneID), GetSequenceBytes(sequence)...)
+}
+
+// reserves/{0x01}/{zoneID}
+func GetReserveAttestationsKey(zoneID acl.ZoneID) []byte {
+	return append(ReserveAttestationKey, zoneID.Bytes()...)
+}
+
+// reserves/{0x02}/{zoneID}
+func GetZoneReserveKey(zoneID acl.ZoneID) []byte {
+	return append(ZoneReserveKey, zoneID.Bytes()...)
+}
+
+// reserves/{0x03}/{auditorAddress}
+func GetAuditorKey(auditorAddress cTypes.AccAddress) []byte {
+	return append(AuditorKey, auditorAddress.Bytes()...)
+}
+
+func GetSequenceBytes(sequence uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	return bz
+}
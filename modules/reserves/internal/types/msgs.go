@@ -0,0 +1,258 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// *****AttestReserves
+
+// AttestReserves : zone posts the reserves held in its bank accounts along with the signature of its auditor over them
+type AttestReserves struct {
+	FromAddress      cTypes.AccAddress `json:"fromAddress"`
+	ZoneID           acl.ZoneID        `json:"zoneID"`
+	Sequence         uint64            `json:"sequence"`
+	Reserves         cTypes.Coins      `json:"reserves"`
+	ReportHash       string            `json:"reportHash"`
+	AuditorPubKey    crypto.PubKey     `json:"auditorPubKey"`
+	AuditorSignature []byte            `json:"auditorSignature"`
+}
+
+// NewAttestReserves : initializer
+func NewAttestReserves(fromAddress cTypes.AccAddress, zoneID acl.ZoneID, sequence uint64, reserves cTypes.Coins,
+	reportHash string, auditorPubKey crypto.PubKey, auditorSignature []byte) AttestReserves {
+
+	return AttestReserves{fromAddress, zoneID, sequence, reserves, reportHash, auditorPubKey, auditorSignature}
+}
+
+// GetSignBytes : get bytes to sign
+func (in AttestReserves) GetSignBytes() []byte {
+	auditorPubKey := ""
+	if in.AuditorPubKey != nil {
+		auditorPubKey = cTypes.MustBech32ifyAccPub(in.AuditorPubKey)
+	}
+
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress      string       `json:"fromAddress"`
+		ZoneID           string       `json:"zoneID"`
+		Sequence         uint64       `json:"sequence"`
+		Reserves         cTypes.Coins `json:"reserves"`
+		ReportHash       string       `json:"reportHash"`
+		AuditorPubKey    string       `json:"auditorPubKey"`
+		AuditorSignature []byte       `json:"auditorSignature"`
+	}{
+		FromAddress:      in.FromAddress.String(),
+		ZoneID:           in.ZoneID.String(),
+		Sequence:         in.Sequence,
+		Reserves:         in.Reserves,
+		ReportHash:       in.ReportHash,
+		AuditorPubKey:    auditorPubKey,
+		AuditorSignature: in.AuditorSignature,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in AttestReserves) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.ZoneID) == 0 {
+		return ErrInvalidAttestation(DefaultCodeSpace, "ZoneID should not be empty.")
+	} else if in.Reserves.Empty() || !in.Reserves.IsValid() {
+		return cTypes.ErrInvalidCoins(in.Reserves.String())
+	} else if len(in.ReportHash) == 0 {
+		return ErrInvalidAttestation(DefaultCodeSpace, "ReportHash should not be empty.")
+	} else if in.AuditorPubKey == nil || len(in.AuditorSignature) == 0 {
+		return ErrInvalidAttestation(DefaultCodeSpace, "Attestation should be signed by an auditor.")
+	} else if in.GetAuditorAddress().Equals(in.FromAddress) {
+		return ErrInvalidAttestation(DefaultCodeSpace, "Zone can't audit its own reserves.")
+	} else if !in.AuditorPubKey.VerifyBytes(AttestationSignBytes(in.ZoneID, in.Sequence, in.Reserves, in.ReportHash), in.AuditorSignature) {
+		return ErrInvalidAttestation(DefaultCodeSpace, "Auditor signature doesn't match the attestation.")
+	}
+	return nil
+}
+
+// GetAuditorAddress : address of the auditor signing the attestation
+func (in AttestReserves) GetAuditorAddress() cTypes.AccAddress {
+	return cTypes.AccAddress(in.AuditorPubKey.Address())
+}
+
+// MsgAttestReserves : high level reserve attestation of reserves module
+type MsgAttestReserves struct {
+	AttestReserves []AttestReserves `json:"attestReserves"`
+}
+
+// NewMsgAttestReserves : initializer
+func NewMsgAttestReserves(attestReserves []AttestReserves) MsgAttestReserves {
+	return MsgAttestReserves{attestReserves}
+}
+
+var _ cTypes.Msg = MsgAttestReserves{}
+
+// Type : implements msg
+func (msg MsgAttestReserves) Type() string { return "attestReserves" }
+
+func (msg MsgAttestReserves) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgAttestReserves) ValidateBasic() cTypes.Error {
+	if len(msg.AttestReserves) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.AttestReserves {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgAttestReserves) GetSignBytes() []byte {
+	var attestReserves []json.RawMessage
+	for _, attestReserve := range msg.AttestReserves {
+		attestReserves = append(attestReserves, attestReserve.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		AttestReserves []json.RawMessage `json:"attestReserves"`
+	}{
+		AttestReserves: attestReserves,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgAttestReserves) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.AttestReserves))
+	for i, in := range msg.AttestReserves {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgAttestReserves : build the MsgAttestReserves
+func BuildMsgAttestReserves(fromAddress cTypes.AccAddress, zoneID acl.ZoneID, sequence uint64, reserves cTypes.Coins,
+	reportHash string, auditorPubKey crypto.PubKey, auditorSignature []byte) cTypes.Msg {
+
+	attestReserves := NewAttestReserves(fromAddress, zoneID, sequence, reserves, reportHash, auditorPubKey, auditorSignature)
+	msg := NewMsgAttestReserves([]AttestReserves{attestReserves})
+	return msg
+}
+
+// #####AttestReserves
+
+// *****RegisterAuditor
+
+// RegisterAuditor : genesis account registers an auditor whose signatures are accepted on reserve attestations, or removes it
+type RegisterAuditor struct {
+	FromAddress    cTypes.AccAddress `json:"fromAddress"`
+	AuditorAddress cTypes.AccAddress `json:"auditorAddress"`
+	Registered     bool              `json:"registered"`
+}
+
+// NewRegisterAuditor : initializer
+func NewRegisterAuditor(fromAddress cTypes.AccAddress, auditorAddress cTypes.AccAddress, registered bool) RegisterAuditor {
+	return RegisterAuditor{fromAddress, auditorAddress, registered}
+}
+
+// GetSignBytes : get bytes to sign
+func (in RegisterAuditor) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress    string `json:"fromAddress"`
+		AuditorAddress string `json:"auditorAddress"`
+		Registered     bool   `json:"registered"`
+	}{
+		FromAddress:    in.FromAddress.String(),
+		AuditorAddress: in.AuditorAddress.String(),
+		Registered:     in.Registered,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in RegisterAuditor) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.AuditorAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.AuditorAddress.String())
+	}
+	return nil
+}
+
+// MsgRegisterAuditors : high level auditor registration of reserves module
+type MsgRegisterAuditors struct {
+	RegisterAuditors []RegisterAuditor `json:"registerAuditors"`
+}
+
+// NewMsgRegisterAuditors : initializer
+func NewMsgRegisterAuditors(registerAuditors []RegisterAuditor) MsgRegisterAuditors {
+	return MsgRegisterAuditors{registerAuditors}
+}
+
+var _ cTypes.Msg = MsgRegisterAuditors{}
+
+// Type : implements msg
+func (msg MsgRegisterAuditors) Type() string { return "registerAuditors" }
+
+func (msg MsgRegisterAuditors) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgRegisterAuditors) ValidateBasic() cTypes.Error {
+	if len(msg.RegisterAuditors) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.RegisterAuditors {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgRegisterAuditors) GetSignBytes() []byte {
+	var registerAuditors []json.RawMessage
+	for _, registerAuditor := range msg.RegisterAuditors {
+		registerAuditors = append(registerAuditors, registerAuditor.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		RegisterAuditors []json.RawMessage `json:"registerAuditors"`
+	}{
+		RegisterAuditors: registerAuditors,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgRegisterAuditors) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.RegisterAuditors))
+	for i, in := range msg.RegisterAuditors {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgRegisterAuditor : build the MsgRegisterAuditors
+func BuildMsgRegisterAuditor(fromAddress cTypes.AccAddress, auditorAddress cTypes.AccAddress, registered bool) cTypes.Msg {
+	registerAuditor := NewRegisterAuditor(fromAddress, auditorAddress, registered)
+	msg := NewMsgRegisterAuditors([]RegisterAuditor{registerAuditor})
+	return msg
+}
+
+// #####RegisterAuditor
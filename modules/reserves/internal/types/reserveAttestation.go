@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// ReserveAttestation : reserves held by a zone in its bank accounts, signed by an auditor
+type ReserveAttestation struct {
+	ZoneID           acl.ZoneID        `json:"zoneID"`
+	Sequence         uint64            `json:"sequence"`
+	Reserves         cTypes.Coins      `json:"reserves"`
+	ReportHash       string            `json:"reportHash"`
+	AuditorAddress   cTypes.AccAddress `json:"auditorAddress"`
+	AuditorSignature []byte            `json:"auditorSignature"`
+	Height           int64             `json:"height"`
+}
+
+func NewReserveAttestation(zoneID acl.ZoneID, sequence uint64, reserves cTypes.Coins, reportHash string,
+	auditorAddress cTypes.AccAddress, auditorSignature []byte, height int64) ReserveAttestation {
+
+	return ReserveAttestation{
+		ZoneID:           zoneID,
+		Sequence:         sequence,
+		Reserves:         reserves,
+		ReportHash:       reportHash,
+		AuditorAddress:   auditorAddress,
+		AuditorSignature: auditorSignature,
+		Height:           height,
+	}
+}
+
+func (attestation ReserveAttestation) ValidateBasic() error {
+	if len(attestation.ZoneID) == 0 {
+		return fmt.Errorf("zoneID should not be empty")
+	}
+	if attestation.Reserves.Empty() || !attestation.Reserves.IsValid() {
+		return fmt.Errorf("invalid reserves %s", attestation.Reserves.String())
+	}
+	if len(attestation.ReportHash) == 0 {
+		return fmt.Errorf("reportHash should not be empty")
+	}
+	if len(attestation.AuditorAddress) == 0 || len(attestation.AuditorSignature) == 0 {
+		return fmt.Errorf("attestation should be signed by an auditor")
+	}
+	return nil
+}
+
+// AttestationSignBytes : bytes an auditor signs to attest the reserves of a zone
+func AttestationSignBytes(zoneID acl.ZoneID, sequence uint64, reserves cTypes.Coins, reportHash string) []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		ZoneID     string       `json:"zoneID"`
+		Sequence   uint64       `json:"sequence"`
+		Reserves   cTypes.Coins `json:"reserves"`
+		ReportHash string       `json:"reportHash"`
+	}{
+		ZoneID:     zoneID.String(),
+		Sequence:   sequence,
+		Reserves:   reserves,
+		ReportHash: reportHash,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return cTypes.MustSortJSON(bin)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+// ZoneReserve : fiat issued and redeemed by a zone per currency, and the number of reserve attestations it posted
+type ZoneReserve struct {
+	ZoneID           acl.ZoneID   `json:"zoneID"`
+	Issued           cTypes.Coins `json:"issued"`
+	Redeemed         cTypes.Coins `json:"redeemed"`
+	AttestationCount uint64       `json:"attestationCount"`
+}
+
+func NewZoneReserve(zoneID acl.ZoneID) ZoneReserve {
+	return ZoneReserve{ZoneID: zoneID, Issued: cTypes.Coins{}, Redeemed: cTypes.Coins{}}
+}
+
+// Outstanding : fiat issued by the zone that is not redeemed yet, per currency
+func (zoneReserve ZoneReserve) Outstanding() cTypes.Coins {
+	outstanding, _ := zoneReserve.Issued.SafeSub(zoneReserve.Redeemed)
+	return outstanding
+}
+
+func (zoneReserve ZoneReserve) ValidateBasic() error {
+	if len(zoneReserve.ZoneID) == 0 {
+		return fmt.Errorf("zoneID should not be empty")
+	}
+	if !zoneReserve.Issued.IsValid() || !zoneReserve.Redeemed.IsValid() || zoneReserve.Outstanding().IsAnyNegative() {
+		return fmt.Errorf("invalid issued %s and redeemed %s amounts of zone %s", zoneReserve.Issued.String(),
+			zoneReserve.Redeemed.String(), zoneReserve.ZoneID.String())
+	}
+	return nil
+}
+
+// ReserveRatio : latest attested reserves of a zone against its outstanding fiat, per currency
+type ReserveRatio struct {
+	ZoneID            acl.ZoneID      `json:"zoneID"`
+	Reserves          cTypes.Coins    `json:"reserves"`
+	Outstanding       cTypes.Coins    `json:"outstanding"`
+	Ratios            cTypes.DecCoins `json:"ratios"`
+	AttestationHeight int64           `json:"attestationHeight"`
+}
+
+// NewReserveRatio : the reserve of each outstanding currency over its outstanding amount, currencies with nothing
+// outstanding have no ratio
+func NewReserveRatio(zoneReserve ZoneReserve, attestation ReserveAttestation) ReserveRatio {
+	outstanding := zoneReserve.Outstanding()
+	ratios := cTypes.DecCoins{}
+	for _, coin := range outstanding {
+		if coin.IsPositive() {
+			ratios = append(ratios, cTypes.NewDecCoinFromDec(coin.Denom,
+				attestation.Reserves.AmountOf(coin.Denom).ToDec().QuoInt(coin.Amount)))
+		}
+	}
+
+	return ReserveRatio{
+		ZoneID:            zoneReserve.ZoneID,
+		Reserves:          attestation.Reserves,
+		Outstanding:       outstanding,
+		Ratios:            ratios,
+		AttestationHeight: attestation.Height,
+	}
+}
+
+func (reserveRatio ReserveRatio) String() string {
+	return fmt.Sprintf(`ReserveRatio:
+ZoneID: %s,
+Reserves: %s,
+Outstanding: %s,
+Ratios: %s,
+AttestationHeight: %d,
+`, reserveRatio.ZoneID.String(), reserveRatio.Reserves.String(), reserveRatio.Outstanding.String(),
+		reserveRatio.Ratios.String(), reserveRatio.AttestationHeight)
+}
@@ -0,0 +1,60 @@
+package tradeFees
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/tradeFees/internal/keeper"
+	"github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+const (
+	StoreKey          = types.StoreKey
+	ModuleName        = types.ModuleName
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+
+	DefaultCodeSpace = types.DefaultCodeSpace
+
+	MsgTypeSendAsset    = types.MsgTypeSendAsset
+	MsgTypeExecuteOrder = types.MsgTypeExecuteOrder
+
+	QueryTradeFees = keeper.QueryTradeFees
+	QueryParams    = keeper.QueryParams
+)
+
+var (
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
+
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	ParamKeyTable       = types.ParamKeyTable
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	ValidateParams      = types.ValidateParams
+
+	NewQuerier = keeper.NewQuerier
+	NewKeeper  = keeper.NewKeeper
+
+	NewTradeFee = types.NewTradeFee
+
+	ErrInvalidTradeFee = types.ErrInvalidTradeFee
+	ErrUnauthorized    = types.ErrUnauthorized
+
+	BuildMsgSetTradeFees = types.BuildMsgSetTradeFees
+
+	EventTypeSetTradeFee = types.EventTypeSetTradeFee
+)
+
+type (
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	Keeper       = keeper.Keeper
+
+	AccountKeeper      = types.AccountKeeper
+	DistributionKeeper = types.DistributionKeeper
+
+	TradeFee = types.TradeFee
+
+	MsgSetTradeFees = types.MsgSetTradeFees
+	SetTradeFee     = types.SetTradeFee
+)
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// noLint
+const (
+	FlagZoneID            = "zone-id"
+	FlagMsgType           = "msg-type"
+	FlagAssetType         = "asset-type"
+	FlagRate              = "rate"
+	FlagFlatAmount        = "flat-amount"
+	FlagZoneShare         = "zone-share"
+	FlagOrganizationShare = "organization-share"
+)
+
+var (
+	fsZoneID            = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMsgType           = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAssetType         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRate              = flag.NewFlagSet("", flag.ContinueOnError)
+	fsFlatAmount        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsZoneShare         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsOrganizationShare = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsZoneID.String(FlagZoneID, "", "ZoneID of the zone charging the fee")
+	fsMsgType.String(FlagMsgType, "", "Trade message charged, sendAsset or executeOrder")
+	fsAssetType.String(FlagAssetType, "", "Asset type charged, empty for every asset type")
+	fsRate.String(FlagRate, "0", "Rate of the trade price charged, e.g. 0.01")
+	fsFlatAmount.Int64(FlagFlatAmount, 0, "Flat amount charged on top of the rate")
+	fsZoneShare.String(FlagZoneShare, "0", "Share of the fee going to the zone")
+	fsOrganizationShare.String(FlagOrganizationShare, "0", "Share of the fee going to the organization of the payer")
+}
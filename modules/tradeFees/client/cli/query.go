@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/commitHub/commitBlockchain/codec"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+func GetTradeFeesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fees [zoneID]",
+		Short: "Query the trade fees defined by a zone, or by all zones",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID := ""
+			if len(args) == 1 {
+				zoneID = args[0]
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", tradeFeeTypes.QuerierRoute, "queryTradeFees", zoneID), nil)
+			if err != nil {
+				return err
+			}
+
+			var tradeFees []tradeFeeTypes.TradeFee
+			cdc.MustUnmarshalJSON(res, &tradeFees)
+
+			output, err := cdc.MarshalJSONIndent(tradeFees, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the default trade fees set by governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", tradeFeeTypes.QuerierRoute, "queryParams"), nil)
+			if err != nil {
+				return err
+			}
+
+			var params tradeFeeTypes.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
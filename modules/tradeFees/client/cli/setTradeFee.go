@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	"github.com/commitHub/commitBlockchain/modules/auth"
+	"github.com/commitHub/commitBlockchain/modules/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+func SetTradeFeeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee",
+		Short: "Set the fee the zone charges on a trade message and asset type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			zoneID, err := acl.GetZoneIDFromString(viper.GetString(FlagZoneID))
+			if err != nil {
+				return err
+			}
+
+			rate, err := cTypes.NewDecFromStr(viper.GetString(FlagRate))
+			if err != nil {
+				return err
+			}
+
+			zoneShare, err := cTypes.NewDecFromStr(viper.GetString(FlagZoneShare))
+			if err != nil {
+				return err
+			}
+
+			organizationShare, err := cTypes.NewDecFromStr(viper.GetString(FlagOrganizationShare))
+			if err != nil {
+				return err
+			}
+
+			tradeFee := tradeFeeTypes.NewTradeFee(zoneID, viper.GetString(FlagMsgType), viper.GetString(FlagAssetType), rate,
+				viper.GetInt64(FlagFlatAmount), zoneShare, organizationShare)
+			msg := tradeFeeTypes.BuildMsgSetTradeFees(cliCtx.GetFromAddress(), tradeFee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsZoneID)
+	cmd.Flags().AddFlagSet(fsMsgType)
+	cmd.Flags().AddFlagSet(fsAssetType)
+	cmd.Flags().AddFlagSet(fsRate)
+	cmd.Flags().AddFlagSet(fsFlatAmount)
+	cmd.Flags().AddFlagSet(fsZoneShare)
+	cmd.Flags().AddFlagSet(fsOrganizationShare)
+	return cmd
+}
@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+// QueryTradeFeesRequestHandlerFn : trade fees of the zone given as zoneID query parameter, of all zones without it
+func QueryTradeFeesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+		zoneID := r.URL.Query().Get("zoneID")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", tradeFeeTypes.QuerierRoute, "queryTradeFees", zoneID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query TradeFees. Error: %s", err.Error()))
+			return
+		}
+
+		var tradeFees []tradeFeeTypes.TradeFee
+		cliCtx.Codec.MustUnmarshalJSON(res, &tradeFees)
+
+		rest.PostProcessResponse(w, cliCtx, tradeFees)
+	}
+}
+
+func QueryParamsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx := cliCtx
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", tradeFeeTypes.QuerierRoute, "queryParams"), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query Params. Error: %s", err.Error()))
+			return
+		}
+
+		var params tradeFeeTypes.Params
+		cliCtx.Codec.MustUnmarshalJSON(res, &params)
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
@@ -0,0 +1,13 @@
+package rest
+
+import (
+	"github.com/commitHub/commitBlockchain/kafka"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, kafkaBool bool, kafkaState kafka.KafkaState) {
+	r.HandleFunc("/tradeFees", QueryTradeFeesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/tradeFees/params", QueryParamsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/setTradeFee", SetTradeFeeRequestHandlerFn(cliCtx, kafkaBool, kafkaState)).Methods("POST")
+}
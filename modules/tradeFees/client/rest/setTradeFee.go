@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/commitHub/commitBlockchain/kafka"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	rest2 "github.com/commitHub/commitBlockchain/client/rest"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+type setTradeFeeReq struct {
+	BaseReq           rest.BaseReq `json:"base_req"`
+	ZoneID            string       `json:"zoneID" valid:"required~Enter the ZoneID,hexadecimal~Invalid ZoneID"`
+	MsgType           string       `json:"msgType" valid:"required~Enter the MsgType,in(sendAsset|executeOrder)~Invalid MsgType"`
+	AssetType         string       `json:"assetType"`
+	Rate              string       `json:"rate" valid:"required~Enter the Rate"`
+	FlatAmount        int64        `json:"flatAmount"`
+	ZoneShare         string       `json:"zoneShare" valid:"required~Enter the ZoneShare"`
+	OrganizationShare string       `json:"organizationShare" valid:"required~Enter the OrganizationShare"`
+	Password          string       `json:"password" valid:"required~Enter the Password"`
+	Mode              string       `json:"mode"`
+}
+
+func SetTradeFeeRequestHandlerFn(cliCtx context.CLIContext, kafkaBool bool, kafkaState kafka.KafkaState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req setTradeFeeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		_, err := govalidator.ValidateStruct(req)
+		if err != nil {
+			rest2.WriteErrorResponse(w, cTypes.NewError(tradeFeeTypes.DefaultCodeSpace, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, name, err := context.GetFromFields(req.BaseReq.From, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		zoneID, err := acl.GetZoneIDFromString(req.ZoneID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rate, err := cTypes.NewDecFromStr(req.Rate)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		zoneShare, err := cTypes.NewDecFromStr(req.ZoneShare)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		organizationShare, err := cTypes.NewDecFromStr(req.OrganizationShare)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithFromAddress(fromAddr)
+		cliCtx = cliCtx.WithFromName(name)
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", acl.QuerierRoute, "queryZone", zoneID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("couldn't query zone. Error: %s", err.Error()))
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("Unauthorized transaction. Zone is not defined"))
+			return
+		}
+
+		tradeFee := tradeFeeTypes.NewTradeFee(zoneID, req.MsgType, req.AssetType, rate, req.FlatAmount, zoneShare, organizationShare)
+		msg := tradeFeeTypes.BuildMsgSetTradeFees(fromAddr, tradeFee)
+
+		if kafkaBool == true {
+			ticketID := kafka.TicketIDGenerator("STFE")
+			jsonResponse := kafka.SendToKafka(kafka.NewKafkaMsgFromRest(msg, ticketID, req.BaseReq, cliCtx, req.Mode, req.Password), kafkaState, cliCtx.Codec)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(jsonResponse)
+		} else {
+			output, err := rest2.SignAndBroadcast(req.BaseReq, cliCtx, req.Mode, req.Password, []cTypes.Msg{msg})
+			if err != nil {
+				rest2.WriteErrorResponse(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(output)
+		}
+	}
+}
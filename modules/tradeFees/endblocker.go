@@ -0,0 +1,19 @@
+package tradeFees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/supply"
+)
+
+// EndBlocker : move the community share of the trade fees collected during the block to the community pool
+func EndBlocker(ctx sdk.Context, accountKeeper AccountKeeper, distributionKeeper DistributionKeeper) {
+	collector := accountKeeper.GetAccount(ctx, supply.NewModuleAddress(ModuleName))
+	if collector == nil || collector.GetCoins().Empty() {
+		return
+	}
+
+	if err := distributionKeeper.FundCommunityPool(ctx, collector.GetCoins(), collector.GetAddress()); err != nil {
+		ctx.Logger().Error("could not fund the community pool with trade fees", "err", err.Error())
+	}
+}
@@ -0,0 +1,19 @@
+package tradeFees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	for _, tradeFee := range data.TradeFees {
+		keeper.SetTradeFee(ctx, tradeFee)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	params := keeper.GetParams(ctx)
+	tradeFees := keeper.GetTradeFees(ctx, nil)
+
+	return GenesisState{Params: params, TradeFees: tradeFees}
+}
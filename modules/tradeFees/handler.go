@@ -0,0 +1,34 @@
+package tradeFees
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) cTypes.Handler {
+	return func(ctx cTypes.Context, msg cTypes.Msg) cTypes.Result {
+		ctx = ctx.WithEventManager(cTypes.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgSetTradeFees:
+			return handleMsgSetTradeFees(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return cTypes.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSetTradeFees(ctx cTypes.Context, k Keeper, msg MsgSetTradeFees) cTypes.Result {
+	for _, setTradeFee := range msg.SetTradeFees {
+		if err := k.SetZoneTradeFee(ctx, setTradeFee); err != nil {
+			return err.Result()
+		}
+	}
+
+	return cTypes.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
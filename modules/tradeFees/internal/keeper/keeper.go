@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/params"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+type Keeper struct {
+	storeKey   cTypes.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	aclKeeper  tradeFeeTypes.ACLKeeper
+}
+
+func NewKeeper(storeKey cTypes.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, aclKeeper tradeFeeTypes.ACLKeeper) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(tradeFeeTypes.ParamKeyTable()),
+		aclKeeper:  aclKeeper,
+	}
+}
+
+// get the governance trade fee schedule
+func (k Keeper) GetParams(ctx cTypes.Context) (params tradeFeeTypes.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the governance trade fee schedule
+func (k Keeper) SetParams(ctx cTypes.Context, params tradeFeeTypes.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// tradeFees/{0x01}/{len(zoneID)}/{zoneID}/{msgType}/{assetType} => tradeFee
+func (k Keeper) SetTradeFee(ctx cTypes.Context, tradeFee tradeFeeTypes.TradeFee) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(tradeFee)
+	store.Set(tradeFeeTypes.GetTradeFeeKey(tradeFee.ZoneID, tradeFee.MsgType, tradeFee.AssetType), bz)
+}
+
+// returns the trade fee the zone defined for the message type and asset type
+func (k Keeper) GetTradeFee(ctx cTypes.Context, zoneID acl.ZoneID, msgType string, assetType string) (tradeFee tradeFeeTypes.TradeFee, err cTypes.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(tradeFeeTypes.GetTradeFeeKey(zoneID, msgType, assetType))
+	if bz == nil {
+		return tradeFee, tradeFeeTypes.ErrInvalidTradeFee(tradeFeeTypes.DefaultCodeSpace, "")
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tradeFee)
+	return tradeFee, nil
+}
+
+// get all trade fees defined by the zone, or by all zones if zoneID is nil => []TradeFee from store
+func (k Keeper) GetTradeFees(ctx cTypes.Context, zoneID acl.ZoneID) (tradeFees []tradeFeeTypes.TradeFee) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := tradeFeeTypes.TradeFeeKey
+	if zoneID != nil {
+		prefix = tradeFeeTypes.GetZoneTradeFeesKey(zoneID)
+	}
+	iterator := cTypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradeFee tradeFeeTypes.TradeFee
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tradeFee)
+		tradeFees = append(tradeFees, tradeFee)
+	}
+	return
+}
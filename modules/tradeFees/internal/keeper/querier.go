@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/codec"
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryTradeFees = "queryTradeFees"
+	QueryParams    = "queryParams"
+)
+
+func NewQuerier(k Keeper) cTypes.Querier {
+	return func(ctx cTypes.Context, path []string, req abciTypes.RequestQuery) (res []byte, err cTypes.Error) {
+		switch path[0] {
+		case QueryTradeFees:
+			return queryTradeFees(ctx, path[1:], k)
+		case QueryParams:
+			return queryParams(ctx, k)
+		default:
+			return nil, cTypes.ErrUnknownRequest("unknown tradeFees query endpoint")
+		}
+	}
+}
+
+// queryTradeFees : trade fees defined by the zone, or by all zones without a zoneID
+func queryTradeFees(ctx cTypes.Context, path []string, k Keeper) ([]byte, cTypes.Error) {
+	var zoneID acl.ZoneID
+	if len(path) > 0 && path[0] != "" {
+		var errRes error
+		zoneID, errRes = acl.GetZoneIDFromString(path[0])
+		if errRes != nil {
+			return nil, tradeFeeTypes.ErrInvalidTradeFee(tradeFeeTypes.DefaultCodeSpace, fmt.Sprintf("invalid zoneID %s", path[0]))
+		}
+	}
+
+	tradeFees := k.GetTradeFees(ctx, zoneID)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, tradeFees)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx cTypes.Context, k Keeper) ([]byte, cTypes.Error) {
+	params := k.GetParams(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, params)
+	if errRes != nil {
+		return nil, cTypes.ErrInternal(cTypes.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strconv"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+
+	tradeFeeTypes "github.com/commitHub/commitBlockchain/modules/tradeFees/internal/types"
+)
+
+// SetZoneTradeFee : zone defines the fee of a message type and asset type for its accounts, replacing the governance default
+func (k Keeper) SetZoneTradeFee(ctx cTypes.Context, setTradeFee tradeFeeTypes.SetTradeFee) cTypes.Error {
+	tradeFee := setTradeFee.TradeFee
+	if !k.aclKeeper.CheckValidZoneAddress(ctx, tradeFee.ZoneID, setTradeFee.FromAddress) {
+		return tradeFeeTypes.ErrUnauthorized(tradeFeeTypes.DefaultCodeSpace)
+	}
+
+	k.SetTradeFee(ctx, tradeFee)
+
+	ctx.EventManager().EmitEvent(
+		cTypes.NewEvent(tradeFeeTypes.EventTypeSetTradeFee,
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyZoneID, tradeFee.ZoneID.String()),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyMsgType, tradeFee.MsgType),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyAssetType, tradeFee.AssetType),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyRate, tradeFee.Rate.String()),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyFlatAmount, strconv.FormatInt(tradeFee.FlatAmount, 10)),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyZoneShare, tradeFee.ZoneShare.String()),
+			cTypes.NewAttribute(tradeFeeTypes.AttributeKeyOrganizationShare, tradeFee.OrganizationShare.String()),
+		))
+
+	return nil
+}
+
+// GetApplicableTradeFee : fee charged on a trade of the zone, the zone's fee of the asset type comes first, then the zone's fee
+// of any asset type, then the governance defaults in the same order. Trades without a matching fee are free.
+func (k Keeper) GetApplicableTradeFee(ctx cTypes.Context, zoneID acl.ZoneID, msgType string, assetType string) (tradeFeeTypes.TradeFee, bool) {
+	if tradeFee, err := k.GetTradeFee(ctx, zoneID, msgType, assetType); err == nil {
+		return tradeFee, true
+	}
+	if tradeFee, err := k.GetTradeFee(ctx, zoneID, msgType, ""); err == nil {
+		return tradeFee, true
+	}
+
+	anyAssetTradeFee, found := tradeFeeTypes.TradeFee{}, false
+	for _, tradeFee := range k.GetParams(ctx).DefaultTradeFees {
+		if tradeFee.MsgType != msgType {
+			continue
+		}
+		if tradeFee.AssetType == assetType {
+			return tradeFee, true
+		} else if tradeFee.AssetType == "" {
+			anyAssetTradeFee, found = tradeFee, true
+		}
+	}
+	return anyAssetTradeFee, found
+}
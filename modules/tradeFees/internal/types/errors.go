@@ -0,0 +1,29 @@
+package types
+
+import cTypes "github.com/cosmos/cosmos-sdk/types"
+
+type CodeType cTypes.CodeType
+
+const (
+	DefaultCodeSpace cTypes.CodespaceType = ModuleName
+
+	CodeInvalidTradeFee      cTypes.CodeType = 1401
+	CodeUnauthorized         cTypes.CodeType = 1402
+	CodeInvalidInputsOutputs cTypes.CodeType = 1403
+)
+
+func ErrInvalidTradeFee(codespace cTypes.CodespaceType, msg string) cTypes.Error {
+	if msg != "" {
+		return cTypes.NewError(codespace, CodeInvalidTradeFee, msg)
+	}
+	return cTypes.NewError(codespace, CodeInvalidTradeFee, "trade fee doesn't exist")
+}
+
+func ErrUnauthorized(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeUnauthorized, "Unauthorized transaction")
+}
+
+// ErrNoInputs is an error
+func ErrNoInputs(codeSpace cTypes.CodespaceType) cTypes.Error {
+	return cTypes.NewError(codeSpace, CodeInvalidInputsOutputs, "no inputs to send transaction")
+}
@@ -0,0 +1,13 @@
+package types
+
+var (
+	EventTypeSetTradeFee = "setTradeFee"
+
+	AttributeKeyZoneID            = "zoneID"
+	AttributeKeyMsgType           = "msgType"
+	AttributeKeyAssetType         = "assetType"
+	AttributeKeyRate              = "rate"
+	AttributeKeyFlatAmount        = "flatAmount"
+	AttributeKeyZoneShare         = "zoneShare"
+	AttributeKeyOrganizationShare = "organizationShare"
+)
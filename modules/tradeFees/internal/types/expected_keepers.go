@@ -0,0 +1,20 @@
+package types
+
+import (
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+	"github.com/commitHub/commitBlockchain/modules/auth/exported"
+)
+
+type ACLKeeper interface {
+	CheckValidZoneAddress(ctx cTypes.Context, id acl.ZoneID, address cTypes.AccAddress) bool
+}
+
+type AccountKeeper interface {
+	GetAccount(ctx cTypes.Context, address cTypes.AccAddress) exported.Account
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx cTypes.Context, amount cTypes.Coins, sender cTypes.AccAddress) cTypes.Error
+}
@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+type GenesisState struct {
+	Params    Params     `json:"params"`
+	TradeFees []TradeFee `json:"tradeFees"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	keys := make(map[string]bool)
+	for _, tradeFee := range data.TradeFees {
+		if len(tradeFee.ZoneID) == 0 {
+			return fmt.Errorf("trade fee in genesis should have a zoneID")
+		}
+		if err := tradeFee.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid trade fee in genesis: %s", err.Error())
+		}
+		key := string(GetTradeFeeKey(tradeFee.ZoneID, tradeFee.MsgType, tradeFee.AssetType))
+		if keys[key] {
+			return fmt.Errorf("duplicate trade fee %s/%s of zone %s in genesis", tradeFee.MsgType, tradeFee.AssetType, tradeFee.ZoneID.String())
+		}
+		keys[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+const (
+	ModuleName        = "tradeFees"
+	StoreKey          = ModuleName
+	RouterKey         = StoreKey
+	QuerierRoute      = RouterKey
+	DefaultParamspace = ModuleName
+)
+
+var (
+	TradeFeeKey = []byte{0x01}
+)
+
+// tradeFees/{0x01}/{len(zoneID)}/{zoneID}/{msgType}/{assetType}
+func GetTradeFeeKey(zoneID acl.ZoneID, msgType string, assetType string) []byte {
+	return append(GetZoneTradeFeesKey(zoneID), []byte(msgType+"/"+assetType)...)
+}
+
+// tradeFees/{0x01}/{len(zoneID)}/{zoneID}
+func GetZoneTradeFeesKey(zoneID acl.ZoneID) []byte {
+	key := append(TradeFeeKey, byte(len(zoneID)))
+	return append(key, zoneID.Bytes()...)
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// *****SetTradeFee
+
+// SetTradeFee : zone defines the fee charged on a trade message type and asset type of its accounts
+type SetTradeFee struct {
+	FromAddress cTypes.AccAddress `json:"fromAddress"`
+	TradeFee    TradeFee          `json:"tradeFee"`
+}
+
+// NewSetTradeFee : initializer
+func NewSetTradeFee(fromAddress cTypes.AccAddress, tradeFee TradeFee) SetTradeFee {
+	return SetTradeFee{fromAddress, tradeFee}
+}
+
+// GetSignBytes : get bytes to sign
+func (in SetTradeFee) GetSignBytes() []byte {
+	bin, err := ModuleCdc.MarshalJSON(struct {
+		FromAddress       string `json:"fromAddress"`
+		ZoneID            string `json:"zoneID"`
+		MsgType           string `json:"msgType"`
+		AssetType         string `json:"assetType"`
+		Rate              string `json:"rate"`
+		FlatAmount        int64  `json:"flatAmount"`
+		ZoneShare         string `json:"zoneShare"`
+		OrganizationShare string `json:"organizationShare"`
+	}{
+		FromAddress:       in.FromAddress.String(),
+		ZoneID:            in.TradeFee.ZoneID.String(),
+		MsgType:           in.TradeFee.MsgType,
+		AssetType:         in.TradeFee.AssetType,
+		Rate:              in.TradeFee.Rate.String(),
+		FlatAmount:        in.TradeFee.FlatAmount,
+		ZoneShare:         in.TradeFee.ZoneShare.String(),
+		OrganizationShare: in.TradeFee.OrganizationShare.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bin
+}
+
+func (in SetTradeFee) ValidateBasic() cTypes.Error {
+	if len(in.FromAddress) == 0 {
+		return cTypes.ErrInvalidAddress(in.FromAddress.String())
+	} else if len(in.TradeFee.ZoneID) == 0 {
+		return ErrInvalidTradeFee(DefaultCodeSpace, "ZoneID should not be empty.")
+	}
+	return in.TradeFee.ValidateBasic()
+}
+
+// MsgSetTradeFees : high level set trade fee of tradeFees module
+type MsgSetTradeFees struct {
+	SetTradeFees []SetTradeFee `json:"setTradeFees"`
+}
+
+// NewMsgSetTradeFees : initializer
+func NewMsgSetTradeFees(setTradeFees []SetTradeFee) MsgSetTradeFees {
+	return MsgSetTradeFees{setTradeFees}
+}
+
+var _ cTypes.Msg = MsgSetTradeFees{}
+
+// Type : implements msg
+func (msg MsgSetTradeFees) Type() string { return "setTradeFees" }
+
+func (msg MsgSetTradeFees) Route() string { return RouterKey }
+
+// ValidateBasic : implements msg
+func (msg MsgSetTradeFees) ValidateBasic() cTypes.Error {
+	if len(msg.SetTradeFees) == 0 {
+		return ErrNoInputs(DefaultCodeSpace).TraceSDK("")
+	}
+	for _, in := range msg.SetTradeFees {
+		if err := in.ValidateBasic(); err != nil {
+			return err.TraceSDK("")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : implements msg
+func (msg MsgSetTradeFees) GetSignBytes() []byte {
+	var setTradeFees []json.RawMessage
+	for _, setTradeFee := range msg.SetTradeFees {
+		setTradeFees = append(setTradeFees, setTradeFee.GetSignBytes())
+	}
+
+	b, err := ModuleCdc.MarshalJSON(struct {
+		SetTradeFees []json.RawMessage `json:"setTradeFees"`
+	}{
+		SetTradeFees: setTradeFees,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners : implements msg
+func (msg MsgSetTradeFees) GetSigners() []cTypes.AccAddress {
+	addrs := make([]cTypes.AccAddress, len(msg.SetTradeFees))
+	for i, in := range msg.SetTradeFees {
+		addrs[i] = in.FromAddress
+	}
+	return addrs
+}
+
+// BuildMsgSetTradeFees : build the MsgSetTradeFees
+func BuildMsgSetTradeFees(fromAddress cTypes.AccAddress, tradeFee TradeFee) cTypes.Msg {
+	setTradeFee := NewSetTradeFee(fromAddress, tradeFee)
+	msg := NewMsgSetTradeFees([]SetTradeFee{setTradeFee})
+	return msg
+}
+
+// #####SetTradeFee
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/commitHub/commitBlockchain/modules/params"
+)
+
+// Parameter store keys
+var (
+	KeyDefaultTradeFees = []byte("DefaultTradeFees")
+)
+
+// tradeFees parameters
+type Params struct {
+	DefaultTradeFees []TradeFee `json:"default_trade_fees" yaml:"default_trade_fees"` // fees of zones that don't define their own
+}
+
+// ParamTable for tradeFees module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(defaultTradeFees []TradeFee) Params {
+	return Params{
+		DefaultTradeFees: defaultTradeFees,
+	}
+}
+
+// default tradeFees module parameters, trades are free until governance sets a schedule
+func DefaultParams() Params {
+	return Params{
+		DefaultTradeFees: []TradeFee{},
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	keys := make(map[string]bool)
+	for _, tradeFee := range params.DefaultTradeFees {
+		if len(tradeFee.ZoneID) != 0 {
+			return fmt.Errorf("tradeFees parameter DefaultTradeFees should not have a zoneID, has %s", tradeFee.ZoneID.String())
+		}
+		if err := tradeFee.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid tradeFees parameter DefaultTradeFees: %s", err.Error())
+		}
+		key := tradeFee.MsgType + "/" + tradeFee.AssetType
+		if keys[key] {
+			return fmt.Errorf("duplicate tradeFees parameter DefaultTradeFees %s", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	s := "TradeFees Params:\n"
+	for _, tradeFee := range p.DefaultTradeFees {
+		s += tradeFee.String()
+	}
+	return s
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyDefaultTradeFees, Value: &p.DefaultTradeFees},
+	}
+}
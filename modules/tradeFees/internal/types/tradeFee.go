@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	cTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/commitHub/commitBlockchain/modules/acl"
+)
+
+const (
+	MsgTypeSendAsset    = "sendAsset"
+	MsgTypeExecuteOrder = "executeOrder"
+)
+
+// TradeFee : fee charged on a trade message, a rate of the trade price plus a flat amount, split between the zone,
+// the organization of the payer and the community pool which takes what is left of the zone and organization shares.
+// An empty zoneID marks a governance default and an empty asset type matches every asset type.
+type TradeFee struct {
+	ZoneID            acl.ZoneID `json:"zoneID"`
+	MsgType           string     `json:"msgType"`
+	AssetType         string     `json:"assetType"`
+	Rate              cTypes.Dec `json:"rate"`
+	FlatAmount        int64      `json:"flatAmount"`
+	ZoneShare         cTypes.Dec `json:"zoneShare"`
+	OrganizationShare cTypes.Dec `json:"organizationShare"`
+}
+
+func NewTradeFee(zoneID acl.ZoneID, msgType string, assetType string, rate cTypes.Dec, flatAmount int64,
+	zoneShare cTypes.Dec, organizationShare cTypes.Dec) TradeFee {
+
+	return TradeFee{
+		ZoneID:            zoneID,
+		MsgType:           msgType,
+		AssetType:         assetType,
+		Rate:              rate,
+		FlatAmount:        flatAmount,
+		ZoneShare:         zoneShare,
+		OrganizationShare: organizationShare,
+	}
+}
+
+// GetAmount : fee charged on a trade at the given price
+func (tradeFee TradeFee) GetAmount(price int64) int64 {
+	return tradeFee.Rate.MulInt64(price).TruncateInt64() + tradeFee.FlatAmount
+}
+
+// Split : shares of the fee amount going to the zone, the organization and the community pool
+func (tradeFee TradeFee) Split(amount int64) (zoneAmount int64, organizationAmount int64, communityAmount int64) {
+	zoneAmount = tradeFee.ZoneShare.MulInt64(amount).TruncateInt64()
+	organizationAmount = tradeFee.OrganizationShare.MulInt64(amount).TruncateInt64()
+	communityAmount = amount - zoneAmount - organizationAmount
+	return
+}
+
+func (tradeFee TradeFee) ValidateBasic() cTypes.Error {
+	if tradeFee.MsgType != MsgTypeSendAsset && tradeFee.MsgType != MsgTypeExecuteOrder {
+		return ErrInvalidTradeFee(DefaultCodeSpace, fmt.Sprintf("MsgType should be %s or %s.", MsgTypeSendAsset, MsgTypeExecuteOrder))
+	} else if tradeFee.Rate.IsNil() || tradeFee.Rate.IsNegative() || tradeFee.Rate.GT(cTypes.OneDec()) {
+		return ErrInvalidTradeFee(DefaultCodeSpace, "Rate should be between 0 and 1.")
+	} else if tradeFee.FlatAmount < 0 {
+		return ErrInvalidTradeFee(DefaultCodeSpace, "FlatAmount should not be negative.")
+	} else if tradeFee.ZoneShare.IsNil() || tradeFee.ZoneShare.IsNegative() ||
+		tradeFee.OrganizationShare.IsNil() || tradeFee.OrganizationShare.IsNegative() {
+		return ErrInvalidTradeFee(DefaultCodeSpace, "Shares should not be negative.")
+	} else if tradeFee.ZoneShare.Add(tradeFee.OrganizationShare).GT(cTypes.OneDec()) {
+		return ErrInvalidTradeFee(DefaultCodeSpace, "Zone and organization shares should not exceed 1.")
+	}
+	return nil
+}
+
+func (tradeFee TradeFee) String() string {
+	return fmt.Sprintf(`TradeFee:
+ZoneID: %s,
+MsgType: %s,
+AssetType: %s,
+Rate: %s,
+FlatAmount: %d,
+ZoneShare: %s,
+OrganizationShare: %s,
+`, tradeFee.ZoneID.String(), tradeFee.MsgType, tradeFee.AssetType, tradeFee.Rate.String(), tradeFee.FlatAmount,
+		tradeFee.ZoneShare.String(), tradeFee.OrganizationShare.String())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"sort"
+	"strings"
 	
 	cTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/common"
@@ -43,6 +44,9 @@ type AssetPeg interface {
 	
 	GetTakerAddress() cTypes.AccAddress
 	SetTakerAddress(cTypes.AccAddress) error
+	
+	GetZoneID() common.HexBytes
+	SetZoneID(common.HexBytes) error
 }
 
 // BaseAssetPeg : base asset type
@@ -57,6 +61,7 @@ type BaseAssetPeg struct {
 	Locked        bool              `json:"locked"`
 	Moderated     bool              `json:"moderated"`
 	TakerAddress  cTypes.AccAddress `json:"takerAddress"`
+	ZoneID        common.HexBytes   `json:"zoneID"`
 }
 
 // NewBaseAssetPegWithPegHash a base asset peg with peg hash
@@ -165,6 +170,15 @@ func (baseAssetPeg *BaseAssetPeg) SetTakerAddress(takerAddress cTypes.AccAddress
 	return nil
 }
 
+// GetZoneID : getter, the zone that issued the moderated asset peg
+func (baseAssetPeg BaseAssetPeg) GetZoneID() common.HexBytes { return baseAssetPeg.ZoneID }
+
+// SetZoneID : setter
+func (baseAssetPeg *BaseAssetPeg) SetZoneID(zoneID common.HexBytes) error {
+	baseAssetPeg.ZoneID = zoneID
+	return nil
+}
+
 // AssetPegDecoder : decoder function for asset peg
 type AssetPegDecoder func(assetPegBytes []byte) (AssetPeg, error)
 
@@ -177,6 +191,21 @@ func GetAssetPegHashHex(pegHashStr string) (pegHash PegHash, err error) {
 	return PegHash(bz), nil
 }
 
+// GetAssetPegHashesHex : convert comma separated string to hex peg hashes
+func GetAssetPegHashesHex(pegHashesStr string) (pegHashes []PegHash, err error) {
+	if pegHashesStr == "" {
+		return nil, nil
+	}
+	for _, pegHashStr := range strings.Split(pegHashesStr, ",") {
+		pegHash, err := GetAssetPegHashHex(strings.TrimSpace(pegHashStr))
+		if err != nil {
+			return nil, err
+		}
+		pegHashes = append(pegHashes, pegHash)
+	}
+	return pegHashes, nil
+}
+
 // ToBaseAssetPeg : convert interface to concrete
 func ToBaseAssetPeg(assetPeg AssetPeg) BaseAssetPeg {
 	var baseAssetPeg BaseAssetPeg
@@ -190,6 +219,7 @@ func ToBaseAssetPeg(assetPeg AssetPeg) BaseAssetPeg {
 	baseAssetPeg.Locked = assetPeg.GetLocked()
 	baseAssetPeg.Moderated = assetPeg.GetModerated()
 	baseAssetPeg.TakerAddress = assetPeg.GetTakerAddress()
+	baseAssetPeg.ZoneID = assetPeg.GetZoneID()
 	return baseAssetPeg
 }
 
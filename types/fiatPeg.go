@@ -6,6 +6,7 @@ import (
 	"sort"
 	
 	cTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // FiatPeg : peg issued against each fiat transaction
@@ -24,6 +25,15 @@ type FiatPeg interface {
 	
 	GetOwners() []Owner
 	SetOwners([]Owner) error
+	
+	GetMergedPegHashes() []PegHash
+	SetMergedPegHashes([]PegHash) error
+
+	GetZoneID() common.HexBytes
+	SetZoneID(common.HexBytes) error
+
+	GetCurrency() string
+	SetCurrency(string) error
 }
 
 // Owner : partial or full owner of a transaction
@@ -34,11 +44,14 @@ type Owner struct {
 
 // BaseFiatPeg : fiat peg basic implementation
 type BaseFiatPeg struct {
-	PegHash           PegHash `json:"pegHash" `
-	TransactionID     string  `json:"transactionID" valid:"required~TxID is mandatory,matches(^[A-Z0-9]+$)~Invalid TransactionId,length(2|40)~TransactionId length between 2-40"`
-	TransactionAmount int64   `json:"transactionAmount" valid:"required~TransactionAmount is mandatory,matches(^[1-9]{1}[0-9]*$)~Invalid TransactionAmount"`
-	RedeemedAmount    int64   `json:"redeemedAmount"`
-	Owners            []Owner `json:"owners"`
+	PegHash           PegHash         `json:"pegHash" `
+	TransactionID     string          `json:"transactionID" valid:"required~TxID is mandatory,matches(^[A-Z0-9]+$)~Invalid TransactionId,length(2|40)~TransactionId length between 2-40"`
+	TransactionAmount int64           `json:"transactionAmount" valid:"required~TransactionAmount is mandatory,matches(^[1-9]{1}[0-9]*$)~Invalid TransactionAmount"`
+	RedeemedAmount    int64           `json:"redeemedAmount"`
+	Owners            []Owner         `json:"owners"`
+	MergedPegHashes   []PegHash       `json:"mergedPegHashes"`
+	ZoneID            common.HexBytes `json:"zoneID"`
+	Currency          string          `json:"currency"`
 }
 
 var _ FiatPeg = (*BaseFiatPeg)(nil)
@@ -88,6 +101,33 @@ func (baseFiatPeg *BaseFiatPeg) SetOwners(owners []Owner) error {
 	return nil
 }
 
+// GetMergedPegHashes : getter
+func (baseFiatPeg BaseFiatPeg) GetMergedPegHashes() []PegHash { return baseFiatPeg.MergedPegHashes }
+
+// SetMergedPegHashes : setter
+func (baseFiatPeg *BaseFiatPeg) SetMergedPegHashes(mergedPegHashes []PegHash) error {
+	baseFiatPeg.MergedPegHashes = mergedPegHashes
+	return nil
+}
+
+// GetZoneID : getter, the zone that issued the fiat peg
+func (baseFiatPeg BaseFiatPeg) GetZoneID() common.HexBytes { return baseFiatPeg.ZoneID }
+
+// SetZoneID : setter
+func (baseFiatPeg *BaseFiatPeg) SetZoneID(zoneID common.HexBytes) error {
+	baseFiatPeg.ZoneID = zoneID
+	return nil
+}
+
+// GetCurrency : getter, the denom of the currency of the fiat peg
+func (baseFiatPeg BaseFiatPeg) GetCurrency() string { return baseFiatPeg.Currency }
+
+// SetCurrency : setter
+func (baseFiatPeg *BaseFiatPeg) SetCurrency(currency string) error {
+	baseFiatPeg.Currency = currency
+	return nil
+}
+
 // FiatPegDecoder : decoder function for fiat peg
 type FiatPegDecoder func(fiatPegBytes []byte) (FiatPeg, error)
 
@@ -126,6 +166,9 @@ func ToFiatPeg(baseFiatPeg BaseFiatPeg) FiatPeg {
 	fiatI.SetRedeemedAmount(baseFiatPeg.RedeemedAmount)
 	fiatI.SetTransactionAmount(baseFiatPeg.TransactionAmount)
 	fiatI.SetTransactionID(baseFiatPeg.TransactionID)
+	fiatI.SetMergedPegHashes(baseFiatPeg.MergedPegHashes)
+	fiatI.SetZoneID(baseFiatPeg.ZoneID)
+	fiatI.SetCurrency(baseFiatPeg.Currency)
 	return fiatI
 }
 
@@ -137,6 +180,9 @@ func ToBaseFiatPeg(fiatPeg FiatPeg) BaseFiatPeg {
 	baseFiatPeg.RedeemedAmount = fiatPeg.GetRedeemedAmount()
 	baseFiatPeg.TransactionAmount = fiatPeg.GetTransactionAmount()
 	baseFiatPeg.TransactionID = fiatPeg.GetTransactionID()
+	baseFiatPeg.MergedPegHashes = fiatPeg.GetMergedPegHashes()
+	baseFiatPeg.ZoneID = fiatPeg.GetZoneID()
+	baseFiatPeg.Currency = fiatPeg.GetCurrency()
 	return baseFiatPeg
 }
 
@@ -270,6 +316,28 @@ func GetFiatPegWalletBalance(fiatPegWallet FiatPegWallet) int64 {
 	return balance
 }
 
+// GetFiatPegWalletPegHashes : the peg hashes of the fiat pegs of a wallet
+func GetFiatPegWalletPegHashes(fiatPegWallet FiatPegWallet) []PegHash {
+	pegHashes := make([]PegHash, 0, len(fiatPegWallet))
+	for _, fiatPeg := range fiatPegWallet {
+		pegHashes = append(pegHashes, fiatPeg.PegHash)
+	}
+	return pegHashes
+}
+
+// GetFiatPegWalletCoins : the sum of the fiat pegs of a wallet per currency, fiat pegs without an issuing zone or a
+// currency are left out
+func GetFiatPegWalletCoins(fiatPegWallet FiatPegWallet) cTypes.Coins {
+	coins := cTypes.Coins{}
+	for _, fiatPeg := range fiatPegWallet {
+		coin := cTypes.Coin{Denom: fiatPeg.Currency, Amount: cTypes.NewInt(fiatPeg.TransactionAmount)}
+		if len(fiatPeg.ZoneID) != 0 && coin.IsValid() {
+			coins = coins.Add(cTypes.Coins{coin})
+		}
+	}
+	return coins
+}
+
 // TransferFiatPegsToWallet : subtracts and changes owners of fiat peg in fiat chain
 func TransferFiatPegsToWallet(fiatPegWallet FiatPegWallet, oldFiatPegWallet FiatPegWallet, fromAddress cTypes.AccAddress, toAddress cTypes.AccAddress) FiatPegWallet {
 	for _, fiatPeg := range fiatPegWallet {
@@ -297,6 +365,7 @@ func TransferFiatPegsToWallet(fiatPegWallet FiatPegWallet, oldFiatPegWallet Fiat
 				if subtracted != 1 || added != 1 {
 					return nil
 				}
+				oldFiatPeg.Owners = CompactOwners(oldFiatPeg.Owners)
 				transfered = true
 				oldFiatPegWallet[j] = oldFiatPeg
 				break
@@ -333,6 +402,7 @@ func RedeemFiatPegsFromWallet(fiatPegWallet FiatPegWallet, oldFiatPegWallet Fiat
 				}
 				oldFiatPeg.TransactionAmount -= fiatPeg.RedeemedAmount
 				oldFiatPeg.RedeemedAmount += fiatPeg.RedeemedAmount
+				oldFiatPeg.Owners = CompactOwners(oldFiatPeg.Owners)
 				
 				transfered = true
 				oldFiatPegWallet[j] = oldFiatPeg
@@ -345,3 +415,93 @@ func RedeemFiatPegsFromWallet(fiatPegWallet FiatPegWallet, oldFiatPegWallet Fiat
 	}
 	return oldFiatPegWallet
 }
+
+// CompactOwners : merge owners listed more than once and drop owners left with nothing
+func CompactOwners(owners []Owner) []Owner {
+	var compactOwners []Owner
+	for _, owner := range owners {
+		merged := false
+		for i, compactOwner := range compactOwners {
+			if compactOwner.OwnerAddress.String() == owner.OwnerAddress.String() {
+				compactOwners[i].Amount += owner.Amount
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			compactOwners = append(compactOwners, owner)
+		}
+	}
+	
+	var nonZeroOwners []Owner
+	for _, owner := range compactOwners {
+		if owner.Amount != 0 {
+			nonZeroOwners = append(nonZeroOwners, owner)
+		}
+	}
+	return nonZeroOwners
+}
+
+// ConsolidateFiatPegWallet : merge the fiat pegs of pegHashes in a wallet, keeping the merged peg hashes as audit trail.
+// Only fiat pegs of the same issuing zone and currency are merged, into the first of them so its peg hash stays known
+// to the fiat chain. All fiat pegs of the wallet are merged per zone and currency if pegHashes is empty.
+func ConsolidateFiatPegWallet(pegHashes []PegHash, fiatPegWallet FiatPegWallet) (FiatPegWallet, FiatPegWallet) {
+	var groups []FiatPegWallet
+	var remainingFiatPegWallet FiatPegWallet
+	for _, fiatPeg := range fiatPegWallet {
+		if len(pegHashes) != 0 && !containsPegHash(pegHashes, fiatPeg.PegHash) {
+			remainingFiatPegWallet = append(remainingFiatPegWallet, fiatPeg)
+			continue
+		}
+		grouped := false
+		for i, group := range groups {
+			if group[0].ZoneID.String() == fiatPeg.ZoneID.String() && group[0].Currency == fiatPeg.Currency {
+				groups[i] = append(group, fiatPeg)
+				grouped = true
+				break
+			}
+		}
+		if !grouped {
+			groups = append(groups, FiatPegWallet{fiatPeg})
+		}
+	}
+	if len(pegHashes) != 0 && (len(groups) != 1 || len(groups[0]) != len(pegHashes)) {
+		return nil, fiatPegWallet
+	}
+
+	var consolidatedFiatPegWallet FiatPegWallet
+	for _, group := range groups {
+		if len(group) < 2 {
+			remainingFiatPegWallet = append(remainingFiatPegWallet, group...)
+			continue
+		}
+
+		consolidatedFiatPeg := group[0]
+		consolidatedFiatPeg.MergedPegHashes = append([]PegHash(nil), consolidatedFiatPeg.MergedPegHashes...)
+		owners := append([]Owner(nil), consolidatedFiatPeg.Owners...)
+		for _, fiatPeg := range group[1:] {
+			consolidatedFiatPeg.TransactionAmount += fiatPeg.TransactionAmount
+			consolidatedFiatPeg.RedeemedAmount += fiatPeg.RedeemedAmount
+			consolidatedFiatPeg.MergedPegHashes = append(consolidatedFiatPeg.MergedPegHashes, fiatPeg.PegHash)
+			consolidatedFiatPeg.MergedPegHashes = append(consolidatedFiatPeg.MergedPegHashes, fiatPeg.MergedPegHashes...)
+			owners = append(owners, fiatPeg.Owners...)
+		}
+		consolidatedFiatPeg.Owners = CompactOwners(owners)
+
+		consolidatedFiatPegWallet = append(consolidatedFiatPegWallet, consolidatedFiatPeg)
+		remainingFiatPegWallet = append(remainingFiatPegWallet, consolidatedFiatPeg)
+	}
+	if len(consolidatedFiatPegWallet) == 0 {
+		return nil, fiatPegWallet
+	}
+	return consolidatedFiatPegWallet, remainingFiatPegWallet.Sort()
+}
+
+func containsPegHash(pegHashes []PegHash, pegHash PegHash) bool {
+	for _, _pegHash := range pegHashes {
+		if _pegHash.String() == pegHash.String() {
+			return true
+		}
+	}
+	return false
+}